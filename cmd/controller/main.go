@@ -22,6 +22,9 @@ import (
 	"github.com/tektoncd/triggers/pkg/reconciler/clusterinterceptor"
 	elresources "github.com/tektoncd/triggers/pkg/reconciler/eventlistener/resources"
 	"github.com/tektoncd/triggers/pkg/reconciler/interceptor"
+	"github.com/tektoncd/triggers/pkg/reconciler/trigger"
+	"github.com/tektoncd/triggers/pkg/reconciler/triggerinvocation"
+	"github.com/tektoncd/triggers/pkg/reconciler/validation"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -60,6 +63,7 @@ var (
 		"The HTTP Client read timeout for EventListener Server.")
 	periodSeconds    = flag.Int("period-seconds", elresources.DefaultPeriodSeconds, "The Period Seconds for the EventListener Liveness and Readiness Probes.")
 	failureThreshold = flag.Int("failure-threshold", elresources.DefaultFailureThreshold, "The Failure Threshold for the EventListener Liveness and Readiness Probes.")
+	enableProfiling  = flag.Bool("el-profiling", elresources.DefaultEnableProfiling, "Serve pprof profiles on the admin port of the EventListener.")
 
 	staticResourceLabels = elresources.DefaultStaticResourceLabels
 	systemNamespace      = os.Getenv("SYSTEM_NAMESPACE")
@@ -85,6 +89,7 @@ func main() {
 		HTTPClientExpectContinueTimeout: httpClientExpectContinueTimeout,
 		PeriodSeconds:                   periodSeconds,
 		FailureThreshold:                failureThreshold,
+		EnableProfiling:                 enableProfiling,
 
 		StaticResourceLabels: staticResourceLabels,
 		SystemNamespace:      systemNamespace,
@@ -99,5 +104,10 @@ func main() {
 		eventlistener.NewController(c),
 		clusterinterceptor.NewController(),
 		interceptor.NewController(),
+		triggerinvocation.NewController(),
+		trigger.NewController(),
+		validation.NewTriggerBindingController(),
+		validation.NewClusterTriggerBindingController(),
+		validation.NewTriggerTemplateController(),
 	)
 }
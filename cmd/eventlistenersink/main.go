@@ -19,6 +19,8 @@ package main
 import (
 	"context"
 	"log"
+	"os"
+	"time"
 
 	"k8s.io/client-go/dynamic"
 	kubeclientset "k8s.io/client-go/kubernetes"
@@ -42,6 +44,17 @@ const (
 )
 
 func main() {
+	// The preStop hook of the EventListener runs "eventlistenersink sleep
+	// <duration>", since its image has no sleep binary.
+	if len(os.Args) == 3 && os.Args[1] == "sleep" {
+		d, err := time.ParseDuration(os.Args[2])
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		time.Sleep(d)
+		return
+	}
+
 	cfg := injection.ParseAndGetRESTConfigOrDie()
 
 	ctx := signals.NewContext()
@@ -0,0 +1,204 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/template"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+var (
+	// Lint flags
+	lintFiles []string
+)
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check that the bindings and templates of Triggers agree on params",
+	Long: `Lint reads Triggers, TriggerBindings, ClusterTriggerBindings and
+TriggerTemplates from YAML files and reports, for every Trigger:
+
+- params provided by the bindings that the template doesn't declare (warning)
+- params the template declares without a default that no binding provides (error)
+- $(tt.params.NAME) references to params the template doesn't declare (error)
+- deprecated $(params.NAME) references to template params (warning)
+
+The bindings and templates referenced by the Triggers must be in the files.
+The command fails if any error is found.
+
+Examples:
+  # Lint the Triggers of a directory of manifests
+  tkn triggers lint -f trigger.yaml -f bindings.yaml -f template.yaml`,
+	RunE:         lintRun,
+	SilenceUsage: true,
+}
+
+func init() {
+	lintCmd.Flags().StringSliceVarP(&lintFiles, "filename", "f", nil, "Path to a YAML file with Triggers, bindings and templates (repeatable)")
+	if err := lintCmd.MarkFlagRequired("filename"); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+}
+
+func lintRun(cmd *cobra.Command, args []string) error {
+	return lint(cmd.OutOrStdout(), lintFiles)
+}
+
+// lintObjects holds the Triggers and the objects they reference, keyed by
+// namespace and name.
+type lintObjects struct {
+	triggers               []lintTrigger
+	triggerBindings        map[string]*v1beta1.TriggerBinding
+	clusterTriggerBindings map[string]*v1beta1.ClusterTriggerBinding
+	triggerTemplates       map[string]*v1beta1.TriggerTemplate
+}
+
+type lintTrigger struct {
+	path    string
+	trigger *v1beta1.Trigger
+}
+
+func lint(w io.Writer, paths []string) error {
+	objs := &lintObjects{
+		triggerBindings:        map[string]*v1beta1.TriggerBinding{},
+		clusterTriggerBindings: map[string]*v1beta1.ClusterTriggerBinding{},
+		triggerTemplates:       map[string]*v1beta1.TriggerTemplate{},
+	}
+	for _, path := range paths {
+		if err := objs.read(path); err != nil {
+			return err
+		}
+	}
+
+	errs := 0
+	for _, lt := range objs.triggers {
+		t := lt.trigger
+		name := t.Name
+		if t.Namespace != "" {
+			name = t.Namespace + "/" + t.Name
+		}
+		rt, err := template.ResolveTrigger(*t,
+			func(ref string) (*v1beta1.TriggerBinding, error) {
+				return get(objs.triggerBindings, "TriggerBinding", t.Namespace, ref)
+			},
+			func(ref string) (*v1beta1.ClusterTriggerBinding, error) {
+				return get(objs.clusterTriggerBindings, "ClusterTriggerBinding", "", ref)
+			},
+			func(ref string) (*v1beta1.TriggerTemplate, error) {
+				return get(objs.triggerTemplates, "TriggerTemplate", t.Namespace, ref)
+			})
+		if err != nil {
+			fmt.Fprintf(w, "%s: Trigger %s: error: %s\n", lt.path, name, err)
+			errs++
+			continue
+		}
+		for _, f := range template.AnalyzeParams(rt) {
+			level := "warning"
+			if f.IsError() {
+				level = "error"
+				errs++
+			}
+			fmt.Fprintf(w, "%s: Trigger %s: %s: %s\n", lt.path, name, level, f.Message)
+		}
+	}
+	switch {
+	case errs == 1:
+		return errors.New("found 1 error")
+	case errs > 1:
+		return fmt.Errorf("found %d errors", errs)
+	}
+	return nil
+}
+
+// read decodes the Triggers and the objects they reference from a file of
+// YAML or JSON documents. Other objects are ignored.
+func (o *lintObjects) read(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+	defer f.Close()
+
+	decoder := yaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var u map[string]interface{}
+		if err := decoder.Decode(&u); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error decoding %s: %w", path, err)
+		}
+		if u == nil || u["apiVersion"] != v1beta1.SchemeGroupVersion.String() {
+			continue
+		}
+
+		switch u["kind"] {
+		case "Trigger":
+			t := &v1beta1.Trigger{}
+			if err := fromUnstructured(path, u, t); err != nil {
+				return err
+			}
+			o.triggers = append(o.triggers, lintTrigger{path: path, trigger: t})
+		case "TriggerBinding":
+			tb := &v1beta1.TriggerBinding{}
+			if err := fromUnstructured(path, u, tb); err != nil {
+				return err
+			}
+			o.triggerBindings[key(tb.Namespace, tb.Name)] = tb
+		case "ClusterTriggerBinding":
+			ctb := &v1beta1.ClusterTriggerBinding{}
+			if err := fromUnstructured(path, u, ctb); err != nil {
+				return err
+			}
+			o.clusterTriggerBindings[key("", ctb.Name)] = ctb
+		case "TriggerTemplate":
+			tt := &v1beta1.TriggerTemplate{}
+			if err := fromUnstructured(path, u, tt); err != nil {
+				return err
+			}
+			o.triggerTemplates[key(tt.Namespace, tt.Name)] = tt
+		}
+	}
+}
+
+func fromUnstructured(path string, u map[string]interface{}, obj interface{}) error {
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u, obj); err != nil {
+		return fmt.Errorf("error decoding %s in %s: %w", u["kind"], path, err)
+	}
+	return nil
+}
+
+func get[T any](objs map[string]*T, kind, namespace, name string) (*T, error) {
+	obj, ok := objs[key(namespace, name)]
+	if !ok {
+		return nil, fmt.Errorf("%s %s not found in the files", kind, name)
+	}
+	return obj, nil
+}
+
+func key(namespace, name string) string {
+	return namespace + "/" + name
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLint(t *testing.T) {
+	path := "../testdata/lint.yaml"
+	var out bytes.Buffer
+	err := lint(&out, []string{path})
+	if err == nil || err.Error() != "found 2 errors" {
+		t.Errorf("lint() returned error %v, want found 2 errors", err)
+	}
+
+	want := path + ": Trigger push: warning: param sha is provided by a binding but not declared by the TriggerTemplate, so its value is ignored\n" +
+		path + ": Trigger push: error: param revision is declared by the TriggerTemplate without a default but no binding provides it\n" +
+		path + ": Trigger push: warning: $(params.revision) is no longer substituted, use $(tt.params.revision) to reference the TriggerTemplate param\n" +
+		path + ": Trigger pull-request: error: failed to resolve bindings: error getting TriggerBinding pull-request: TriggerBinding pull-request not found in the files\n"
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("lint() output mismatch. -want/+got: %s", diff)
+	}
+}
+
+func TestLint_missingFile(t *testing.T) {
+	if err := lint(&bytes.Buffer{}, []string{"../testdata/missing.yaml"}); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
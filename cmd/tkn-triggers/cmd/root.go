@@ -24,7 +24,7 @@ var rootCmd = &cobra.Command{
 	Use:   "tkn-triggers",
 	Short: "Tekton Triggers CLI plugin",
 	Long: `tkn-triggers is a CLI plugin for Tekton Triggers that provides 
-command for bootstrapping Tekton Triggers resources.`,
+commands for bootstrapping and linting Tekton Triggers resources.`,
 }
 
 func Execute() error {
@@ -33,4 +33,5 @@ func Execute() error {
 
 func init() {
 	rootCmd.AddCommand(bootstrapCmd)
+	rootCmd.AddCommand(lintCmd)
 }
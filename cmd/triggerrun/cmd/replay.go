@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	triggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
+	"github.com/tektoncd/triggers/pkg/sink"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	replayCmd = &cobra.Command{
+		Use:   "replay",
+		Short: "Replay a recorded event against an EventListener.",
+		Long: `Replay sends a recorded event to an EventListener, which processes it with its current Triggers.
+The event is read either from a TriggerInvocation or from a file holding an HTTP request.`,
+		RunE: replayRun,
+	}
+	replayURL        string
+	replayInvocation string
+	replayNamespace  string
+	replayHTTPPath   string
+	replayEventID    string
+	replayToken      string
+	replayDryRun     bool
+)
+
+func init() {
+	replayCmd.Flags().StringVarP(&replayURL, "url", "u", "", "URL of the EventListener")
+	replayCmd.Flags().StringVarP(&replayInvocation, "invocation", "i", "", "Name of the TriggerInvocation to replay")
+	replayCmd.Flags().StringVarP(&replayNamespace, "namespace", "n", "default", "Namespace of the TriggerInvocation")
+	replayCmd.Flags().StringVarP(&replayHTTPPath, "httpPath", "r", "", "Path to the HTTP request to replay")
+	replayCmd.Flags().StringVarP(&replayEventID, "event-id", "e", "", "ID of the original event, when replaying an HTTP request")
+	replayCmd.Flags().StringVarP(&replayToken, "token", "t", "", "Replay token of the EventListener, the token key of the Secret named by its replay token annotation")
+	replayCmd.Flags().BoolVar(&replayDryRun, "dry-run", false, "validate the resources instead of creating them")
+	rootCmd.AddCommand(replayCmd)
+}
+
+// revive:disable:unused-parameter
+
+func replayRun(cmd *cobra.Command, args []string) error {
+	var client triggersclientset.Interface
+	if replayInvocation != "" {
+		var err error
+		if _, client, err = getKubeClient(kubeconfig); err != nil {
+			return fmt.Errorf("fail to get clients: %w", err)
+		}
+	}
+	if err := replay(client, replayURL, replayInvocation, replayNamespace, replayHTTPPath, replayEventID, replayToken, replayDryRun, os.Stdout); err != nil {
+		return fmt.Errorf("fail to replay event: %w", err)
+	}
+	return nil
+}
+
+func replay(client triggersclientset.Interface, url, invocation, namespace, httpPath, eventID, token string, dryRun bool, writer io.Writer) error {
+	if url == "" {
+		return errors.New("the URL of the EventListener is required")
+	}
+	if token == "" {
+		return errors.New("the replay token of the EventListener is required")
+	}
+
+	var header http.Header
+	var body []byte
+	// method and path are the ones of the original request, which the
+	// routes of the Triggers match
+	method, path := http.MethodPost, ""
+	switch {
+	case invocation != "":
+		ti, err := client.TriggersV1alpha1().TriggerInvocations(namespace).Get(context.Background(), invocation, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting TriggerInvocation: %w", err)
+		}
+		if ti.Spec.Event == nil {
+			return fmt.Errorf("TriggerInvocation %s does not record its event", invocation)
+		}
+		if ti.Spec.Event.Truncated {
+			return fmt.Errorf("TriggerInvocation %s records a truncated event", invocation)
+		}
+		if ti.Spec.Event.Redacted {
+			return fmt.Errorf("TriggerInvocation %s records an event with redacted fields, replay it from a file holding the original request", invocation)
+		}
+		header = redactedHeadersRemoved(ti.Spec.Event.Header)
+		body = []byte(ti.Spec.Event.Body)
+		eventID = ti.Spec.EventID
+		if ti.Spec.Event.Method != "" {
+			method = ti.Spec.Event.Method
+		}
+		path = ti.Spec.Event.Path
+	case httpPath != "":
+		if eventID == "" {
+			return errors.New("the ID of the original event is required to replay an HTTP request")
+		}
+		request, b, err := readHTTP(httpPath)
+		if err != nil {
+			return fmt.Errorf("error reading HTTP txt file: %w", err)
+		}
+		header = request.Header
+		body = b
+		method, path = request.Method, request.URL.Path
+	default:
+		return errors.New("either a TriggerInvocation or an HTTP request is required")
+	}
+
+	if path != "" && path != "/" {
+		url = strings.TrimSuffix(url, "/") + path
+	}
+	request, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	for k, v := range header {
+		request.Header[k] = v
+	}
+	request.Header.Set(sink.ReplayOfHeader, eventID)
+	request.Header.Set(sink.ReplayTokenHeader, token)
+	if dryRun {
+		request.Header.Set(sink.ReplayDryRunHeader, "true")
+	}
+
+	resp, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("error sending event to EventListener: %w", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading EventListener response: %w", err)
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("EventListener responded with status %d: %s", resp.StatusCode, b)
+	}
+
+	var out sink.Response
+	if err := json.Unmarshal(b, &out); err != nil {
+		return fmt.Errorf("error decoding EventListener response: %w", err)
+	}
+	s, err := yaml.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("fail to print out the response: %w", err)
+	}
+	fmt.Fprintf(writer, "%s", s)
+	return nil
+}
+
+// redactedHeadersRemoved returns the recorded headers without the headers
+// whose values were redacted.
+func redactedHeadersRemoved(recorded map[string][]string) http.Header {
+	header := http.Header{}
+	for k, values := range recorded {
+		redacted := false
+		for _, v := range values {
+			if v == sink.RedactedValue {
+				redacted = true
+			}
+		}
+		if !redacted {
+			header[k] = values
+		}
+	}
+	return header
+}
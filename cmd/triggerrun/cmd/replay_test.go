@@ -0,0 +1,219 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	"github.com/tektoncd/triggers/pkg/sink"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReplay(t *testing.T) {
+	ti := &triggersv1alpha1.TriggerInvocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "original", Namespace: "default"},
+		Spec: triggersv1alpha1.TriggerInvocationSpec{
+			EventListener: "my-el",
+			EventID:       "original",
+			Event: &triggersv1alpha1.RecordedEvent{
+				Method: http.MethodPut,
+				Path:   "/github",
+				Header: map[string][]string{
+					"Content-Type":        {"application/json"},
+					"X-Hub-Signature-256": {sink.RedactedValue},
+				},
+				Body: `{"ref": "main"}`,
+			},
+		},
+	}
+	for _, tc := range []struct {
+		name       string
+		invocation string
+		httpPath   string
+		eventID    string
+		dryRun     bool
+		wantMethod string
+		wantPath   string
+		wantHeader http.Header
+		wantBody   string
+	}{{
+		name:       "TriggerInvocation",
+		invocation: "original",
+		wantMethod: http.MethodPut,
+		wantPath:   "/github",
+		wantHeader: http.Header{
+			"Content-Type":         {"application/json"},
+			sink.ReplayOfHeader:    {"original"},
+			sink.ReplayTokenHeader: {"s3cr3t"},
+		},
+		wantBody: `{"ref": "main"}`,
+	}, {
+		name:       "dry run",
+		invocation: "original",
+		dryRun:     true,
+		wantMethod: http.MethodPut,
+		wantPath:   "/github",
+		wantHeader: http.Header{
+			"Content-Type":          {"application/json"},
+			sink.ReplayOfHeader:     {"original"},
+			sink.ReplayDryRunHeader: {"true"},
+			sink.ReplayTokenHeader:  {"s3cr3t"},
+		},
+		wantBody: `{"ref": "main"}`,
+	}, {
+		name:       "HTTP request",
+		httpPath:   "../testdata/http.txt",
+		eventID:    "from-file",
+		wantMethod: http.MethodPost,
+		wantPath:   "/foo",
+		wantHeader: http.Header{
+			"Content-Type":         {"application/json"},
+			"X-Header":             {"testheader"},
+			"X-Gitlab-Event":       {"Push Hook"},
+			sink.ReplayOfHeader:    {"from-file"},
+			sink.ReplayTokenHeader: {"s3cr3t"},
+		},
+		wantBody: `{
+  "checkout_sha": "1a1736ec3d7b03349b31218a2f2c572c7c7206d6",
+  "repository": {
+    "url": "git@gitlab.com:dibyom/triggers.git"
+  }
+}`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotMethod, gotPath string
+			var gotHeader http.Header
+			var gotBody []byte
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotMethod, gotPath = r.Method, r.URL.Path
+				gotHeader = r.Header.Clone()
+				gotBody, _ = io.ReadAll(r.Body)
+				_ = json.NewEncoder(w).Encode(sink.Response{EventID: "new", ReplayOf: r.Header.Get(sink.ReplayOfHeader)})
+			}))
+			defer ts.Close()
+			_, client := getFakeTriggersClient(t, test.Resources{})
+			if _, err := client.TriggersV1alpha1().TriggerInvocations("default").Create(context.Background(), ti, metav1.CreateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+
+			buf := new(bytes.Buffer)
+			if err := replay(client, ts.URL, tc.invocation, "default", tc.httpPath, tc.eventID, "s3cr3t", tc.dryRun, buf); err != nil {
+				t.Fatalf("replay() returned error: %v", err)
+			}
+			for _, h := range []string{"Accept-Encoding", "Content-Length", "User-Agent"} {
+				gotHeader.Del(h)
+			}
+			if gotMethod != tc.wantMethod || gotPath != tc.wantPath {
+				t.Errorf("replay() sent %s %s, want %s %s", gotMethod, gotPath, tc.wantMethod, tc.wantPath)
+			}
+			if diff := cmp.Diff(tc.wantHeader, gotHeader); diff != "" {
+				t.Errorf("replay() header -want +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantBody, string(gotBody)); diff != "" {
+				t.Errorf("replay() body -want +got: %s", diff)
+			}
+			if !strings.Contains(buf.String(), "eventID: new") {
+				t.Errorf("replay() output = %q, want the response of the EventListener", buf.String())
+			}
+		})
+	}
+}
+
+func TestReplay_Error(t *testing.T) {
+	_, client := getFakeTriggersClient(t, test.Resources{})
+	for _, ti := range []*triggersv1alpha1.TriggerInvocation{{
+		ObjectMeta: metav1.ObjectMeta{Name: "truncated", Namespace: "default"},
+		Spec: triggersv1alpha1.TriggerInvocationSpec{
+			EventID: "truncated",
+			Event:   &triggersv1alpha1.RecordedEvent{Body: `{"ref`, Truncated: true},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "redacted", Namespace: "default"},
+		Spec: triggersv1alpha1.TriggerInvocationSpec{
+			EventID: "redacted",
+			Event:   &triggersv1alpha1.RecordedEvent{Body: `{"token":"[REDACTED]"}`, Redacted: true},
+		},
+	}} {
+		if _, err := client.TriggersV1alpha1().TriggerInvocations("default").Create(context.Background(), ti, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, tc := range []struct {
+		name       string
+		url        string
+		invocation string
+		httpPath   string
+		token      string
+		wantErr    string
+	}{{
+		name:       "no url",
+		invocation: "original",
+		token:      "s3cr3t",
+		wantErr:    "the URL of the EventListener is required",
+	}, {
+		name:       "no token",
+		url:        "http://el",
+		invocation: "original",
+		wantErr:    "the replay token of the EventListener is required",
+	}, {
+		name:    "no event",
+		url:     "http://el",
+		token:   "s3cr3t",
+		wantErr: "either a TriggerInvocation or an HTTP request is required",
+	}, {
+		name:     "no event ID for an HTTP request",
+		url:      "http://el",
+		httpPath: "../testdata/http.txt",
+		token:    "s3cr3t",
+		wantErr:  "the ID of the original event is required to replay an HTTP request",
+	}, {
+		name:       "missing TriggerInvocation",
+		url:        "http://el",
+		invocation: "missing",
+		token:      "s3cr3t",
+		wantErr:    "error getting TriggerInvocation",
+	}, {
+		name:       "truncated event",
+		url:        "http://el",
+		invocation: "truncated",
+		token:      "s3cr3t",
+		wantErr:    "TriggerInvocation truncated records a truncated event",
+	}, {
+		name:       "redacted event",
+		url:        "http://el",
+		invocation: "redacted",
+		token:      "s3cr3t",
+		wantErr:    "TriggerInvocation redacted records an event with redacted fields",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := replay(client, tc.url, tc.invocation, "default", tc.httpPath, "", tc.token, false, io.Discard)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("replay() = %v, want error containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
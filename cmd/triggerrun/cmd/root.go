@@ -236,7 +236,11 @@ func processTriggerSpec(kubeClient kubernetes.Interface, client triggersclientse
 	}
 	log.Infof("ResolvedParams : %+v", params)
 
-	resources := template.ResolveResources(rt.TriggerTemplate, params)
+	resources, err := template.ResolveResources(rt.TriggerTemplate, params)
+	if err != nil {
+		log.Error("Failed to resolve resources", err)
+		return nil, err
+	}
 
 	return resources, nil
 }
@@ -24,6 +24,13 @@ import (
 	"github.com/tektoncd/triggers/pkg/apis/triggers/contexts"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	eventlistenerpolicyinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/eventlistenerpolicy"
+	clustertriggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding"
+	eventlistenerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/eventlistener"
+	triggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggerbinding"
+	triggertemplateinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate"
+	"github.com/tektoncd/triggers/pkg/policy"
+	"github.com/tektoncd/triggers/pkg/template"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
@@ -44,6 +51,7 @@ var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	v1alpha1.SchemeGroupVersion.WithKind("ClusterInterceptor"):    &v1alpha1.ClusterInterceptor{},
 	v1alpha1.SchemeGroupVersion.WithKind("Interceptor"):           &v1alpha1.Interceptor{},
 	v1alpha1.SchemeGroupVersion.WithKind("EventListener"):         &v1alpha1.EventListener{},
+	v1alpha1.SchemeGroupVersion.WithKind("EventListenerPolicy"):   &v1alpha1.EventListenerPolicy{},
 	v1alpha1.SchemeGroupVersion.WithKind("TriggerBinding"):        &v1alpha1.TriggerBinding{},
 	v1alpha1.SchemeGroupVersion.WithKind("TriggerTemplate"):       &v1alpha1.TriggerTemplate{},
 	v1alpha1.SchemeGroupVersion.WithKind("Trigger"):               &v1alpha1.Trigger{},
@@ -84,6 +92,29 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 	// Decorate contexts with the current state of the config.
 	store := defaultconfig.NewStore(logging.FromContext(ctx).Named("config-store"))
 	store.WatchConfigs(cmw)
+
+	// Enforce the EventListenerPolicies on the Triggers and the
+	// EventListeners they constrain.
+	admission := &policy.Admission{
+		PolicyLister:          eventlistenerpolicyinformer.Get(ctx).Lister(),
+		EventListenerLister:   eventlistenerinformer.Get(ctx).Lister(),
+		TriggerTemplateLister: triggertemplateinformer.Get(ctx).Lister(),
+	}
+	triggerCallback := validation.NewCallback(admission.ValidateTrigger, webhook.Create, webhook.Update)
+	eventListenerCallback := validation.NewCallback(admission.ValidateEventListener, webhook.Create, webhook.Update)
+	callbacks := map[schema.GroupVersionKind]validation.Callback{
+		v1alpha1.SchemeGroupVersion.WithKind("Trigger"):       triggerCallback,
+		v1beta1.SchemeGroupVersion.WithKind("Trigger"):        triggerCallback,
+		v1alpha1.SchemeGroupVersion.WithKind("EventListener"): eventListenerCallback,
+		v1beta1.SchemeGroupVersion.WithKind("EventListener"):  eventListenerCallback,
+	}
+
+	// Warn about Triggers whose bindings and template don't agree on params.
+	analyzer := template.NewTriggerParamsAnalyzer(
+		triggerbindinginformer.Get(ctx).Lister(),
+		clustertriggerbindinginformer.Get(ctx).Lister(),
+		triggertemplateinformer.Get(ctx).Lister())
+
 	return validation.NewAdmissionController(ctx,
 
 		// Name of the resource webhook.
@@ -97,11 +128,14 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
 		func(ctx context.Context) context.Context {
-			return contexts.WithUpgradeViaDefaulting(store.ToContext(ctx))
+			return v1beta1.WithTriggerParamsAnalyzer(contexts.WithUpgradeViaDefaulting(store.ToContext(ctx)), analyzer)
 		},
 
 		// Whether to disallow unknown fields.
 		true,
+
+		// Extra validating callbacks to be applied to resources.
+		callbacks,
 	)
 }
 
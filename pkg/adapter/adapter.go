@@ -25,10 +25,13 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	clusterinterceptorsinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/clusterinterceptor"
+	eventlistenerpoliciesinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/eventlistenerpolicy"
 	interceptorsinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/interceptor"
 	clustertriggerbindingsinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding"
 	eventlistenerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/eventlistener"
@@ -45,6 +48,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	v1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"knative.dev/eventing/pkg/adapter/v2"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
@@ -205,11 +209,49 @@ func (s *sinker) getCertFromInterceptor(certPool *x509.CertPool) error {
 	return nil
 }
 
+// startAdminServer serves the probes, metrics and profiles of the
+// EventListener on the admin port until ctx is done.
+func (s *sinker) startAdminServer(ctx context.Context, r *readiness) {
+	srv := &http.Server{
+		Addr:              ":" + s.Args.AdminPort,
+		ReadHeaderTimeout: s.Args.ELReadTimeOut * time.Second, //nolint:durationcheck
+		Handler:           adminHandler(r, s.Args.AdminProfiling),
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.Logger.Errorf("admin server failed: %v", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			s.Logger.Errorf("failed to close the admin server: %v", err)
+		}
+	}()
+}
+
 func (s *sinker) Start(ctx context.Context) error {
+	ready := &readiness{
+		synced: []cache.InformerSynced{
+			eventlistenerinformer.Get(s.injCtx).Informer().HasSynced,          //nolint:contextcheck
+			triggersinformer.Get(s.injCtx).Informer().HasSynced,               //nolint:contextcheck
+			triggerbindingsinformer.Get(s.injCtx).Informer().HasSynced,        //nolint:contextcheck
+			clustertriggerbindingsinformer.Get(s.injCtx).Informer().HasSynced, //nolint:contextcheck
+			triggertemplatesinformer.Get(s.injCtx).Informer().HasSynced,       //nolint:contextcheck
+			clusterinterceptorsinformer.Get(s.injCtx).Informer().HasSynced,    //nolint:contextcheck
+			interceptorsinformer.Get(s.injCtx).Informer().HasSynced,           //nolint:contextcheck
+			eventlistenerpoliciesinformer.Get(s.injCtx).Informer().HasSynced,  //nolint:contextcheck
+		},
+	}
+	if s.Args.AdminPort != "" {
+		s.startAdminServer(ctx, ready)
+	}
+
 	clientObj, err := s.getHTTPClient()
 	if err != nil {
 		return err
 	}
+	ready.caLoaded.Store(true)
 	// Create EventListener Sink
 
 	dynamicClient := dynamicclient.Get(ctx)
@@ -229,6 +271,8 @@ func (s *sinker) Start(ctx context.Context) error {
 		CloudEventURI:          s.Args.CloudEventURI,
 		Auth:                   sink.DefaultAuthOverride{},
 		WGProcessTriggers:      &sync.WaitGroup{},
+		WGCloudEvents:          &sync.WaitGroup{},
+		PendingEvents:          &atomic.Int64{},
 		EventRecorder:          s.createRecorder(s.injCtx, "EventListener"), //nolint:contextcheck
 
 		// Register all the listers we'll need
@@ -239,20 +283,35 @@ func (s *sinker) Start(ctx context.Context) error {
 		TriggerTemplateLister:       triggertemplatesinformer.Get(s.injCtx).Lister(),       //nolint:contextcheck
 		ClusterInterceptorLister:    clusterinterceptorsinformer.Get(s.injCtx).Lister(),    //nolint:contextcheck
 		InterceptorLister:           interceptorsinformer.Get(s.injCtx).Lister(),           //nolint:contextcheck
+		EventListenerPolicyLister:   eventlistenerpoliciesinformer.Get(s.injCtx).Lister(),  //nolint:contextcheck
 	}
 
+	identity, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to get the hostname: %w", err)
+	}
+	// The event sources stop once ctx is done, before the EventListener is
+	// drained.
+	sources := &sync.WaitGroup{}
+	sources.Add(2)
+	go func() {
+		defer sources.Done()
+		r.RunScheduler(ctx, identity)
+	}()
+	go func() {
+		defer sources.Done()
+		r.RunMessageSources(ctx)
+	}()
+
 	mux := http.NewServeMux()
 	eventHandler := http.HandlerFunc(r.HandleEvent)
 	metricsRecorder := &sink.MetricsHandler{Handler: r.IsValidPayload(eventHandler)}
 
 	mux.HandleFunc("/", metricsRecorder.Intercept(r.NewMetricsRecorderInterceptor()))
 
-	// For handling Liveness Probe
-	// TODO(dibyom): Livness, metrics etc. should be on a separate port
-	mux.HandleFunc("/live", func(w http.ResponseWriter, _ *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprint(w, "ok")
-	})
+	// For handling the Liveness Probe of EventListeners that don't serve an
+	// admin port, e.g. those using a custom resource.
+	mux.HandleFunc("/live", live)
 
 	srv := &http.Server{
 		Addr:              ":" + s.Args.Port,
@@ -264,18 +323,60 @@ func (s *sinker) Start(ctx context.Context) error {
 			s.Args.ELTimeOutHandler*time.Second, "EventListener Timeout!\n"), //nolint:durationcheck
 	}
 
-	if s.Args.Cert == "" && s.Args.Key == "" {
-		if err := srv.ListenAndServe(); err != nil {
-			return err
+	serve := srv.ListenAndServe
+	if s.Args.Cert != "" || s.Args.Key != "" {
+		// Serve the certificate through GetCertificate so that a rotated
+		// certificate is used without restarting the EventListener.
+		reloader, err := newCertReloader(s.Args.Cert, s.Args.Key, s.Logger)
+		if err != nil {
+			return fmt.Errorf("failed to load the TLS certificate: %w", err)
 		}
-	} else {
-		if err := srv.ListenAndServeTLS(s.Args.Cert, s.Args.Key); err != nil {
-			return err
+		srv.TLSConfig = &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: reloader.GetCertificate,
 		}
+		serve = func() error { return srv.ListenAndServeTLS("", "") }
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serve()
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
 	}
+	ready.draining.Store(true)
+	s.shutdown(srv, sources, r)
 	return nil
 }
 
+// shutdown stops the EventListener from accepting new events, waits for its
+// event sources to stop, and waits for the events being processed to be
+// done, until the drain timeout.
+func (s *sinker) shutdown(srv *http.Server, sources *sync.WaitGroup, r sink.Sink) {
+	s.Logger.Info("shutting down the EventListener")
+	ctx, cancel := context.WithTimeout(context.Background(), s.Args.ELDrainTimeOut*time.Second) //nolint:durationcheck
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		s.Logger.Errorf("failed to shut down the EventListener server: %v", err)
+	}
+	stopped := make(chan struct{})
+	go func() {
+		sources.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.Logger.Error("timed out stopping the event sources of the EventListener")
+	}
+	if incomplete := r.Drain(ctx); incomplete == 0 {
+		s.Logger.Info("drained the EventListener")
+	}
+}
+
 func New(sinkArgs sink.Args, sinkClients sink.Clients, recorder *sink.Recorder) adapter.AdapterConstructor {
 	return func(ctx context.Context, processed adapter.EnvConfigAccessor, _ cloudevents.Client) adapter.Adapter {
 		env := processed.(*envConfig)
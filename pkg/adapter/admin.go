@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"k8s.io/client-go/tools/cache"
+)
+
+// readiness tracks whether the EventListener is ready to process events:
+// its informers are synced, the CA bundles of the interceptors are loaded,
+// and it isn't shutting down.
+type readiness struct {
+	synced   []cache.InformerSynced
+	caLoaded atomic.Bool
+	draining atomic.Bool
+}
+
+func (r *readiness) ready() error {
+	if r.draining.Load() {
+		return errors.New("draining")
+	}
+	for _, synced := range r.synced {
+		if !synced() {
+			return errors.New("informers are not synced")
+		}
+	}
+	if !r.caLoaded.Load() {
+		return errors.New("interceptor CA bundles are not loaded")
+	}
+	return nil
+}
+
+func live(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "ok")
+}
+
+// adminHandler returns the handler of the admin port, serving the probes,
+// the metrics and, if enabled, the pprof profiles of the EventListener.
+func adminHandler(r *readiness, profiling bool) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/live", live)
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, _ *http.Request) {
+		if err := r.ready(); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "ok")
+	})
+	mux.Handle("/metrics", promhttp.Handler())
+	if profiling {
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
+	return mux
+}
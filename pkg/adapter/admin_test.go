@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+func adminStatus(t *testing.T, h http.Handler, path string) int {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec.Code
+}
+
+func TestAdminHandler(t *testing.T) {
+	synced := false
+	r := &readiness{synced: []cache.InformerSynced{func() bool { return synced }}}
+	h := adminHandler(r, false)
+
+	for _, tt := range []struct {
+		name     string
+		synced   bool
+		caLoaded bool
+		want     int
+	}{{
+		name: "not synced",
+		want: http.StatusServiceUnavailable,
+	}, {
+		name:   "CA not loaded",
+		synced: true,
+		want:   http.StatusServiceUnavailable,
+	}, {
+		name:     "ready",
+		synced:   true,
+		caLoaded: true,
+		want:     http.StatusOK,
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			synced = tt.synced
+			r.caLoaded.Store(tt.caLoaded)
+			if got := adminStatus(t, h, "/ready"); got != tt.want {
+				t.Errorf("/ready = %d, want %d", got, tt.want)
+			}
+			if got := adminStatus(t, h, "/live"); got != http.StatusOK {
+				t.Errorf("/live = %d, want %d", got, http.StatusOK)
+			}
+		})
+	}
+
+	if got := adminStatus(t, h, "/metrics"); got != http.StatusOK {
+		t.Errorf("/metrics = %d, want %d", got, http.StatusOK)
+	}
+	if got := adminStatus(t, h, "/debug/pprof/"); got != http.StatusNotFound {
+		t.Errorf("/debug/pprof/ = %d without profiling, want %d", got, http.StatusNotFound)
+	}
+	if got := adminStatus(t, adminHandler(r, true), "/debug/pprof/"); got != http.StatusOK {
+		t.Errorf("/debug/pprof/ = %d with profiling, want %d", got, http.StatusOK)
+	}
+}
+
+func TestAdminHandlerDraining(t *testing.T) {
+	r := &readiness{}
+	r.caLoaded.Store(true)
+	h := adminHandler(r, false)
+	if got := adminStatus(t, h, "/ready"); got != http.StatusOK {
+		t.Fatalf("/ready = %d before draining, want %d", got, http.StatusOK)
+	}
+	r.draining.Store(true)
+	if got := adminStatus(t, h, "/ready"); got != http.StatusServiceUnavailable {
+		t.Errorf("/ready = %d while draining, want %d", got, http.StatusServiceUnavailable)
+	}
+	if got := adminStatus(t, h, "/live"); got != http.StatusOK {
+		t.Errorf("/live = %d while draining, want %d", got, http.StatusOK)
+	}
+}
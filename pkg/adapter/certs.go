@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// certReloader serves the certificate and key files the EventListener is
+// started with, and reloads them when they change on disk, e.g. when the
+// kubelet updates the mounted Secret after the certificate is rotated.
+type certReloader struct {
+	certFile, keyFile string
+	logger            *zap.SugaredLogger
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+func newCertReloader(certFile, keyFile string, logger *zap.SugaredLogger) (*certReloader, error) {
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		logger:   logger,
+	}
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.reload(modTime); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	modTime, err := r.latestModTime()
+	r.mu.RLock()
+	cert, changed := r.cert, err == nil && !modTime.Equal(r.modTime)
+	r.mu.RUnlock()
+	if !changed {
+		return cert, nil
+	}
+	if err := r.reload(modTime); err != nil {
+		// The files may not be consistent while they're being updated, keep
+		// serving the previous certificate until they are.
+		r.logger.Warnf("failed to reload TLS certificate %s: %v", r.certFile, err)
+		return cert, nil
+	}
+	r.logger.Infof("reloaded TLS certificate %s", r.certFile)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+func (r *certReloader) reload(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.modTime = modTime
+	return nil
+}
+
+// latestModTime returns when the certificate or key file last changed.
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, f := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(f)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
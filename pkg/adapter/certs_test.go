@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	certresources "knative.dev/pkg/webhook/certificates/resources"
+)
+
+func writeCerts(t *testing.T, certFile, keyFile string, modTime time.Time) []byte {
+	t.Helper()
+	serverKey, serverCert, _, err := certresources.CreateCerts(context.Background(), "el-name", "namespace", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for f, data := range map[string][]byte{certFile: serverCert, keyFile: serverKey} {
+		if err := os.WriteFile(f, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(f, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return serverCert
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	now := time.Now()
+	writeCerts(t, certFile, keyFile, now)
+
+	reloader, err := newCertReloader(certFile, keyFile, zaptest.NewLogger(t).Sugar())
+	if err != nil {
+		t.Fatalf("newCertReloader() = %v", err)
+	}
+	first, err := reloader.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate() = %v", err)
+	}
+
+	rotated := writeCerts(t, certFile, keyFile, now.Add(time.Minute))
+	got, err := reloader.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate() = %v", err)
+	}
+	block, _ := pem.Decode(rotated)
+	if got == first || !bytes.Equal(got.Certificate[0], block.Bytes) {
+		t.Fatal("GetCertificate() didn't reload the rotated certificate")
+	}
+
+	// An invalid key keeps the previous certificate served.
+	if err := os.WriteFile(keyFile, []byte("invalid"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(keyFile, now.Add(2*time.Minute), now.Add(2*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if partial, err := reloader.GetCertificate(nil); err != nil || partial != got {
+		t.Fatalf("GetCertificate() = %v, %v, want the previous certificate", partial, err)
+	}
+}
+
+func TestNewCertReloaderMissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := newCertReloader(filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), zaptest.NewLogger(t).Sugar()); err == nil {
+		t.Fatal("newCertReloader() expected an error for missing files")
+	}
+}
@@ -15,4 +15,8 @@ const (
 
 	// TriggerGroupLabelKey is used as a label identifier for a TriggerGroup
 	TriggerGroupLabelKey = "/triggergroup"
+
+	// ReplayOfLabelKey is used as the label identifier for the original event
+	// of a replayed event.
+	ReplayOfLabelKey = "/replay-of"
 )
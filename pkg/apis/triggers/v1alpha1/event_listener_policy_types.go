@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+// Check that EventListenerPolicy may be validated and defaulted.
+var _ apis.Validatable = (*EventListenerPolicy)(nil)
+var _ apis.Defaultable = (*EventListenerPolicy)(nil)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// EventListenerPolicy constrains the Triggers processed by EventListeners
+// serving several namespaces: which namespaces they are in, which
+// ServiceAccounts they impersonate, which kinds of resources they create and
+// what they do with them. It also constrains the resources watched by the
+// Kubernetes event sources of the EventListeners, and the Secrets read by
+// them to poll repositories and consume messages. It is enforced both when
+// Triggers and EventListeners are admitted and when the EventListeners
+// process events.
+type EventListenerPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec EventListenerPolicySpec `json:"spec"`
+}
+
+// EventListenerPolicySpec holds the constraints of an EventListenerPolicy.
+// Unset constraints allow anything. When several policies constrain an
+// EventListener, a Trigger must be allowed by all of them.
+type EventListenerPolicySpec struct {
+	// EventListeners are the EventListeners constrained by the policy.
+	// +listType=atomic
+	EventListeners []EventListenerReference `json:"eventListeners"`
+	// Namespaces are the namespaces, in addition to the namespace of each
+	// EventListener, whose Triggers the EventListeners may process. They are
+	// also the namespaces, in addition to the namespace of each Trigger, in
+	// which the Triggers may create resources. Unlike the other constraints,
+	// an unset Namespaces only lets Triggers create resources in their own
+	// namespace.
+	// +optional
+	// +listType=atomic
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ServiceAccountNames are the names of the ServiceAccounts the Triggers
+	// may create resources with, in the namespace of each Trigger. When set,
+	// Triggers outside of the namespace of the EventListener must set one of
+	// them, rather than creating resources with the ServiceAccount of the
+	// EventListener.
+	// +optional
+	// +listType=atomic
+	ServiceAccountNames []string `json:"serviceAccountNames,omitempty"`
+	// Resources are the kinds of resources the Triggers may create.
+	// +optional
+	// +listType=atomic
+	Resources []PolicyResource `json:"resources,omitempty"`
+	// Actions are the actions the Triggers may perform on their resources,
+	// among create, apply, patch and delete.
+	// +optional
+	// +listType=atomic
+	Actions []string `json:"actions,omitempty"`
+	// SecretNames are the names of the Secrets the EventListeners may read to
+	// authenticate to the repositories polled by Triggers, in the namespace
+	// of each Trigger, and to the brokers of their message sources, in the
+	// namespace of each EventListener.
+	// +optional
+	// +listType=atomic
+	SecretNames []string `json:"secretNames,omitempty"`
+	// KubernetesEventSources constrain the resources watched by the
+	// Kubernetes event sources of the EventListeners.
+	// +optional
+	KubernetesEventSources *PolicyKubernetesEventSources `json:"kubernetesEventSources,omitempty"`
+}
+
+// PolicyKubernetesEventSources constrain the resources watched by Kubernetes
+// event sources. Unset constraints allow anything.
+type PolicyKubernetesEventSources struct {
+	// Resources are the kinds of resources the sources may watch.
+	// +optional
+	// +listType=atomic
+	Resources []PolicyResource `json:"resources,omitempty"`
+	// Namespaces are the namespaces, in addition to the namespace of each
+	// EventListener, whose resources the sources may watch. Cluster-scoped
+	// resources aren't constrained by namespace.
+	// +optional
+	// +listType=atomic
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// EventListenerReference refers to an EventListener.
+type EventListenerReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// PolicyResource is a kind of resources allowed by an EventListenerPolicy.
+type PolicyResource struct {
+	// Group is the API group of the resources, empty for the core group, or
+	// * for any group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources, or * for any kind of the group.
+	Kind string `json:"kind"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// EventListenerPolicyList contains a list of EventListenerPolicy
+type EventListenerPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventListenerPolicy `json:"items"`
+}
+
+// Constrains returns whether the policy constrains an EventListener.
+func (s *EventListenerPolicySpec) Constrains(namespace, name string) bool {
+	for _, ref := range s.EventListeners {
+		if ref.Namespace == namespace && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsNamespace returns whether an EventListener in elNamespace may process
+// the Triggers of a namespace.
+func (s *EventListenerPolicySpec) AllowsNamespace(elNamespace, namespace string) bool {
+	if len(s.Namespaces) == 0 || namespace == elNamespace {
+		return true
+	}
+	for _, ns := range s.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsServiceAccount returns whether an EventListener in elNamespace may
+// process a Trigger of a namespace with a ServiceAccount, which is empty when
+// the Trigger uses the ServiceAccount of the EventListener.
+func (s *EventListenerPolicySpec) AllowsServiceAccount(elNamespace, namespace, serviceAccount string) bool {
+	if len(s.ServiceAccountNames) == 0 {
+		return true
+	}
+	if serviceAccount == "" {
+		return namespace == elNamespace
+	}
+	for _, name := range s.ServiceAccountNames {
+		if name == serviceAccount {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsKind returns whether the Triggers may create resources of a kind.
+func (s *EventListenerPolicySpec) AllowsKind(gk schema.GroupKind) bool {
+	return allowsKind(s.Resources, gk)
+}
+
+// AllowsAction returns whether the Triggers may perform an action on their
+// resources.
+func (s *EventListenerPolicySpec) AllowsAction(action string) bool {
+	return len(s.Actions) == 0 || slices.Contains(s.Actions, action)
+}
+
+// AllowsResourceNamespace returns whether the Triggers of a namespace may
+// create resources in a target namespace.
+func (s *EventListenerPolicySpec) AllowsResourceNamespace(namespace, target string) bool {
+	return target == namespace || slices.Contains(s.Namespaces, target)
+}
+
+// AllowsSecret returns whether the EventListeners may read a Secret to poll
+// repositories or consume messages.
+func (s *EventListenerPolicySpec) AllowsSecret(name string) bool {
+	return len(s.SecretNames) == 0 || slices.Contains(s.SecretNames, name)
+}
+
+// AllowsWatch returns whether the Kubernetes event sources of an
+// EventListener in elNamespace may watch the resources of a kind in a
+// namespace, which is empty for cluster-scoped resources and "*" for all
+// namespaces.
+func (s *EventListenerPolicySpec) AllowsWatch(elNamespace string, gk schema.GroupKind, namespace string) bool {
+	sources := s.KubernetesEventSources
+	if sources == nil {
+		return true
+	}
+	if !allowsKind(sources.Resources, gk) {
+		return false
+	}
+	if len(sources.Namespaces) == 0 || namespace == "" || namespace == elNamespace {
+		return true
+	}
+	return slices.Contains(sources.Namespaces, namespace)
+}
+
+func allowsKind(resources []PolicyResource, gk schema.GroupKind) bool {
+	if len(resources) == 0 {
+		return true
+	}
+	for _, r := range resources {
+		if (r.Group == "*" || r.Group == gk.Group) && (r.Kind == "*" || r.Kind == gk.Kind) {
+			return true
+		}
+	}
+	return false
+}
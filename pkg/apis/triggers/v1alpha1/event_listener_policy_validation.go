@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"knative.dev/pkg/apis"
+)
+
+// Validate EventListenerPolicy
+func (p *EventListenerPolicy) Validate(ctx context.Context) *apis.FieldError {
+	if apis.IsInDelete(ctx) {
+		return nil
+	}
+	return p.Spec.validate().ViaField("spec")
+}
+
+func (s *EventListenerPolicySpec) validate() (errs *apis.FieldError) {
+	if len(s.EventListeners) == 0 {
+		errs = errs.Also(apis.ErrMissingField("eventListeners"))
+	}
+	for i, ref := range s.EventListeners {
+		if ref.Namespace == "" {
+			errs = errs.Also(apis.ErrMissingField("namespace").ViaFieldIndex("eventListeners", i))
+		}
+		if ref.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("eventListeners", i))
+		}
+	}
+	for i, ns := range s.Namespaces {
+		if msgs := validation.IsDNS1123Label(ns); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidArrayValue(fmt.Sprintf("%s: %s", ns, strings.Join(msgs, ", ")), "namespaces", i))
+		}
+	}
+	for i, name := range s.ServiceAccountNames {
+		if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidArrayValue(fmt.Sprintf("%s: %s", name, strings.Join(msgs, ", ")), "serviceAccountNames", i))
+		}
+	}
+	errs = errs.Also(validatePolicyResources(s.Resources))
+	for i, action := range s.Actions {
+		switch action {
+		case triggers.CreateAction, triggers.ApplyAction, triggers.PatchAction, triggers.DeleteAction:
+		default:
+			errs = errs.Also(apis.ErrInvalidArrayValue(action, "actions", i))
+		}
+	}
+	for i, name := range s.SecretNames {
+		if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidArrayValue(fmt.Sprintf("%s: %s", name, strings.Join(msgs, ", ")), "secretNames", i))
+		}
+	}
+	if sources := s.KubernetesEventSources; sources != nil {
+		errs = errs.Also(validatePolicyResources(sources.Resources).ViaField("kubernetesEventSources"))
+		for i, ns := range sources.Namespaces {
+			if msgs := validation.IsDNS1123Label(ns); len(msgs) > 0 {
+				errs = errs.Also(apis.ErrInvalidArrayValue(fmt.Sprintf("%s: %s", ns, strings.Join(msgs, ", ")), "namespaces", i).ViaField("kubernetesEventSources"))
+			}
+		}
+	}
+	return errs
+}
+
+func validatePolicyResources(resources []PolicyResource) (errs *apis.FieldError) {
+	for i, r := range resources {
+		if r.Kind == "" {
+			errs = errs.Also(apis.ErrMissingField("kind").ViaFieldIndex("resources", i))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+)
+
+func TestEventListenerPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		spec triggersv1.EventListenerPolicySpec
+		want *apis.FieldError
+	}{{
+		name: "valid",
+		spec: triggersv1.EventListenerPolicySpec{
+			EventListeners:      []triggersv1.EventListenerReference{{Namespace: "triggers", Name: "shared"}},
+			Namespaces:          []string{"team-a"},
+			ServiceAccountNames: []string{"triggers"},
+			Resources:           []triggersv1.PolicyResource{{Group: "tekton.dev", Kind: "*"}},
+			Actions:             []string{"create", "apply"},
+			SecretNames:         []string{"git-credentials"},
+			KubernetesEventSources: &triggersv1.PolicyKubernetesEventSources{
+				Resources:  []triggersv1.PolicyResource{{Group: "tekton.dev", Kind: "PipelineRun"}},
+				Namespaces: []string{"team-a"},
+			},
+		},
+	}, {
+		name: "missing eventListeners",
+		spec: triggersv1.EventListenerPolicySpec{},
+		want: apis.ErrMissingField("spec.eventListeners"),
+	}, {
+		name: "invalid fields",
+		spec: triggersv1.EventListenerPolicySpec{
+			EventListeners:      []triggersv1.EventListenerReference{{Name: "shared"}},
+			Namespaces:          []string{"Team_A"},
+			ServiceAccountNames: []string{"triggers"},
+			Resources:           []triggersv1.PolicyResource{{Group: "tekton.dev"}},
+			Actions:             []string{"replace"},
+			KubernetesEventSources: &triggersv1.PolicyKubernetesEventSources{
+				Resources:  []triggersv1.PolicyResource{{Group: "tekton.dev"}},
+				Namespaces: []string{"Team_A"},
+			},
+		},
+		want: apis.ErrMissingField("spec.eventListeners[0].namespace").
+			Also(apis.ErrInvalidArrayValue("Team_A: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')", "spec.namespaces", 0)).
+			Also(apis.ErrMissingField("spec.resources[0].kind")).
+			Also(apis.ErrInvalidArrayValue("replace", "spec.actions", 0)).
+			Also(apis.ErrMissingField("spec.kubernetesEventSources.resources[0].kind")).
+			Also(apis.ErrInvalidArrayValue("Team_A: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')", "spec.kubernetesEventSources.namespaces", 0)),
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := triggersv1.EventListenerPolicy{ObjectMeta: metav1.ObjectMeta{Name: "tenants"}, Spec: tc.spec}
+			got := p.Validate(context.Background())
+			if diff := cmp.Diff(tc.want.Error(), got.Error()); diff != "" {
+				t.Fatalf("EventListenerPolicy.Validate() error: %s", diff)
+			}
+		})
+	}
+}
+
+func TestEventListenerPolicyAllows(t *testing.T) {
+	spec := triggersv1.EventListenerPolicySpec{
+		Namespaces:          []string{"team-a"},
+		ServiceAccountNames: []string{"triggers"},
+		Resources:           []triggersv1.PolicyResource{{Group: "tekton.dev", Kind: "*"}, {Kind: "ConfigMap"}},
+		Actions:             []string{"create"},
+		SecretNames:         []string{"git-credentials"},
+		KubernetesEventSources: &triggersv1.PolicyKubernetesEventSources{
+			Resources:  []triggersv1.PolicyResource{{Group: "tekton.dev", Kind: "PipelineRun"}, {Kind: "Namespace"}},
+			Namespaces: []string{"team-a"},
+		},
+	}
+	pipelineRun := schema.GroupKind{Group: "tekton.dev", Kind: "PipelineRun"}
+	for _, tc := range []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{name: "namespace of the EventListener", got: spec.AllowsNamespace("triggers", "triggers"), want: true},
+		{name: "allowed namespace", got: spec.AllowsNamespace("triggers", "team-a"), want: true},
+		{name: "other namespace", got: spec.AllowsNamespace("triggers", "team-b")},
+		{name: "resource in the namespace of the Trigger", got: spec.AllowsResourceNamespace("team-b", "team-b"), want: true},
+		{name: "resource in allowed namespace", got: spec.AllowsResourceNamespace("team-b", "team-a"), want: true},
+		{name: "resource in other namespace", got: spec.AllowsResourceNamespace("team-a", "triggers")},
+		{name: "resource in any namespace without namespaces", got: (&triggersv1.EventListenerPolicySpec{}).AllowsResourceNamespace("team-a", "triggers")},
+		{name: "allowed service account", got: spec.AllowsServiceAccount("triggers", "team-a", "triggers"), want: true},
+		{name: "other service account", got: spec.AllowsServiceAccount("triggers", "team-a", "admin")},
+		{name: "no service account in the namespace of the EventListener", got: spec.AllowsServiceAccount("triggers", "triggers", ""), want: true},
+		{name: "no service account in another namespace", got: spec.AllowsServiceAccount("triggers", "team-a", "")},
+		{name: "any kind of group", got: spec.AllowsKind(schema.GroupKind{Group: "tekton.dev", Kind: "PipelineRun"}), want: true},
+		{name: "core kind", got: spec.AllowsKind(schema.GroupKind{Kind: "ConfigMap"}), want: true},
+		{name: "other kind", got: spec.AllowsKind(schema.GroupKind{Kind: "Secret"})},
+		{name: "allowed action", got: spec.AllowsAction("create"), want: true},
+		{name: "other action", got: spec.AllowsAction("delete")},
+		{name: "allowed secret", got: spec.AllowsSecret("git-credentials"), want: true},
+		{name: "other secret", got: spec.AllowsSecret("admin-token")},
+		{name: "watch in the namespace of the EventListener", got: spec.AllowsWatch("triggers", pipelineRun, "triggers"), want: true},
+		{name: "watch in allowed namespace", got: spec.AllowsWatch("triggers", pipelineRun, "team-a"), want: true},
+		{name: "watch in other namespace", got: spec.AllowsWatch("triggers", pipelineRun, "team-b")},
+		{name: "watch cluster-scoped kind", got: spec.AllowsWatch("triggers", schema.GroupKind{Kind: "Namespace"}, ""), want: true},
+		{name: "watch other kind", got: spec.AllowsWatch("triggers", schema.GroupKind{Kind: "ConfigMap"}, "triggers")},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s: got %t, want %t", tc.name, tc.got, tc.want)
+		}
+	}
+}
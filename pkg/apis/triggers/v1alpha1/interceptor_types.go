@@ -127,6 +127,11 @@ type TriggerContext struct {
 	EventID string `json:"event_id,omitempty"`
 	// TriggerID is of the form namespace/$ns/triggers/$name
 	TriggerID string `json:"trigger_id,omitempty"`
+	// VerifiedReplay is true when the event is a replay of a recorded event
+	// authenticated by the EventListener. Interceptors don't verify the
+	// signature of such events, since their signature headers are redacted
+	// when recorded.
+	VerifiedReplay bool `json:"verified_replay,omitempty"`
 }
 
 // Do not generate Deepcopy(). See #827
@@ -52,10 +52,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterTriggerBindingList{},
 		&EventListener{},
 		&EventListenerList{},
+		&EventListenerPolicy{},
+		&EventListenerPolicyList{},
 		&Interceptor{},
 		&InterceptorList{},
 		&TriggerBinding{},
 		&TriggerBindingList{},
+		&TriggerInvocation{},
+		&TriggerInvocationList{},
 		&TriggerTemplate{},
 		&TriggerTemplateList{},
 		&Trigger{},
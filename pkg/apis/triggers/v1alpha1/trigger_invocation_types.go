@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +genclient
+// +genreconciler:krshapedlogic=false
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// TriggerInvocation records an event processed by an EventListener, along with
+// the outcome of each Trigger that processed it. TriggerInvocations are
+// written by the EventListener and deleted by the controller once their TTL
+// expires.
+type TriggerInvocation struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TriggerInvocationSpec `json:"spec"`
+	// +optional
+	Status TriggerInvocationStatus `json:"status"`
+}
+
+// TriggerInvocationSpec holds the record of an event processed by an
+// EventListener.
+type TriggerInvocationSpec struct {
+	// EventListener is the name of the EventListener that processed the event
+	EventListener string `json:"eventListener"`
+	// EventID is the ID assigned to the event by the EventListener
+	EventID string `json:"eventID"`
+	// ReplayOf is the ID of the original event when the event is a replay
+	// +optional
+	ReplayOf string `json:"replayOf,omitempty"`
+	// Event is the incoming event
+	// +optional
+	Event *RecordedEvent `json:"event,omitempty"`
+	// Triggers holds the outcome of each Trigger that processed the event
+	// +optional
+	// +listType=atomic
+	Triggers []TriggerOutcome `json:"triggers,omitempty"`
+	// TTL is how long the TriggerInvocation is kept after it is created. If
+	// not set, the TriggerInvocation is kept until it is deleted.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// RecordedEvent is an incoming event recorded by an EventListener.
+type RecordedEvent struct {
+	// Method is the HTTP method of the request of the event
+	// +optional
+	Method string `json:"method,omitempty"`
+	// Path is the HTTP path of the request of the event
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Header holds the headers of the event, with the values of sensitive
+	// headers redacted
+	// +optional
+	Header map[string][]string `json:"header,omitempty"`
+	// Body is the body of the event
+	// +optional
+	Body string `json:"body,omitempty"`
+	// Truncated is true if the body was larger than the maximum payload size
+	// and was cut short
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+	// Redacted is true if fields of the body were replaced with [REDACTED],
+	// so that the body isn't the one of the original event
+	// +optional
+	Redacted bool `json:"redacted,omitempty"`
+}
+
+// TriggerOutcomeStatus is the outcome of a Trigger for an event.
+type TriggerOutcomeStatus string
+
+const (
+	// TriggerOutcomeSucceeded is used when the resources of the Trigger were
+	// created.
+	TriggerOutcomeSucceeded TriggerOutcomeStatus = "Succeeded"
+	// TriggerOutcomeStopped is used when an interceptor stopped the processing
+	// of the Trigger.
+	TriggerOutcomeStopped TriggerOutcomeStatus = "Stopped"
+	// TriggerOutcomeFailed is used when the Trigger failed to process the event.
+	TriggerOutcomeFailed TriggerOutcomeStatus = "Failed"
+)
+
+// TriggerOutcome records how a Trigger, or the interceptors of a TriggerGroup,
+// processed an event.
+type TriggerOutcome struct {
+	// Name is the name of the Trigger. It is empty when the interceptors of a
+	// TriggerGroup stopped processing before any Trigger was selected.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the Trigger
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// TriggerGroup is the name of the TriggerGroup whose interceptors stopped
+	// processing
+	// +optional
+	TriggerGroup string `json:"triggerGroup,omitempty"`
+	// Status is the outcome of the Trigger
+	Status TriggerOutcomeStatus `json:"status"`
+	// Message holds the error, or the status message of the interceptor that
+	// stopped processing
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Params are the params resolved for the TriggerTemplate
+	// +optional
+	// +listType=atomic
+	Params []Param `json:"params,omitempty"`
+	// Resources are references to the resources created by the Trigger
+	// +optional
+	// +listType=atomic
+	Resources []ResourceReference `json:"resources,omitempty"`
+}
+
+// ResourceReference refers to a resource created by a Trigger.
+type ResourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource, as returned by the API server
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// TriggerInvocationStatus holds the status of the TriggerInvocation
+// +k8s:deepcopy-gen=true
+type TriggerInvocationStatus struct {
+	duckv1.Status `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// TriggerInvocationList contains a list of TriggerInvocation
+type TriggerInvocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TriggerInvocation `json:"items"`
+}
+
+// ExpirationTime returns the time at which the TriggerInvocation expires, and
+// false if it is kept until it is deleted.
+func (ti *TriggerInvocation) ExpirationTime() (time.Time, bool) {
+	if ti.Spec.TTL == nil {
+		return time.Time{}, false
+	}
+	return ti.CreationTimestamp.Add(ti.Spec.TTL.Duration), true
+}
@@ -27,6 +27,7 @@ var _ apis.Defaultable = (*ClusterTriggerBinding)(nil)
 
 // +genclient
 // +genclient:nonNamespaced
+// +genreconciler:krshapedlogic=false
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:openapi-gen=true
 
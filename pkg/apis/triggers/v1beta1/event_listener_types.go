@@ -18,9 +18,11 @@ package v1beta1
 
 import (
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -28,6 +30,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/apis/duck/v1beta1"
 	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
 )
 
 // Check that EventListener may be validated and defaulted.
@@ -67,11 +70,295 @@ type EventListenerSpec struct {
 	LabelSelector     *metav1.LabelSelector       `json:"labelSelector,omitempty"`
 	Resources         Resources                   `json:"resources,omitempty"`
 	CloudEventURI     string                      `json:"cloudEventURI,omitempty"`
+	// KubernetesEventSources fire the Triggers of the EventListener when
+	// Kubernetes resources are added, updated or deleted.
+	// +listType=atomic
+	// +optional
+	KubernetesEventSources []KubernetesEventSource `json:"kubernetesEventSources,omitempty"`
+	// MessageSources consume the events processed by the Triggers of the
+	// EventListener from message brokers.
+	// +listType=atomic
+	// +optional
+	MessageSources []MessageSource `json:"messageSources,omitempty"`
+	// PollSecretNames are the names of the Secrets the Triggers processed by
+	// the EventListener may read to authenticate to the repositories they
+	// poll. A Secret not listed here is only read if an EventListenerPolicy
+	// constraining the EventListener lists it in its secretNames.
+	// +listType=atomic
+	// +optional
+	PollSecretNames []string `json:"pollSecretNames,omitempty"`
+	// PayloadDecoders decode the bodies of the incoming events into the
+	// structured body passed to interceptors and bindings, by content type.
+	// The first decoder matching the content type of an event is used, and
+	// bodies without a matching decoder are decoded as JSON.
+	// +listType=atomic
+	// +optional
+	PayloadDecoders []PayloadDecoder `json:"payloadDecoders,omitempty"`
+	// Exposure makes the EventListener reachable from outside of the
+	// cluster through an Ingress or a Gateway API HTTPRoute owned by the
+	// EventListener.
+	// +optional
+	Exposure *EventListenerExposure `json:"exposure,omitempty"`
+	// TLS makes the EventListener serve HTTPS with the certificate of a
+	// Secret, or with a certificate generated and rotated by the controller.
+	// +optional
+	TLS *EventListenerTLS `json:"tls,omitempty"`
+}
+
+// EventListenerTLS configures the certificate the EventListener serves HTTPS
+// with. Exactly one of SecretName and Generate is set.
+type EventListenerTLS struct {
+	// SecretName is the name of a kubernetes.io/tls Secret in the namespace
+	// of the EventListener holding the certificate and key to serve.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// Generate makes the controller generate a certificate for the Service
+	// of the EventListener, signed by a generated CA, and rotate it before it
+	// expires. The certificate is stored in the el-<name>-tls Secret, along
+	// with the CA certificate under the ca.crt key.
+	// +optional
+	Generate bool `json:"generate,omitempty"`
+}
+
+// ExposureType is the kind of object exposing an EventListener outside of the
+// cluster.
+type ExposureType string
+
+const (
+	IngressExposure   ExposureType = "Ingress"
+	HTTPRouteExposure ExposureType = "HTTPRoute"
+)
+
+// EventListenerExposure routes the requests for an external hostname to the
+// Service of the EventListener.
+type EventListenerExposure struct {
+	// Type is the kind of object created to expose the EventListener.
+	// Defaults to Ingress.
+	// +optional
+	Type ExposureType `json:"type,omitempty"`
+	// Hostname is the external hostname the EventListener is reachable at.
+	Hostname string `json:"hostname"`
+	// Path is the path prefix routed to the EventListener. Defaults to /.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// ClassName is the IngressClass of the Ingress. Only used by the Ingress
+	// type.
+	// +optional
+	ClassName string `json:"className,omitempty"`
+	// TLSSecretName is the name of a Secret in the namespace of the
+	// EventListener holding the certificate of the Hostname, used by the
+	// Ingress to terminate TLS. Only used by the Ingress type, the TLS of an
+	// HTTPRoute is configured on the listeners of its Gateway.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// Gateway is the Gateway the HTTPRoute is attached to. Required by the
+	// HTTPRoute type.
+	// +optional
+	Gateway *ExposureGateway `json:"gateway,omitempty"`
+}
+
+// ExposureGateway refers to the Gateway, and optionally the listener of the
+// Gateway, an HTTPRoute is attached to.
+type ExposureGateway struct {
+	Name string `json:"name"`
+	// Namespace is the namespace of the Gateway. Defaults to the namespace of
+	// the EventListener.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the listener of the Gateway. Defaults to all
+	// the listeners of the Gateway.
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// GetType returns the type of the exposure, defaulting to Ingress.
+func (e *EventListenerExposure) GetType() ExposureType {
+	if e.Type == "" {
+		return IngressExposure
+	}
+	return e.Type
+}
+
+// GetPath returns the path prefix of the exposure, defaulting to /.
+func (e *EventListenerExposure) GetPath() string {
+	if e.Path == "" {
+		return "/"
+	}
+	return e.Path
+}
+
+// PayloadFormat is the format a PayloadDecoder decodes bodies from.
+type PayloadFormat string
+
+const (
+	PayloadFormatJSON      PayloadFormat = "json"
+	PayloadFormatForm      PayloadFormat = "form"
+	PayloadFormatMultipart PayloadFormat = "multipart"
+	PayloadFormatXML       PayloadFormat = "xml"
+	PayloadFormatYAML      PayloadFormat = "yaml"
+	PayloadFormatText      PayloadFormat = "text"
+)
+
+// PayloadDecoder decodes the bodies of a content type.
+type PayloadDecoder struct {
+	// ContentType is the media type of the decoded bodies, which can contain
+	// wildcards, e.g. application/*+xml or text/*.
+	ContentType string `json:"contentType"`
+	// Format is the format the bodies are decoded from.
+	Format PayloadFormat `json:"format"`
+}
+
+// MessageSource consumes events from a message broker. Each message is
+// processed like an incoming event, and only acknowledged once none of the
+// Triggers failed to process it. Exactly one of Kafka, NATS and AMQP is set.
+type MessageSource struct {
+	// Name identifies the source in the events it fires.
+	Name string `json:"name"`
+	// +optional
+	Kafka *KafkaSource `json:"kafka,omitempty"`
+	// +optional
+	NATS *NATSSource `json:"nats,omitempty"`
+	// +optional
+	AMQP *AMQPSource `json:"amqp,omitempty"`
+	// AuthSecretName is the name of a Secret in the namespace of the
+	// EventListener with the username and password used to authenticate to
+	// the broker.
+	// +optional
+	AuthSecretName string `json:"authSecretName,omitempty"`
+	// TLSSecretName is the name of a Secret in the namespace of the
+	// EventListener used to connect to the broker with TLS. Its optional
+	// ca.crt key holds the certificate authorities of the broker, and its
+	// optional tls.crt and tls.key keys hold a client certificate.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// KafkaSASLMechanism is a SASL mechanism used to authenticate to Kafka.
+type KafkaSASLMechanism string
+
+const (
+	KafkaSASLPlain       KafkaSASLMechanism = "PLAIN"
+	KafkaSASLScramSHA256 KafkaSASLMechanism = "SCRAM-SHA-256"
+	KafkaSASLScramSHA512 KafkaSASLMechanism = "SCRAM-SHA-512"
+)
+
+// KafkaSource consumes the messages of Kafka topics.
+type KafkaSource struct {
+	// Brokers are the addresses of the Kafka brokers, as host:port.
+	// +listType=atomic
+	Brokers []string `json:"brokers"`
+	// Topics are the consumed topics.
+	// +listType=atomic
+	Topics []string `json:"topics"`
+	// ConsumerGroup is the consumer group shared by the replicas of the
+	// EventListener, which commits the offsets of the processed messages.
+	ConsumerGroup string `json:"consumerGroup"`
+	// SASLMechanism is the SASL mechanism used with the credentials of the
+	// AuthSecretName. Defaults to PLAIN.
+	// +optional
+	SASLMechanism KafkaSASLMechanism `json:"saslMechanism,omitempty"`
+}
+
+// NATSSource consumes the messages of a NATS JetStream subject.
+type NATSSource struct {
+	// URL is the comma separated URLs of the NATS servers.
+	URL string `json:"url"`
+	// Subject is the consumed subject, which can contain wildcards.
+	Subject string `json:"subject"`
+	// Stream is the name of the JetStream stream of the subject. Defaults to
+	// the stream that captures the subject.
+	// +optional
+	Stream string `json:"stream,omitempty"`
+	// Durable is the name of the durable consumer shared by the replicas of
+	// the EventListener.
+	Durable string `json:"durable"`
+}
+
+// AMQPSource consumes the messages of an AMQP 0-9-1 queue.
+type AMQPSource struct {
+	// URL is the amqp:// or amqps:// URL of the broker, without credentials.
+	URL string `json:"url"`
+	// Queue is the consumed queue, which must exist.
+	Queue string `json:"queue"`
+	// Prefetch is the number of messages delivered to each replica before
+	// they are acknowledged. Defaults to 10.
+	// +optional
+	Prefetch *int32 `json:"prefetch,omitempty"`
+}
+
+// KubernetesEventType is a change to a Kubernetes resource.
+type KubernetesEventType string
+
+const (
+	KubernetesEventAdd    KubernetesEventType = "add"
+	KubernetesEventUpdate KubernetesEventType = "update"
+	KubernetesEventDelete KubernetesEventType = "delete"
+)
+
+// KubernetesEventSource watches Kubernetes resources and fires an event with
+// the resource as its body for each change to them.
+type KubernetesEventSource struct {
+	// Name identifies the source in the events it fires.
+	Name string `json:"name"`
+	// APIVersion of the watched resources, for example tekton.dev/v1.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the watched resources, for example PipelineRun.
+	Kind string `json:"kind"`
+	// NamespaceSelector selects the namespaces of the watched resources.
+	// Defaults to the namespace of the EventListener; "*" selects all of
+	// them. It is ignored for cluster-scoped resources.
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+	// LabelSelector selects the watched resources.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// EventTypes are the changes that fire events. Defaults to all of them.
+	// +listType=atomic
+	// +optional
+	EventTypes []KubernetesEventType `json:"eventTypes,omitempty"`
+	// Filter is a CEL expression that returns true for the changes that fire
+	// events. It can use the type of the change as eventType, the resource as
+	// object, and the resource before an update as oldObject.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+}
+
+// IsWatchForbidden returns whether the resources of a kind can't be watched
+// by a Kubernetes event source, since they would pass credentials the
+// EventListener can read, such as Secrets, into the params of Triggers.
+func IsWatchForbidden(gk schema.GroupKind) bool {
+	return gk.Group == "" && gk.Kind == "Secret"
 }
 
 type Resources struct {
 	KubernetesResource *KubernetesResource `json:"kubernetesResource,omitempty"`
 	CustomResource     *CustomResource     `json:"customResource,omitempty"`
+	// KnativeService runs the EventListener as a Knative Service, which
+	// Knative Serving scales to zero when the EventListener doesn't receive
+	// events.
+	// +optional
+	KnativeService *KnativeServiceResource `json:"knativeService,omitempty"`
+}
+
+// KnativeServiceResource configures the Knative Service generated for the
+// EventListener. The Knative Service times requests out after the timeout
+// handler of the EventListener.
+type KnativeServiceResource struct {
+	// MinScale is the minimum number of replicas. Defaults to 0, which scales
+	// the EventListener to zero when it doesn't receive events. It must be at
+	// least 1 with KubernetesEventSources or MessageSources, and is raised to
+	// 1 while the EventListener has scheduled or polling Triggers.
+	// +optional
+	MinScale *int32 `json:"minScale,omitempty"`
+	// MaxScale is the maximum number of replicas. Defaults to 0, which
+	// doesn't limit the number of replicas.
+	// +optional
+	MaxScale *int32 `json:"maxScale,omitempty"`
+	// ContainerConcurrency is the maximum number of events a replica
+	// receives at the same time. Defaults to 0, which doesn't limit them.
+	// +optional
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+	duckv1.WithPodSpec   `json:"spec,omitempty"`
 }
 
 type CustomResource struct {
@@ -83,7 +370,73 @@ type KubernetesResource struct {
 	ServiceType              corev1.ServiceType `json:"serviceType,omitempty"`
 	ServicePort              *int32             `json:"servicePort,omitempty"`
 	ServiceLoadBalancerClass *string            `json:"serviceLoadBalancerClass,omitempty"`
-	duckv1.WithPodSpec       `json:"spec,omitempty"`
+	// Autoscaling scales the EventListener Deployment with a
+	// HorizontalPodAutoscaler. It can't be set together with Replicas.
+	// +optional
+	Autoscaling        *EventListenerAutoscaling `json:"autoscaling,omitempty"`
+	duckv1.WithPodSpec `json:"spec,omitempty"`
+}
+
+// EventListenerAutoscaling configures the HorizontalPodAutoscaler generated
+// for the EventListener Deployment. The HorizontalPodAutoscaler scales on
+// the average CPU utilization of the pods, on a custom metric of the events
+// they process, or on both.
+type EventListenerAutoscaling struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of replicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of
+	// the pods, as a percentage of the CPU they request. Defaults to 80% when
+	// no Metric is set either.
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// Metric scales the EventListener on a per pod metric of the events it
+	// processes, served to the HorizontalPodAutoscaler by a custom metrics
+	// API adapter such as prometheus-adapter.
+	// +optional
+	Metric *EventListenerAutoscalingMetric `json:"metric,omitempty"`
+}
+
+// EventListenerAutoscalingMetricType is the kind of event metric an
+// EventListener scales on.
+type EventListenerAutoscalingMetricType string
+
+const (
+	// InFlightEventsMetric scales on the number of events each pod is
+	// processing, exported by the sink as eventlistener_events_in_flight.
+	InFlightEventsMetric EventListenerAutoscalingMetricType = "InFlightEvents"
+	// EventsReceivedRateMetric scales on the rate of events each pod receives,
+	// computed by the adapter from eventlistener_event_received_total.
+	EventsReceivedRateMetric EventListenerAutoscalingMetricType = "EventsReceivedRate"
+)
+
+// EventListenerAutoscalingMetric is a custom per pod metric the
+// HorizontalPodAutoscaler of an EventListener targets.
+type EventListenerAutoscalingMetric struct {
+	// Type is the kind of event metric, InFlightEvents or EventsReceivedRate.
+	Type EventListenerAutoscalingMetricType `json:"type"`
+	// Name is the name of the metric in the custom metrics API. Defaults to
+	// eventlistener_events_in_flight for InFlightEvents and to
+	// eventlistener_event_received_rate for EventsReceivedRate.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// TargetAverageValue is the target value of the metric averaged across
+	// the pods.
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// MetricName returns the name of the metric in the custom metrics API.
+func (m *EventListenerAutoscalingMetric) MetricName() string {
+	switch {
+	case m.Name != "":
+		return m.Name
+	case m.Type == EventsReceivedRateMetric:
+		return "eventlistener_event_received_rate"
+	default:
+		return "eventlistener_events_in_flight"
+	}
 }
 
 // EventListenerTrigger represents a connection between TriggerBinding, Params,
@@ -107,6 +460,10 @@ type EventListenerTrigger struct {
 	// multi-tenant model based scenarios
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Route restricts the incoming events processed by the Trigger to the
+	// requests for an HTTP path, and optionally methods
+	// +optional
+	Route *TriggerRoute `json:"route,omitempty"`
 }
 
 // EventListenerTriggerGroup defines a group of Triggers that share a common set of interceptors
@@ -115,6 +472,12 @@ type EventListenerTriggerGroup struct {
 	// +listType=atomic
 	Interceptors    []*TriggerInterceptor        `json:"interceptors"`
 	TriggerSelector EventListenerTriggerSelector `json:"triggerSelector"`
+	// Route restricts the incoming events processed by the group to the
+	// requests for an HTTP path, and optionally methods. The Triggers of the
+	// group that have their own Route only process the requests that match
+	// both.
+	// +optional
+	Route *TriggerRoute `json:"route,omitempty"`
 }
 
 // EventListenerTriggerSelector  defines ways to select a group of triggers using their metadata
@@ -161,6 +524,12 @@ type EventListenerStatus struct {
 
 	// Configuration stores configuration for the EventListener service
 	Configuration EventListenerConfig `json:"configuration"`
+
+	// Triggers reports, for every Trigger the EventListener processes events
+	// for, whether the objects it references could be resolved.
+	// +optional
+	// +listType=atomic
+	Triggers []EventListenerTriggerStatus `json:"triggers,omitempty"`
 }
 
 // EventListenerConfig stores configuration for resources generated by the
@@ -171,6 +540,28 @@ type EventListenerConfig struct {
 	GeneratedResourceName string `json:"generatedName"`
 }
 
+// EventListenerTriggerStatus reports whether the bindings, template and
+// interceptors of a single Trigger processed by an EventListener exist.
+type EventListenerTriggerStatus struct {
+	// Name is the name of the Trigger, or of the trigger defined inline in
+	// the EventListener.
+	Name string `json:"name"`
+	// Namespace is the namespace of the Trigger.
+	Namespace string `json:"namespace"`
+	// TriggerGroup is the name of the trigger group that selected the
+	// Trigger, if any.
+	// +optional
+	TriggerGroup string `json:"triggerGroup,omitempty"`
+	// Resolved is true when every object referenced by the Trigger exists.
+	Resolved bool `json:"resolved"`
+	// Reason is a CamelCase reason for the Trigger not being resolved.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable description of the Reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // NamespaceSelector is a selector for selecting either all namespaces or a
 // list of namespaces.
 // +k8s:openapi-gen=true
@@ -188,6 +579,65 @@ const (
 	// DeploymentExists is the ConditionType set on the EventListener, which
 	// specifies Deployment existence.
 	DeploymentExists apis.ConditionType = "Deployment"
+	// TriggersResolved is the ConditionType set on the EventListener, which
+	// specifies whether every Trigger it processes could be resolved. It does
+	// not affect the Ready condition since the EventListener keeps serving
+	// the Triggers that do resolve.
+	TriggersResolved apis.ConditionType = "TriggersResolved"
+	// KnativeServiceReady is the ConditionType set on an EventListener run
+	// as a Knative Service, which reflects the Ready condition of the
+	// Knative Service.
+	KnativeServiceReady apis.ConditionType = "KnativeServiceReady"
+	// CustomObjectSynced is the ConditionType set on an EventListener run
+	// as a custom object, which specifies whether the generated spec is
+	// applied to the custom object. It does not affect the Ready condition.
+	CustomObjectSynced apis.ConditionType = "CustomObjectSynced"
+)
+
+// The reasons reported for the CustomObjectSynced condition.
+const (
+	// CustomObjectDriftCorrectedReason is reported when fields of the custom
+	// object changed outside of the EventListener were applied again.
+	CustomObjectDriftCorrectedReason = "DriftCorrected"
+	// CustomObjectApplyFailedReason is reported when the generated spec
+	// could not be applied to the custom object.
+	CustomObjectApplyFailedReason = "ApplyFailed"
+)
+
+// The reasons reported for a Trigger that could not be resolved.
+const (
+	// TriggerNotFoundReason is reported when a triggerRef names a Trigger
+	// that does not exist.
+	TriggerNotFoundReason = "TriggerNotFound"
+	// TriggerBindingNotFoundReason is reported when a referenced
+	// TriggerBinding does not exist.
+	TriggerBindingNotFoundReason = "TriggerBindingNotFound"
+	// ClusterTriggerBindingNotFoundReason is reported when a referenced
+	// ClusterTriggerBinding does not exist.
+	ClusterTriggerBindingNotFoundReason = "ClusterTriggerBindingNotFound"
+	// TriggerTemplateNotFoundReason is reported when a referenced
+	// TriggerTemplate does not exist.
+	TriggerTemplateNotFoundReason = "TriggerTemplateNotFound"
+	// InterceptorNotFoundReason is reported when a referenced namespaced
+	// Interceptor does not exist.
+	InterceptorNotFoundReason = "InterceptorNotFound"
+	// ClusterInterceptorNotFoundReason is reported when a referenced
+	// ClusterInterceptor does not exist.
+	ClusterInterceptorNotFoundReason = "ClusterInterceptorNotFound"
+	// InvalidTriggerReason is reported when the referenced objects exist but
+	// cannot be combined, e.g. because bindings declare the same param.
+	InvalidTriggerReason = "InvalidTrigger"
+	// TriggersUnresolvedReason is the reason of a false TriggersResolved
+	// condition.
+	TriggersUnresolvedReason = "TriggersUnresolved"
+)
+
+// The names of the Addresses reported by an exposed EventListener.
+const (
+	// ClusterLocalAddressName names the address of the EventListener Service.
+	ClusterLocalAddressName = "cluster-local"
+	// ExternalAddressName names the address of the EventListener exposure.
+	ExternalAddressName = "external"
 )
 
 // Check that EventListener may be validated and defaulted.
@@ -231,7 +681,8 @@ func (els *EventListenerStatus) SetReadyCondition() {
 		ServiceExists,
 		DeploymentExists,
 		apis.ConditionType(appsv1.DeploymentProgressing),
-		apis.ConditionType(appsv1.DeploymentAvailable)} {
+		apis.ConditionType(appsv1.DeploymentAvailable),
+		KnativeServiceReady} {
 		if sc := els.GetCondition(ct); sc != nil {
 			if sc.Status != corev1.ConditionTrue {
 				els.SetCondition(&apis.Condition{
@@ -292,6 +743,46 @@ func (els *EventListenerStatus) SetConditionsForDynamicObjects(conditions v1beta
 	})
 }
 
+// SetKnativeServiceCondition sets the KnativeServiceReady condition from the
+// Ready condition of the Knative Service running the EventListener, which is
+// Unknown until Knative Serving reports it.
+func (els *EventListenerStatus) SetKnativeServiceCondition(ready *apis.Condition) {
+	if ready == nil {
+		els.SetCondition(&apis.Condition{
+			Type:    KnativeServiceReady,
+			Status:  corev1.ConditionUnknown,
+			Message: "Knative Service is not reconciled yet",
+		})
+		return
+	}
+	els.SetCondition(&apis.Condition{
+		Type:    KnativeServiceReady,
+		Status:  ready.Status,
+		Reason:  ready.Reason,
+		Message: ready.Message,
+	})
+}
+
+// ClearKnativeServiceCondition removes the KnativeServiceReady condition
+// once the EventListener isn't run as a Knative Service anymore.
+func (els *EventListenerStatus) ClearKnativeServiceCondition() error {
+	return eventListenerCondSet.Manage(els).ClearCondition(KnativeServiceReady)
+}
+
+// ClearDeploymentConditions removes the conditions reflecting the status of
+// the Deployment once the EventListener isn't run as a Deployment anymore.
+func (els *EventListenerStatus) ClearDeploymentConditions() error {
+	for _, ct := range []appsv1.DeploymentConditionType{
+		appsv1.DeploymentAvailable,
+		appsv1.DeploymentProgressing,
+		appsv1.DeploymentReplicaFailure} {
+		if err := eventListenerCondSet.Manage(els).ClearCondition(apis.ConditionType(ct)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SetExistsCondition simplifies setting the exists conditions on the
 // EventListenerStatus.
 func (els *EventListenerStatus) SetExistsCondition(cond apis.ConditionType, err error) {
@@ -327,6 +818,92 @@ func (els *EventListenerStatus) InitializeConditions() {
 	}
 }
 
+// SetTriggerStatuses records the resolution status of every Trigger and sets
+// the TriggersResolved condition accordingly. A non-nil err means the
+// Triggers could not be listed at all.
+func (els *EventListenerStatus) SetTriggerStatuses(statuses []EventListenerTriggerStatus, err error) {
+	els.Triggers = statuses
+	if err != nil {
+		els.SetCondition(&apis.Condition{
+			Type:    TriggersResolved,
+			Status:  corev1.ConditionUnknown,
+			Reason:  TriggersUnresolvedReason,
+			Message: err.Error(),
+		})
+		return
+	}
+	var unresolved []string
+	for _, s := range statuses {
+		if !s.Resolved {
+			unresolved = append(unresolved, fmt.Sprintf("%s/%s: %s", s.Namespace, s.Name, s.Message))
+		}
+	}
+	if len(unresolved) > 0 {
+		els.SetCondition(&apis.Condition{
+			Type:    TriggersResolved,
+			Status:  corev1.ConditionFalse,
+			Reason:  TriggersUnresolvedReason,
+			Message: fmt.Sprintf("%d of %d Triggers could not be resolved: %s", len(unresolved), len(statuses), strings.Join(unresolved, "; ")),
+		})
+		return
+	}
+	els.SetCondition(&apis.Condition{
+		Type:    TriggersResolved,
+		Status:  corev1.ConditionTrue,
+		Message: "All Triggers resolved",
+	})
+}
+
+// SetCustomObjectSyncedCondition sets the CustomObjectSynced condition
+// after the generated spec was applied to the custom object, reporting the
+// drifted fields it corrected. A non-nil err means it could not be applied.
+func (els *EventListenerStatus) SetCustomObjectSyncedCondition(drifted []string, err error) {
+	if err != nil {
+		els.SetCondition(&apis.Condition{
+			Type:    CustomObjectSynced,
+			Status:  corev1.ConditionFalse,
+			Reason:  CustomObjectApplyFailedReason,
+			Message: err.Error(),
+		})
+		return
+	}
+	if len(drifted) > 0 {
+		els.SetCondition(&apis.Condition{
+			Type:    CustomObjectSynced,
+			Status:  corev1.ConditionTrue,
+			Reason:  CustomObjectDriftCorrectedReason,
+			Message: fmt.Sprintf("Corrected fields changed outside of the EventListener: %s", strings.Join(drifted, ", ")),
+		})
+		return
+	}
+	els.SetCondition(&apis.Condition{
+		Type:    CustomObjectSynced,
+		Status:  corev1.ConditionTrue,
+		Message: "Custom object matches the EventListener",
+	})
+}
+
+// SetExternalAddress reports the URL the EventListener is reachable at from
+// outside of the cluster in the Addresses, alongside the cluster-local
+// Address. A nil URL clears the Addresses.
+func (els *EventListenerStatus) SetExternalAddress(url *apis.URL) {
+	if url == nil {
+		els.Addresses = nil
+		return
+	}
+	els.Addresses = nil
+	if els.Address != nil && els.Address.URL != nil {
+		els.Addresses = append(els.Addresses, v1beta1.Addressable{
+			Name: ptr.String(ClusterLocalAddressName),
+			URL:  els.Address.URL.DeepCopy(),
+		})
+	}
+	els.Addresses = append(els.Addresses, v1beta1.Addressable{
+		Name: ptr.String(ExternalAddressName),
+		URL:  url,
+	})
+}
+
 // SetAddress sets the address (as part of Addressable contract) and marks the correct condition.
 func (els *EventListenerStatus) SetAddress(hostname string) {
 	if els.Address == nil {
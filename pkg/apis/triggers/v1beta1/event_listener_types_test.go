@@ -28,6 +28,7 @@ import (
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/apis/duck/v1beta1"
+	"knative.dev/pkg/ptr"
 )
 
 func TestSetGetCondition(t *testing.T) {
@@ -413,3 +414,94 @@ func TestEventListenerStatus_SetReadyCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestSetTriggerStatuses(t *testing.T) {
+	resolved := EventListenerTriggerStatus{Name: "ok", Namespace: "ns", Resolved: true}
+	missingTemplate := EventListenerTriggerStatus{
+		Name:      "broken",
+		Namespace: "ns",
+		Reason:    TriggerTemplateNotFoundReason,
+		Message:   `TriggerTemplate tt: triggertemplate.triggers.tekton.dev "tt" not found`,
+	}
+	tests := []struct {
+		name     string
+		statuses []EventListenerTriggerStatus
+		err      error
+		want     *apis.Condition
+	}{{
+		name: "no triggers",
+		want: &apis.Condition{
+			Type:    TriggersResolved,
+			Status:  corev1.ConditionTrue,
+			Message: "All Triggers resolved",
+		},
+	}, {
+		name:     "all resolved",
+		statuses: []EventListenerTriggerStatus{resolved},
+		want: &apis.Condition{
+			Type:    TriggersResolved,
+			Status:  corev1.ConditionTrue,
+			Message: "All Triggers resolved",
+		},
+	}, {
+		name:     "one unresolved",
+		statuses: []EventListenerTriggerStatus{resolved, missingTemplate},
+		want: &apis.Condition{
+			Type:    TriggersResolved,
+			Status:  corev1.ConditionFalse,
+			Reason:  TriggersUnresolvedReason,
+			Message: `1 of 2 Triggers could not be resolved: ns/broken: TriggerTemplate tt: triggertemplate.triggers.tekton.dev "tt" not found`,
+		},
+	}, {
+		name: "listing failed",
+		err:  fmt.Errorf("failed to create label selector"),
+		want: &apis.Condition{
+			Type:    TriggersResolved,
+			Status:  corev1.ConditionUnknown,
+			Reason:  TriggersUnresolvedReason,
+			Message: "failed to create label selector",
+		},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			els := EventListenerStatus{}
+			els.SetReadyCondition()
+			els.SetTriggerStatuses(tc.statuses, tc.err)
+			if diff := cmp.Diff(tc.want, els.GetCondition(TriggersResolved), cmpopts.IgnoreFields(apis.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("SetTriggerStatuses() condition mismatch. -want/+got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.statuses, els.Triggers); diff != "" {
+				t.Errorf("SetTriggerStatuses() statuses mismatch. -want/+got: %s", diff)
+			}
+			// The EventListener keeps serving the Triggers that do resolve.
+			if ready := els.GetCondition(apis.ConditionReady); ready.Status != corev1.ConditionTrue {
+				t.Errorf("SetTriggerStatuses() changed the Ready condition to %s", ready.Status)
+			}
+		})
+	}
+}
+
+func TestSetExternalAddress(t *testing.T) {
+	els := &EventListenerStatus{}
+	els.SetAddress("el-listener.ns.svc.cluster.local:8080")
+	els.SetExternalAddress(&apis.URL{Scheme: "https", Host: "events.example.com"})
+
+	want := []v1beta1.Addressable{{
+		Name: ptr.String("cluster-local"),
+		URL:  &apis.URL{Scheme: "http", Host: "el-listener.ns.svc.cluster.local:8080"},
+	}, {
+		Name: ptr.String("external"),
+		URL:  &apis.URL{Scheme: "https", Host: "events.example.com"},
+	}}
+	if diff := cmp.Diff(want, els.Addresses); diff != "" {
+		t.Errorf("SetExternalAddress() -want, +got: %s", diff)
+	}
+	if els.Address.URL.Host != "el-listener.ns.svc.cluster.local:8080" {
+		t.Errorf("expected the cluster-local Address to be kept, got %s", els.Address.URL)
+	}
+
+	els.SetExternalAddress(nil)
+	if els.Addresses != nil {
+		t.Errorf("expected no Addresses once the external address is cleared, got %v", els.Addresses)
+	}
+}
@@ -21,14 +21,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
 
+	"github.com/google/cel-go/cel"
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
 	"knative.dev/pkg/webhook/resourcesemantics"
 )
 
@@ -37,6 +44,14 @@ var (
 		"TLS_CERT",
 		"TLS_KEY",
 	)
+	payloadFormats = sets.NewString(
+		string(PayloadFormatJSON),
+		string(PayloadFormatForm),
+		string(PayloadFormatMultipart),
+		string(PayloadFormatXML),
+		string(PayloadFormatYAML),
+		string(PayloadFormatText),
+	)
 )
 
 var _ resourcesemantics.VerbLimited = (*EventListener)(nil)
@@ -85,12 +100,261 @@ func (s *EventListenerSpec) validate(ctx context.Context) (errs *apis.FieldError
 		errs = errs.Also(validateCustomObject(s.Resources.CustomResource).ViaField("spec.resources.customResource"))
 	}
 
+	if s.Resources.KnativeService != nil {
+		if s.Resources.KubernetesResource != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.resources.kubernetesResource", "spec.resources.knativeService"))
+		}
+		if s.Resources.CustomResource != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.resources.customResource", "spec.resources.knativeService"))
+		}
+		errs = errs.Also(s.Resources.KnativeService.validate().ViaField("spec.resources.knativeService"))
+		// Event sources run in the EventListener's replicas, so a Knative
+		// Service scaled to zero would stop consuming them.
+		if minScale := ptr.Int32Value(s.Resources.KnativeService.MinScale); minScale < 1 && (len(s.KubernetesEventSources) > 0 || len(s.MessageSources) > 0) {
+			errs = errs.Also(apis.ErrInvalidValue(minScale, "spec.resources.knativeService.minScale",
+				"minScale must be at least 1 with kubernetesEventSources or messageSources"))
+		}
+	}
+
 	if len(s.TriggerGroups) > 0 {
 		for i, group := range s.TriggerGroups {
 			errs = errs.Also(group.validate(ctx).ViaField(fmt.Sprintf("spec.triggerGroups[%d]", i)))
 		}
 	}
 
+	names := sets.NewString()
+	for i, source := range s.KubernetesEventSources {
+		path := fmt.Sprintf("spec.kubernetesEventSources[%d]", i)
+		if names.Has(source.Name) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate name %q", source.Name), path+".name"))
+		}
+		names.Insert(source.Name)
+		errs = errs.Also(source.validate().ViaField(path))
+	}
+
+	names = sets.NewString()
+	for i, source := range s.MessageSources {
+		path := fmt.Sprintf("spec.messageSources[%d]", i)
+		if names.Has(source.Name) {
+			errs = errs.Also(apis.ErrGeneric(fmt.Sprintf("duplicate name %q", source.Name), path+".name"))
+		}
+		names.Insert(source.Name)
+		errs = errs.Also(source.validate().ViaField(path))
+	}
+
+	for i, decoder := range s.PayloadDecoders {
+		errs = errs.Also(decoder.validate().ViaField(fmt.Sprintf("spec.payloadDecoders[%d]", i)))
+	}
+
+	if s.Exposure != nil {
+		if s.Resources.CustomResource != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.exposure", "spec.resources.customResource"))
+		}
+		if s.Resources.KnativeService != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.exposure", "spec.resources.knativeService"))
+		}
+		errs = errs.Also(s.Exposure.validate().ViaField("spec.exposure"))
+	}
+
+	if s.TLS != nil {
+		if s.Resources.CustomResource != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.tls", "spec.resources.customResource"))
+		}
+		if s.Resources.KnativeService != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.tls", "spec.resources.knativeService"))
+		}
+		if hasTLSEnv(s.Resources.KubernetesResource) {
+			errs = errs.Also(apis.ErrMultipleOneOf("spec.tls", "spec.resources.kubernetesResource.spec.template.spec.containers[0].env"))
+		}
+		errs = errs.Also(s.TLS.validate().ViaField("spec.tls"))
+	}
+
+	return errs
+}
+
+func (t *EventListenerTLS) validate() *apis.FieldError {
+	switch {
+	case t.SecretName == "" && !t.Generate:
+		return apis.ErrMissingOneOf("secretName", "generate")
+	case t.SecretName != "" && t.Generate:
+		return apis.ErrMultipleOneOf("secretName", "generate")
+	}
+	return nil
+}
+
+// hasTLSEnv reports whether the TLS_CERT and TLS_KEY env vars of the
+// EventListener container configure TLS.
+func hasTLSEnv(kr *KubernetesResource) bool {
+	if kr == nil || len(kr.Template.Spec.Containers) == 0 {
+		return false
+	}
+	for _, env := range kr.Template.Spec.Containers[0].Env {
+		if reservedEnvVars.Has(env.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *EventListenerExposure) validate() (errs *apis.FieldError) {
+	if e.Hostname == "" {
+		errs = errs.Also(apis.ErrMissingField("hostname"))
+	} else if msgs := validation.IsDNS1123Subdomain(e.Hostname); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: %s", e.Hostname, strings.Join(msgs, ", ")), "hostname"))
+	}
+	if e.Path != "" && !strings.HasPrefix(e.Path, "/") {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: must start with /", e.Path), "path"))
+	}
+	switch e.GetType() {
+	case IngressExposure:
+		if e.Gateway != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("gateway"))
+		}
+	case HTTPRouteExposure:
+		if e.ClassName != "" {
+			errs = errs.Also(apis.ErrDisallowedFields("className"))
+		}
+		if e.TLSSecretName != "" {
+			errs = errs.Also(apis.ErrDisallowedFields("tlsSecretName"))
+		}
+		if e.Gateway == nil {
+			errs = errs.Also(apis.ErrMissingField("gateway"))
+		} else if e.Gateway.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("gateway.name"))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: must be %s or %s", e.Type, IngressExposure, HTTPRouteExposure), "type"))
+	}
+	return errs
+}
+
+func (d *PayloadDecoder) validate() (errs *apis.FieldError) {
+	if d.ContentType == "" {
+		errs = errs.Also(apis.ErrMissingField("contentType"))
+	} else if _, err := path.Match(d.ContentType, ""); err != nil || !strings.Contains(d.ContentType, "/") {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: must be a media type such as application/xml or text/*", d.ContentType), "contentType"))
+	}
+	if d.Format == "" {
+		errs = errs.Also(apis.ErrMissingField("format"))
+	} else if !payloadFormats.Has(string(d.Format)) {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: must be one of %s", d.Format, strings.Join(payloadFormats.List(), ", ")), "format"))
+	}
+	return errs
+}
+
+func (s *MessageSource) validate() (errs *apis.FieldError) {
+	if s.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Label(s.Name); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: %s", s.Name, strings.Join(msgs, ", ")), "name"))
+	}
+	var set []string
+	if s.Kafka != nil {
+		set = append(set, "kafka")
+		errs = errs.Also(s.Kafka.validate().ViaField("kafka"))
+	}
+	if s.NATS != nil {
+		set = append(set, "nats")
+		errs = errs.Also(s.NATS.validate().ViaField("nats"))
+	}
+	if s.AMQP != nil {
+		set = append(set, "amqp")
+		errs = errs.Also(s.AMQP.validate().ViaField("amqp"))
+	}
+	switch len(set) {
+	case 0:
+		errs = errs.Also(apis.ErrMissingOneOf("kafka", "nats", "amqp"))
+	case 1:
+	default:
+		errs = errs.Also(apis.ErrMultipleOneOf(set...))
+	}
+	return errs
+}
+
+func (s *KafkaSource) validate() (errs *apis.FieldError) {
+	if len(s.Brokers) == 0 {
+		errs = errs.Also(apis.ErrMissingField("brokers"))
+	}
+	if len(s.Topics) == 0 {
+		errs = errs.Also(apis.ErrMissingField("topics"))
+	}
+	if s.ConsumerGroup == "" {
+		errs = errs.Also(apis.ErrMissingField("consumerGroup"))
+	}
+	switch s.SASLMechanism {
+	case "", KafkaSASLPlain, KafkaSASLScramSHA256, KafkaSASLScramSHA512:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.SASLMechanism, "saslMechanism"))
+	}
+	return errs
+}
+
+func (s *NATSSource) validate() (errs *apis.FieldError) {
+	if s.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	}
+	if s.Subject == "" {
+		errs = errs.Also(apis.ErrMissingField("subject"))
+	}
+	if s.Durable == "" {
+		errs = errs.Also(apis.ErrMissingField("durable"))
+	}
+	return errs
+}
+
+func (s *AMQPSource) validate() (errs *apis.FieldError) {
+	if s.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	} else if u, err := url.Parse(s.URL); err != nil || (u.Scheme != "amqp" && u.Scheme != "amqps") || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: must be an amqp:// or amqps:// URL", s.URL), "url"))
+	} else if u.User != nil {
+		errs = errs.Also(apis.ErrInvalidValue("the credentials must be set with authSecretName", "url"))
+	}
+	if s.Queue == "" {
+		errs = errs.Also(apis.ErrMissingField("queue"))
+	}
+	if s.Prefetch != nil && *s.Prefetch < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*s.Prefetch, "prefetch"))
+	}
+	return errs
+}
+
+func (s *KubernetesEventSource) validate() (errs *apis.FieldError) {
+	if s.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Label(s.Name); len(msgs) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s: %s", s.Name, strings.Join(msgs, ", ")), "name"))
+	}
+	if s.APIVersion == "" {
+		errs = errs.Also(apis.ErrMissingField("apiVersion"))
+	} else if gv, err := schema.ParseGroupVersion(s.APIVersion); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "apiVersion"))
+	} else if IsWatchForbidden(gv.WithKind(s.Kind).GroupKind()) {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s can't be watched by a Kubernetes event source", s.Kind), "kind"))
+	}
+	if s.Kind == "" {
+		errs = errs.Also(apis.ErrMissingField("kind"))
+	}
+	if s.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(s.LabelSelector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), "labelSelector"))
+		}
+	}
+	for i, t := range s.EventTypes {
+		switch t {
+		case KubernetesEventAdd, KubernetesEventUpdate, KubernetesEventDelete:
+		default:
+			errs = errs.Also(apis.ErrInvalidArrayValue(t, "eventTypes", i))
+		}
+	}
+	if s.Filter != "" {
+		env, err := cel.NewEnv()
+		if err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Errorf("failed to create a CEL env: %w", err), "filter"))
+		} else if _, issues := env.Parse(s.Filter); issues != nil && issues.Err() != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("failed to parse the filter: %s", issues.Err()), "filter"))
+		}
+	}
 	return errs
 }
 
@@ -101,6 +365,9 @@ func (g *EventListenerTriggerGroup) validate(ctx context.Context) (errs *apis.Fi
 	if len(g.Interceptors) == 0 {
 		errs = errs.Also(apis.ErrMissingField("interceptors"))
 	}
+	if g.Route != nil {
+		errs = errs.Also(g.Route.validate().ViaField("route"))
+	}
 	return errs
 }
 
@@ -129,6 +396,34 @@ func validateCustomObject(customData *CustomResource) (errs *apis.FieldError) {
 	return errs
 }
 
+func (k *KnativeServiceResource) validate() (errs *apis.FieldError) {
+	if k.MinScale != nil && *k.MinScale < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*k.MinScale, "minScale", "minScale can't be negative"))
+	}
+	if k.MaxScale != nil {
+		if *k.MaxScale < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(*k.MaxScale, "maxScale", "maxScale can't be negative"))
+		} else if *k.MaxScale > 0 && k.MinScale != nil && *k.MinScale > *k.MaxScale {
+			errs = errs.Also(apis.ErrInvalidValue(*k.MinScale, "minScale", "minScale can't be greater than maxScale"))
+		}
+	}
+	if k.ContainerConcurrency != nil && *k.ContainerConcurrency < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*k.ContainerConcurrency, "containerConcurrency", "containerConcurrency can't be negative"))
+	}
+
+	if len(k.Template.Spec.Containers) > 1 {
+		errs = errs.Also(apis.ErrMultipleOneOf("containers").ViaField("spec.template.spec"))
+	}
+	errs = errs.Also(apis.CheckDisallowedFields(k.Template.Spec,
+		*podSpecMask(&k.Template.Spec)).ViaField("spec.template.spec"))
+	if len(k.Template.Spec.Containers) == 1 {
+		errs = errs.Also(apis.CheckDisallowedFields(k.Template.Spec.Containers[0],
+			*containerFieldMaskForCustomResource(&k.Template.Spec.Containers[0])).ViaField("spec.template.spec.containers[0]"))
+		errs = errs.Also(validateEnv(k.Template.Spec.Containers[0].Env).ViaField("spec.template.spec.containers[0].env"))
+	}
+	return errs
+}
+
 func validateKubernetesObject(orig *KubernetesResource) (errs *apis.FieldError) {
 	if orig.Replicas != nil {
 		if *orig.Replicas < 0 {
@@ -153,6 +448,45 @@ func validateKubernetesObject(orig *KubernetesResource) (errs *apis.FieldError)
 		errs = errs.Also(apis.ErrInvalidValue(*orig.ServiceLoadBalancerClass, "serviceLoadBalancerClass", "ServiceLoadBalancerClass is only needed for LoadBalancer service type"))
 	}
 
+	if orig.Autoscaling != nil {
+		if orig.Replicas != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("replicas", "autoscaling"))
+		}
+		errs = errs.Also(orig.Autoscaling.validate().ViaField("autoscaling"))
+	}
+
+	return errs
+}
+
+func (a *EventListenerAutoscaling) validate() (errs *apis.FieldError) {
+	if a.MaxReplicas < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(a.MaxReplicas, "maxReplicas", "maxReplicas must be at least 1"))
+	}
+	if a.MinReplicas != nil {
+		if *a.MinReplicas < 1 {
+			errs = errs.Also(apis.ErrInvalidValue(*a.MinReplicas, "minReplicas", "minReplicas must be at least 1"))
+		} else if *a.MinReplicas > a.MaxReplicas {
+			errs = errs.Also(apis.ErrInvalidValue(*a.MinReplicas, "minReplicas", "minReplicas can't be greater than maxReplicas"))
+		}
+	}
+	if a.TargetCPUUtilizationPercentage != nil && *a.TargetCPUUtilizationPercentage < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*a.TargetCPUUtilizationPercentage, "targetCPUUtilizationPercentage",
+			"targetCPUUtilizationPercentage must be at least 1"))
+	}
+	if a.Metric != nil {
+		switch a.Metric.Type {
+		case InFlightEventsMetric, EventsReceivedRateMetric:
+		case "":
+			errs = errs.Also(apis.ErrMissingField("metric.type"))
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(a.Metric.Type, "metric.type",
+				fmt.Sprintf("metric type must be %s or %s", InFlightEventsMetric, EventsReceivedRateMetric)))
+		}
+		if a.Metric.TargetAverageValue.Sign() <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(a.Metric.TargetAverageValue.String(), "metric.targetAverageValue",
+				"targetAverageValue must be greater than 0"))
+		}
+	}
 	return errs
 }
 
@@ -318,6 +652,12 @@ func (t *EventListenerTrigger) validate(ctx context.Context) (errs *apis.FieldEr
 	if t.TriggerRef != "" && (t.Template != nil || t.Bindings != nil || t.Interceptors != nil) {
 		errs = errs.Also(apis.ErrMultipleOneOf("triggerRef", "template or bindings or interceptors"))
 	}
+	if t.TriggerRef != "" && t.Route != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("triggerRef", "route"))
+	}
+	if t.Route != nil {
+		errs = errs.Also(t.Route.validate().ViaField("route"))
+	}
 
 	// Validate optional Bindings
 	errs = errs.Also(triggerSpecBindingArray(t.Bindings).validate(ctx))
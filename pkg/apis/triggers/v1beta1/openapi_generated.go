@@ -28,46 +28,100 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ClusterTriggerBinding":        schema_pkg_apis_triggers_v1beta1_ClusterTriggerBinding(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ClusterTriggerBindingList":    schema_pkg_apis_triggers_v1beta1_ClusterTriggerBindingList(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource":               schema_pkg_apis_triggers_v1beta1_CustomResource(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListener":                schema_pkg_apis_triggers_v1beta1_EventListener(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerConfig":          schema_pkg_apis_triggers_v1beta1_EventListenerConfig(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerList":            schema_pkg_apis_triggers_v1beta1_EventListenerList(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerSpec":            schema_pkg_apis_triggers_v1beta1_EventListenerSpec(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerStatus":          schema_pkg_apis_triggers_v1beta1_EventListenerStatus(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger":         schema_pkg_apis_triggers_v1beta1_EventListenerTrigger(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup":    schema_pkg_apis_triggers_v1beta1_EventListenerTriggerGroup(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector": schema_pkg_apis_triggers_v1beta1_EventListenerTriggerSelector(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorParams":            schema_pkg_apis_triggers_v1beta1_InterceptorParams(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorRef":               schema_pkg_apis_triggers_v1beta1_InterceptorRef(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorRequest":           schema_pkg_apis_triggers_v1beta1_InterceptorRequest(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorResponse":          schema_pkg_apis_triggers_v1beta1_InterceptorResponse(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource":           schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector":            schema_pkg_apis_triggers_v1beta1_NamespaceSelector(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param":                        schema_pkg_apis_triggers_v1beta1_Param(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ParamSpec":                    schema_pkg_apis_triggers_v1beta1_ParamSpec(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources":                    schema_pkg_apis_triggers_v1beta1_Resources(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef":                    schema_pkg_apis_triggers_v1beta1_SecretRef(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Status":                       schema_pkg_apis_triggers_v1beta1_Status(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.StatusError":                  schema_pkg_apis_triggers_v1beta1_StatusError(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Trigger":                      schema_pkg_apis_triggers_v1beta1_Trigger(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBinding":               schema_pkg_apis_triggers_v1beta1_TriggerBinding(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingList":           schema_pkg_apis_triggers_v1beta1_TriggerBindingList(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingSpec":           schema_pkg_apis_triggers_v1beta1_TriggerBindingSpec(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingStatus":         schema_pkg_apis_triggers_v1beta1_TriggerBindingStatus(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerContext":               schema_pkg_apis_triggers_v1beta1_TriggerContext(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor":           schema_pkg_apis_triggers_v1beta1_TriggerInterceptor(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerList":                  schema_pkg_apis_triggers_v1beta1_TriggerList(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerResourceTemplate":      schema_pkg_apis_triggers_v1beta1_TriggerResourceTemplate(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpec":                  schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding":           schema_pkg_apis_triggers_v1beta1_TriggerSpecBinding(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate":          schema_pkg_apis_triggers_v1beta1_TriggerSpecTemplate(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplate":              schema_pkg_apis_triggers_v1beta1_TriggerTemplate(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateList":          schema_pkg_apis_triggers_v1beta1_TriggerTemplateList(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateSpec":          schema_pkg_apis_triggers_v1beta1_TriggerTemplateSpec(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateStatus":        schema_pkg_apis_triggers_v1beta1_TriggerTemplateStatus(ref),
-		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.WebhookInterceptor":           schema_pkg_apis_triggers_v1beta1_WebhookInterceptor(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.AMQPSource":                     schema_pkg_apis_triggers_v1beta1_AMQPSource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ClusterTriggerBinding":          schema_pkg_apis_triggers_v1beta1_ClusterTriggerBinding(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ClusterTriggerBindingList":      schema_pkg_apis_triggers_v1beta1_ClusterTriggerBindingList(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource":                 schema_pkg_apis_triggers_v1beta1_CustomResource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListener":                  schema_pkg_apis_triggers_v1beta1_EventListener(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerAutoscaling":       schema_pkg_apis_triggers_v1beta1_EventListenerAutoscaling(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerAutoscalingMetric": schema_pkg_apis_triggers_v1beta1_EventListenerAutoscalingMetric(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerConfig":            schema_pkg_apis_triggers_v1beta1_EventListenerConfig(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerExposure":          schema_pkg_apis_triggers_v1beta1_EventListenerExposure(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerList":              schema_pkg_apis_triggers_v1beta1_EventListenerList(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerSpec":              schema_pkg_apis_triggers_v1beta1_EventListenerSpec(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerStatus":            schema_pkg_apis_triggers_v1beta1_EventListenerStatus(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTLS":               schema_pkg_apis_triggers_v1beta1_EventListenerTLS(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger":           schema_pkg_apis_triggers_v1beta1_EventListenerTrigger(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup":      schema_pkg_apis_triggers_v1beta1_EventListenerTriggerGroup(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector":   schema_pkg_apis_triggers_v1beta1_EventListenerTriggerSelector(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerStatus":     schema_pkg_apis_triggers_v1beta1_EventListenerTriggerStatus(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ExposureGateway":                schema_pkg_apis_triggers_v1beta1_ExposureGateway(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorParams":              schema_pkg_apis_triggers_v1beta1_InterceptorParams(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorRef":                 schema_pkg_apis_triggers_v1beta1_InterceptorRef(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorRequest":             schema_pkg_apis_triggers_v1beta1_InterceptorRequest(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.InterceptorResponse":            schema_pkg_apis_triggers_v1beta1_InterceptorResponse(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KafkaSource":                    schema_pkg_apis_triggers_v1beta1_KafkaSource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KnativeServiceResource":         schema_pkg_apis_triggers_v1beta1_KnativeServiceResource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesEventSource":          schema_pkg_apis_triggers_v1beta1_KubernetesEventSource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource":             schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.MessageSource":                  schema_pkg_apis_triggers_v1beta1_MessageSource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NATSSource":                     schema_pkg_apis_triggers_v1beta1_NATSSource(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector":              schema_pkg_apis_triggers_v1beta1_NamespaceSelector(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param":                          schema_pkg_apis_triggers_v1beta1_Param(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ParamSpec":                      schema_pkg_apis_triggers_v1beta1_ParamSpec(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.PayloadDecoder":                 schema_pkg_apis_triggers_v1beta1_PayloadDecoder(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources":                      schema_pkg_apis_triggers_v1beta1_Resources(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.SecretRef":                      schema_pkg_apis_triggers_v1beta1_SecretRef(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Status":                         schema_pkg_apis_triggers_v1beta1_Status(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.StatusError":                    schema_pkg_apis_triggers_v1beta1_StatusError(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Trigger":                        schema_pkg_apis_triggers_v1beta1_Trigger(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBinding":                 schema_pkg_apis_triggers_v1beta1_TriggerBinding(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingList":             schema_pkg_apis_triggers_v1beta1_TriggerBindingList(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingSpec":             schema_pkg_apis_triggers_v1beta1_TriggerBindingSpec(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerBindingStatus":           schema_pkg_apis_triggers_v1beta1_TriggerBindingStatus(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerContext":                 schema_pkg_apis_triggers_v1beta1_TriggerContext(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor":             schema_pkg_apis_triggers_v1beta1_TriggerInterceptor(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerList":                    schema_pkg_apis_triggers_v1beta1_TriggerList(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerPoll":                    schema_pkg_apis_triggers_v1beta1_TriggerPoll(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerResourceTemplate":        schema_pkg_apis_triggers_v1beta1_TriggerResourceTemplate(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute":                   schema_pkg_apis_triggers_v1beta1_TriggerRoute(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSchedule":                schema_pkg_apis_triggers_v1beta1_TriggerSchedule(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpec":                    schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding":             schema_pkg_apis_triggers_v1beta1_TriggerSpecBinding(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate":            schema_pkg_apis_triggers_v1beta1_TriggerSpecTemplate(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerStatus":                  schema_pkg_apis_triggers_v1beta1_TriggerStatus(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplate":                schema_pkg_apis_triggers_v1beta1_TriggerTemplate(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateList":            schema_pkg_apis_triggers_v1beta1_TriggerTemplateList(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateSpec":            schema_pkg_apis_triggers_v1beta1_TriggerTemplateSpec(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerTemplateStatus":          schema_pkg_apis_triggers_v1beta1_TriggerTemplateStatus(ref),
+		"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.WebhookInterceptor":             schema_pkg_apis_triggers_v1beta1_WebhookInterceptor(ref),
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_AMQPSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AMQPSource consumes the messages of an AMQP 0-9-1 queue.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the amqp:// or amqps:// URL of the broker, without credentials.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Queue is the consumed queue, which must exist.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prefetch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefetch is the number of messages delivered to each replica before they are acknowledged. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"url", "queue"},
+			},
+		},
 	}
 }
 
@@ -226,6 +280,87 @@ func schema_pkg_apis_triggers_v1beta1_EventListener(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_EventListenerAutoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventListenerAutoscaling configures the HorizontalPodAutoscaler generated for the EventListener Deployment. The HorizontalPodAutoscaler scales on the average CPU utilization of the pods, on a custom metric of the events they process, or on both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the lower limit for the number of replicas. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the upper limit for the number of replicas.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetCPUUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetCPUUtilizationPercentage is the target average CPU utilization of the pods, as a percentage of the CPU they request. Defaults to 80% when no Metric is set either.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metric": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metric scales the EventListener on a per pod metric of the events it processes, served to the HorizontalPodAutoscaler by a custom metrics API adapter such as prometheus-adapter.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerAutoscalingMetric"),
+						},
+					},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerAutoscalingMetric"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_EventListenerAutoscalingMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventListenerAutoscalingMetric is a custom per pod metric the HorizontalPodAutoscaler of an EventListener targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the kind of event metric, InFlightEvents or EventsReceivedRate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the metric in the custom metrics API. Defaults to eventlistener_events_in_flight for InFlightEvents and to eventlistener_event_received_rate for EventsReceivedRate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetAverageValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetAverageValue is the target value of the metric averaged across the pods.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"type", "targetAverageValue"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_EventListenerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -248,6 +383,64 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerConfig(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_EventListenerExposure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventListenerExposure routes the requests for an external hostname to the Service of the EventListener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the kind of object created to expose the EventListener. Defaults to Ingress.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostname": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hostname is the external hostname the EventListener is reachable at.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path prefix routed to the EventListener. Defaults to /.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"className": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClassName is the IngressClass of the Ingress. Only used by the Ingress type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSSecretName is the name of a Secret in the namespace of the EventListener holding the certificate of the Hostname, used by the Ingress to terminate TLS. Only used by the Ingress type, the TLS of an HTTPRoute is configured on the listeners of its Gateway.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gateway is the Gateway the HTTPRoute is attached to. Required by the HTTPRoute type.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ExposureGateway"),
+						},
+					},
+				},
+				Required: []string{"hostname"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.ExposureGateway"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_EventListenerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -370,11 +563,100 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerSpec(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"kubernetesEventSources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesEventSources fire the Triggers of the EventListener when Kubernetes resources are added, updated or deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesEventSource"),
+									},
+								},
+							},
+						},
+					},
+					"messageSources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageSources consume the events processed by the Triggers of the EventListener from message brokers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.MessageSource"),
+									},
+								},
+							},
+						},
+					},
+					"pollSecretNames": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PollSecretNames are the names of the Secrets the Triggers processed by the EventListener may read to authenticate to the repositories they poll. A Secret not listed here is only read if an EventListenerPolicy constraining the EventListener lists it in its secretNames.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"payloadDecoders": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PayloadDecoders decode the bodies of the incoming events into the structured body passed to interceptors and bindings, by content type. The first decoder matching the content type of an event is used, and bodies without a matching decoder are decoded as JSON.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.PayloadDecoder"),
+									},
+								},
+							},
+						},
+					},
+					"exposure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exposure makes the EventListener reachable from outside of the cluster through an Ingress or a Gateway API HTTPRoute owned by the EventListener.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerExposure"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS makes the EventListener serve HTTPS with the certificate of a Secret, or with a certificate generated and rotated by the controller.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTLS"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerExposure", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTLS", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTrigger", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerGroup", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesEventSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.MessageSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.PayloadDecoder", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Resources", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -455,12 +737,58 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerStatus(ref common.ReferenceCa
 							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerConfig"),
 						},
 					},
+					"triggers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Triggers reports, for every Trigger the EventListener processes events for, whether the objects it references could be resolved.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"configuration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerConfig", "knative.dev/pkg/apis.Condition", "knative.dev/pkg/apis/duck/v1beta1.Addressable"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerConfig", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerStatus", "knative.dev/pkg/apis.Condition", "knative.dev/pkg/apis/duck/v1beta1.Addressable"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_EventListenerTLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventListenerTLS configures the certificate the EventListener serves HTTPS with. Exactly one of SecretName and Generate is set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a kubernetes.io/tls Secret in the namespace of the EventListener holding the certificate and key to serve.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generate makes the controller generate a certificate for the Service of the EventListener, signed by a generated CA, and rotate it before it expires. The certificate is stored in the el-<name>-tls Secret, along with the CA certificate under the ca.crt key.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -529,11 +857,17 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerTrigger(ref common.ReferenceC
 							Format:      "",
 						},
 					},
+					"route": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Route restricts the incoming events processed by the Trigger to the requests for an HTTP path, and optionally methods",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
 	}
 }
 
@@ -574,12 +908,18 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerTriggerGroup(ref common.Refer
 							Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector"),
 						},
 					},
+					"route": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Route restricts the incoming events processed by the group to the requests for an HTTP path, and optionally methods. The Triggers of the group that have their own Route only process the requests that match both.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute"),
+						},
+					},
 				},
 				Required: []string{"name", "interceptors", "triggerSelector"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerTriggerSelector", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute"},
 	}
 }
 
@@ -609,28 +949,122 @@ func schema_pkg_apis_triggers_v1beta1_EventListenerTriggerSelector(ref common.Re
 	}
 }
 
-func schema_pkg_apis_triggers_v1beta1_InterceptorParams(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_triggers_v1beta1_EventListenerTriggerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InterceptorParams defines a key-value pair that can be passed on an interceptor",
+				Description: "EventListenerTriggerStatus reports whether the bindings, template and interceptors of a single Trigger processed by an EventListener exist.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name is the name of the Trigger, or of the trigger defined inline in the EventListener.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"value": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
+							Description: "Namespace is the namespace of the Trigger.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name", "value"},
-			},
+					"triggerGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggerGroup is the name of the trigger group that selected the Trigger, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resolved is true when every object referenced by the Trigger exists.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a CamelCase reason for the Trigger not being resolved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the Reason.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "namespace", "resolved"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_ExposureGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExposureGateway refers to the Gateway, and optionally the listener of the Gateway, an HTTPRoute is attached to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the Gateway. Defaults to the namespace of the EventListener.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sectionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SectionName is the name of the listener of the Gateway. Defaults to all the listeners of the Gateway.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_InterceptorParams(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterceptorParams defines a key-value pair that can be passed on an interceptor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
 		},
 		Dependencies: []string{
 			"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"},
@@ -799,6 +1233,197 @@ func schema_pkg_apis_triggers_v1beta1_InterceptorResponse(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_KafkaSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaSource consumes the messages of Kafka topics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"brokers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Brokers are the addresses of the Kafka brokers, as host:port.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"topics": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Topics are the consumed topics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"consumerGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsumerGroup is the consumer group shared by the replicas of the EventListener, which commits the offsets of the processed messages.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"saslMechanism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASLMechanism is the SASL mechanism used with the credentials of the AuthSecretName. Defaults to PLAIN.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"brokers", "topics", "consumerGroup"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_KnativeServiceResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KnativeServiceResource configures the Knative Service generated for the EventListener. The Knative Service times requests out after the timeout handler of the EventListener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minScale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinScale is the minimum number of replicas. Defaults to 0, which scales the EventListener to zero when it doesn't receive events. It must be at least 1 with KubernetesEventSources or MessageSources, and is raised to 1 while the EventListener has scheduled or polling Triggers.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxScale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxScale is the maximum number of replicas. Defaults to 0, which doesn't limit the number of replicas.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"containerConcurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerConcurrency is the maximum number of events a replica receives at the same time. Defaults to 0, which doesn't limit them.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("knative.dev/pkg/apis/duck/v1.WithPodSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"knative.dev/pkg/apis/duck/v1.WithPodSpec"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_KubernetesEventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubernetesEventSource watches Kubernetes resources and fires an event with the resource as its body for each change to them.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the source in the events it fires.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion of the watched resources, for example tekton.dev/v1.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the watched resources, for example PipelineRun.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects the namespaces of the watched resources. Defaults to the namespace of the EventListener; \"*\" selects all of them. It is ignored for cluster-scoped resources.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector"),
+						},
+					},
+					"labelSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelSelector selects the watched resources.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"eventTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "EventTypes are the changes that fire events. Defaults to all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is a CEL expression that returns true for the changes that fire events. It can use the type of the change as eventType, the resource as object, and the resource before an update as oldObject.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "apiVersion", "kind"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NamespaceSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -829,6 +1454,12 @@ func schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref common.ReferenceCal
 							Format: "",
 						},
 					},
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling scales the EventListener Deployment with a HorizontalPodAutoscaler. It can't be set together with Replicas.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerAutoscaling"),
+						},
+					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
@@ -839,7 +1470,105 @@ func schema_pkg_apis_triggers_v1beta1_KubernetesResource(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"knative.dev/pkg/apis/duck/v1.WithPodSpec"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.EventListenerAutoscaling", "knative.dev/pkg/apis/duck/v1.WithPodSpec"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_MessageSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageSource consumes events from a message broker. Each message is processed like an incoming event, and only acknowledged once none of the Triggers failed to process it. Exactly one of Kafka, NATS and AMQP is set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name identifies the source in the events it fires.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KafkaSource"),
+						},
+					},
+					"nats": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NATSSource"),
+						},
+					},
+					"amqp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.AMQPSource"),
+						},
+					},
+					"authSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthSecretName is the name of a Secret in the namespace of the EventListener with the username and password used to authenticate to the broker.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tlsSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLSSecretName is the name of a Secret in the namespace of the EventListener used to connect to the broker with TLS. Its optional ca.crt key holds the certificate authorities of the broker, and its optional tls.crt and tls.key keys hold a client certificate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.AMQPSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KafkaSource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.NATSSource"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_NATSSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NATSSource consumes the messages of a NATS JetStream subject.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the comma separated URLs of the NATS servers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject is the consumed subject, which can contain wildcards.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stream": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stream is the name of the JetStream stream of the subject. Defaults to the stream that captures the subject.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"durable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Durable is the name of the durable consumer shared by the replicas of the EventListener.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "subject", "durable"},
+			},
+		},
 	}
 }
 
@@ -919,6 +1648,13 @@ func schema_pkg_apis_triggers_v1beta1_ParamSpec(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the user-specified type of the parameter. The possible types are currently \"string\", \"array\" and \"object\", and \"string\" is the default. Values for \"array\" and \"object\" params are JSON encoded and are substituted into resource templates as JSON values.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"description": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Description is a user-facing description of the parameter that may be used to populate a UI.",
@@ -928,7 +1664,7 @@ func schema_pkg_apis_triggers_v1beta1_ParamSpec(ref common.ReferenceCallback) co
 					},
 					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Default is the value a parameter takes if no input value via a Param is supplied.",
+							Description: "Default is the value a parameter takes if no input value via a Param is supplied. For \"array\" and \"object\" params this must be a JSON encoded value of the declared type.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -940,6 +1676,36 @@ func schema_pkg_apis_triggers_v1beta1_ParamSpec(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_PayloadDecoder(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PayloadDecoder decodes the bodies of a content type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"contentType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentType is the media type of the decoded bodies, which can contain wildcards, e.g. application/*+xml or text/*.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is the format the bodies are decoded from.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"contentType", "format"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_Resources(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -956,11 +1722,17 @@ func schema_pkg_apis_triggers_v1beta1_Resources(ref common.ReferenceCallback) co
 							Ref: ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource"),
 						},
 					},
+					"knativeService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KnativeService runs the EventListener as a Knative Service, which Knative Serving scales to zero when the EventListener doesn't receive events.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KnativeServiceResource"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.CustomResource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KnativeServiceResource", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.KubernetesResource"},
 	}
 }
 
@@ -1070,11 +1842,18 @@ func schema_pkg_apis_triggers_v1beta1_Trigger(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status reports whether the objects the Trigger references exist and are compatible with each other.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpec", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1194,8 +1973,66 @@ func schema_pkg_apis_triggers_v1beta1_TriggerBindingSpec(ref common.ReferenceCal
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param"},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_TriggerBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerBindingStatus defines the observed state of TriggerBinding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the 'Generation' of the Service that was last processed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("knative.dev/pkg/apis.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is additional Status fields for the Resource to save some additional State as well as convey more information to the user. This is roughly akin to Annotations on any k8s resource, just the reconciler conveying richer information outwards.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -1205,18 +2042,7 @@ func schema_pkg_apis_triggers_v1beta1_TriggerBindingSpec(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.Param"},
-	}
-}
-
-func schema_pkg_apis_triggers_v1beta1_TriggerBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TriggerBindingStatus defines the observed state of TriggerBinding.",
-				Type:        []string{"object"},
-			},
-		},
+			"knative.dev/pkg/apis.Condition"},
 	}
 }
 
@@ -1247,6 +2073,13 @@ func schema_pkg_apis_triggers_v1beta1_TriggerContext(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"verified_replay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerifiedReplay is true when the event is a replay of a recorded event authenticated by the EventListener. Interceptors don't verify the signature of such events, since their signature headers are redacted when recorded.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1357,6 +2190,63 @@ func schema_pkg_apis_triggers_v1beta1_TriggerList(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_TriggerPoll(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerPoll defines the Git repository polled by the EventListener for a Trigger. Each new or updated ref that matches Refs fires a push event.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the HTTP(S) URL of the Git repository",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"refs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Refs are the patterns of the refs to watch, for example \"refs/heads/main\" or \"refs/tags/*\". Defaults to \"refs/heads/*\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the time between two polls of the repository. Defaults to one minute.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is the name of a Secret in the namespace of the Trigger whose username and password keys are used to authenticate to the repository, such as a kubernetes.io/basic-auth Secret. The URL must use https, and the Secret must be allowed by the pollSecretNames of the EventListener or by an EventListenerPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_TriggerResourceTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1368,6 +2258,100 @@ func schema_pkg_apis_triggers_v1beta1_TriggerResourceTemplate(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_TriggerRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerRoute matches the requests received by an EventListener on their HTTP path and method.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the HTTP path of the requests, for example \"/github\". A trailing slash is ignored. A segment \"{name}\" matches any segment of the path, and a last segment \"{name...}\" matches the rest of the path; bindings access their values with $(context.pathParams.name).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"methods": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Methods are the HTTP methods of the requests, for example \"POST\". Defaults to every method.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"path"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_triggers_v1beta1_TriggerSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerSchedule defines the events fired on a cron schedule by the EventListener for a Trigger. The Body and the values of the Header can use the $(context.eventID) and $(context.scheduledTime) variables.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cron": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cron is the schedule in cron format, for example \"0 */2 * * *\" or \"@hourly\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the name of the time zone of the schedule, for example \"Europe/Paris\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body is the body of the scheduled events. Defaults to an empty JSON object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header holds the headers of the scheduled events",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"cron"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1428,12 +2412,30 @@ func schema_pkg_apis_triggers_v1beta1_TriggerSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule fires the Trigger on a cron schedule. A Trigger with a Schedule only processes its scheduled events.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSchedule"),
+						},
+					},
+					"poll": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Poll fires the Trigger when the refs of a Git repository change. A Trigger that polls a repository only processes the events for its changed refs.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerPoll"),
+						},
+					},
+					"route": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Route restricts the incoming events processed by the Trigger to the requests for an HTTP path, and optionally methods. A Trigger without a Route processes the requests for every path.",
+							Ref:         ref("github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute"),
+						},
+					},
 				},
 				Required: []string{"bindings", "template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
+			"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerInterceptor", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerPoll", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerRoute", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSchedule", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecBinding", "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1.TriggerSpecTemplate"},
 	}
 }
 
@@ -1517,6 +2519,64 @@ func schema_pkg_apis_triggers_v1beta1_TriggerSpecTemplate(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_triggers_v1beta1_TriggerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TriggerStatus defines the observed state of Trigger.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the 'Generation' of the Service that was last processed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("knative.dev/pkg/apis.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is additional Status fields for the Resource to save some additional State as well as convey more information to the user. This is roughly akin to Annotations on any k8s resource, just the reconciler conveying richer information outwards.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"knative.dev/pkg/apis.Condition"},
+	}
+}
+
 func schema_pkg_apis_triggers_v1beta1_TriggerTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1668,10 +2728,57 @@ func schema_pkg_apis_triggers_v1beta1_TriggerTemplateStatus(ref common.Reference
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TriggerTemplateStatus describes the desired state of TriggerTemplate",
+				Description: "TriggerTemplateStatus describes the observed state of TriggerTemplate",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the 'Generation' of the Service that was last processed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions the latest available observations of a resource's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("knative.dev/pkg/apis.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is additional Status fields for the Resource to save some additional State as well as convey more information to the user. This is roughly akin to Annotations on any k8s resource, just the reconciler conveying richer information outwards.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"knative.dev/pkg/apis.Condition"},
 	}
 }
 
@@ -1,19 +1,51 @@
 package v1beta1
 
+// ParamType indicates the type of an input parameter.
+// Used to distinguish between a single string, an array of strings and an
+// object with string values.
+type ParamType string
+
+// Valid ParamTypes:
+const (
+	ParamTypeString ParamType = "string"
+	ParamTypeArray  ParamType = "array"
+	ParamTypeObject ParamType = "object"
+)
+
+// AllParamTypes can be used for ParamType validation.
+var AllParamTypes = []ParamType{ParamTypeString, ParamTypeArray, ParamTypeObject}
+
 // ParamSpec defines an arbitrary named  input whose value can be supplied by a
 // `Param`.
 type ParamSpec struct {
 	// Name declares the name by which a parameter is referenced.
 	Name string `json:"name"`
+	// Type is the user-specified type of the parameter. The possible types
+	// are currently "string", "array" and "object", and "string" is the
+	// default. Values for "array" and "object" params are JSON encoded and
+	// are substituted into resource templates as JSON values.
+	// +optional
+	Type ParamType `json:"type,omitempty"`
 	// Description is a user-facing description of the parameter that may be
 	// used to populate a UI.
 	// +optional
 	Description string `json:"description,omitempty"`
 	// Default is the value a parameter takes if no input value via a Param is supplied.
+	// For "array" and "object" params this must be a JSON encoded value of the
+	// declared type.
 	// +optional
 	Default *string `json:"default,omitempty"`
 }
 
+// GetType returns the declared type of the ParamSpec, defaulting to
+// ParamTypeString when no type is set.
+func (ps ParamSpec) GetType() ParamType {
+	if ps.Type == "" {
+		return ParamTypeString
+	}
+	return ps.Type
+}
+
 // Param defines a string value to be used for a ParamSpec with the same name.
 type Param struct {
 	Name  string `json:"name"`
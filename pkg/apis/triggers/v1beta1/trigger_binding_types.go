@@ -19,6 +19,7 @@ package v1beta1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 // Check that TriggerBinding may be validated and defaulted.
@@ -45,9 +46,30 @@ type TriggerBindingSpec struct {
 }
 
 // TriggerBindingStatus defines the observed state of TriggerBinding.
-type TriggerBindingStatus struct{}
+type TriggerBindingStatus struct {
+	duckv1.Status `json:",inline"`
+}
+
+var triggerBindingCondSet = apis.NewLivingConditionSet()
+
+// GetCondition returns the Condition matching the given type.
+func (tbs *TriggerBindingStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return triggerBindingCondSet.Manage(tbs).GetCondition(t)
+}
+
+// MarkReady marks the TriggerBinding as ready.
+func (tbs *TriggerBindingStatus) MarkReady() {
+	triggerBindingCondSet.Manage(tbs).MarkTrue(apis.ConditionReady)
+}
+
+// MarkNotReady marks the TriggerBinding as not ready with the given reason
+// and message.
+func (tbs *TriggerBindingStatus) MarkNotReady(reason, messageFormat string, messageA ...interface{}) {
+	triggerBindingCondSet.Manage(tbs).MarkFalse(apis.ConditionReady, reason, messageFormat, messageA...)
+}
 
 // +genclient
+// +genreconciler:krshapedlogic=false
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TriggerBinding defines a mapping of an input event to parameters. This is used
@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 // Check that TriggerTemplate may be validated and defaulted.
@@ -39,11 +40,32 @@ type TriggerResourceTemplate struct {
 	runtime.RawExtension `json:",inline"`
 }
 
-// TriggerTemplateStatus describes the desired state of TriggerTemplate
-type TriggerTemplateStatus struct{}
+// TriggerTemplateStatus describes the observed state of TriggerTemplate
+type TriggerTemplateStatus struct {
+	duckv1.Status `json:",inline"`
+}
+
+var triggerTemplateCondSet = apis.NewLivingConditionSet()
+
+// GetCondition returns the Condition matching the given type.
+func (tts *TriggerTemplateStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return triggerTemplateCondSet.Manage(tts).GetCondition(t)
+}
+
+// MarkReady marks the TriggerTemplate as ready.
+func (tts *TriggerTemplateStatus) MarkReady() {
+	triggerTemplateCondSet.Manage(tts).MarkTrue(apis.ConditionReady)
+}
+
+// MarkNotReady marks the TriggerTemplate as not ready with the given reason
+// and message.
+func (tts *TriggerTemplateStatus) MarkNotReady(reason, messageFormat string, messageA ...interface{}) {
+	triggerTemplateCondSet.Manage(tts).MarkFalse(apis.ConditionReady, reason, messageFormat, messageA...)
+}
 
 //
 // +genclient
+// +genreconciler:krshapedlogic=false
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TriggerTemplate takes parameters and uses them to create CRDs
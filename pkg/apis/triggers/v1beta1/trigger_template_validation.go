@@ -18,10 +18,13 @@ package v1beta1
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 
+	"github.com/google/cel-go/cel"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -43,6 +46,7 @@ func (t *TriggerTemplate) SupportedVerbs() []admissionregistrationv1.OperationTy
 // Validate validates a TriggerTemplate.
 func (t *TriggerTemplate) Validate(ctx context.Context) *apis.FieldError {
 	errs := validate.ObjectMetadata(t.GetObjectMeta()).ViaField("metadata")
+	errs = errs.Also(triggers.ValidateTemplateAnnotations(t.GetObjectMeta().GetAnnotations()))
 	return errs.Also(t.Spec.validate(ctx).ViaField("spec"))
 }
 
@@ -56,7 +60,9 @@ func (s *TriggerTemplateSpec) validate(ctx context.Context) (errs *apis.FieldErr
 	if len(s.ResourceTemplates) == 0 {
 		errs = errs.Also(apis.ErrMissingField("resourcetemplates"))
 	}
+	errs = errs.Also(validateParamSpecs(s.Params).ViaField("params"))
 	errs = errs.Also(validateResourceTemplates(s.ResourceTemplates).ViaField("resourcetemplates"))
+	errs = errs.Also(validateResourceTemplateAnnotations(s.Params, s.ResourceTemplates).ViaField("resourcetemplates"))
 	errs = errs.Also(verifyParamDeclarations(s.Params, s.ResourceTemplates).ViaField("resourcetemplates"))
 	return errs
 }
@@ -76,6 +82,126 @@ func validateResourceTemplates(templates []TriggerResourceTemplate) (errs *apis.
 	return errs
 }
 
+// validateResourceTemplateAnnotations checks that the when annotations of the
+// resource templates are valid CEL expressions, that the for-each
+// annotations name a declared array param, and that the action annotations
+// are supported.
+func validateResourceTemplateAnnotations(params []ParamSpec, templates []TriggerResourceTemplate) (errs *apis.FieldError) {
+	paramTypes := make(map[string]ParamType, len(params))
+	for _, p := range params {
+		paramTypes[p.Name] = p.GetType()
+	}
+	for i, trt := range templates {
+		data := new(unstructured.Unstructured)
+		if err := data.UnmarshalJSON(trt.Raw); err != nil {
+			// invalid resource templates are reported by validateResourceTemplates
+			continue
+		}
+		path := fmt.Sprintf("[%d].metadata.annotations", i)
+		annotations := data.GetAnnotations()
+		if when, ok := annotations[triggers.WhenAnnotation]; ok {
+			env, err := cel.NewEnv()
+			if err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Errorf("failed to create a CEL env: %w", err), path))
+			} else if _, issues := env.Parse(when); issues != nil && issues.Err() != nil {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("failed to parse the %s expression: %s", triggers.WhenAnnotation, issues.Err()), path))
+			}
+		}
+		if forEach, ok := annotations[triggers.ForEachAnnotation]; ok {
+			if t, declared := paramTypes[forEach]; !declared || t != ParamTypeArray {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s must name a declared array param, got %q", triggers.ForEachAnnotation, forEach), path))
+			}
+		}
+		errs = errs.Also(validateActionAnnotations(data, path))
+	}
+	return errs
+}
+
+// validateActionAnnotations checks the action and patch type annotations of a
+// resource template.
+func validateActionAnnotations(data *unstructured.Unstructured, path string) (errs *apis.FieldError) {
+	annotations := data.GetAnnotations()
+	action, ok := annotations[triggers.ActionAnnotation]
+	if !ok {
+		if _, ok := annotations[triggers.PatchTypeAnnotation]; ok {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s requires the %s action", triggers.PatchTypeAnnotation, triggers.PatchAction), path))
+		}
+		return errs
+	}
+	switch action {
+	case triggers.CreateAction:
+	case triggers.ApplyAction, triggers.PatchAction, triggers.DeleteAction:
+		if data.GetName() == "" {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("resource templates with the %s action must have a metadata.name", action), path))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s must be one of %s, %s, %s or %s, got %q", triggers.ActionAnnotation,
+			triggers.CreateAction, triggers.ApplyAction, triggers.PatchAction, triggers.DeleteAction, action), path))
+	}
+	patchType, ok := annotations[triggers.PatchTypeAnnotation]
+	if !ok {
+		return errs
+	}
+	switch {
+	case action != triggers.PatchAction:
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s requires the %s action", triggers.PatchTypeAnnotation, triggers.PatchAction), path))
+	case patchType == triggers.MergePatchType:
+	case patchType == triggers.JSONPatchType:
+		if _, ok := data.Object["jsonPatch"].([]interface{}); !ok {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("resource templates with the %s patch type must have a jsonPatch list", triggers.JSONPatchType), path))
+		}
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s must be %s or %s, got %q", triggers.PatchTypeAnnotation, triggers.MergePatchType, triggers.JSONPatchType, patchType), path))
+	}
+	return errs
+}
+
+// validateParamSpecs checks that every ParamSpec has a supported type and that
+// the default value, if any, is valid for the declared type.
+func validateParamSpecs(params []ParamSpec) (errs *apis.FieldError) {
+	for i, p := range params {
+		if p.Type != "" && !isValidParamType(p.Type) {
+			errs = errs.Also(apis.ErrInvalidValue(string(p.Type), fmt.Sprintf("[%d].type", i)))
+			continue
+		}
+		if p.Default == nil {
+			continue
+		}
+		if err := ValidateParamValue(p.GetType(), *p.Default); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), fmt.Sprintf("[%d].default", i)))
+		}
+	}
+	return errs
+}
+
+func isValidParamType(t ParamType) bool {
+	for _, v := range AllParamTypes {
+		if t == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateParamValue checks that value is a valid value for a param of type t.
+// Values for "array" params must be a JSON array of strings, values for "object"
+// params must be a JSON object with string values.
+func ValidateParamValue(t ParamType, value string) error {
+	switch t {
+	case ParamTypeArray:
+		var v []string
+		if err := json.Unmarshal([]byte(value), &v); err != nil || v == nil {
+			return fmt.Errorf("value %q is not a JSON array of strings", value)
+		}
+	case ParamTypeObject:
+		var v map[string]string
+		if err := json.Unmarshal([]byte(value), &v); err != nil || v == nil {
+			return fmt.Errorf("value %q is not a JSON object with string values", value)
+		}
+	}
+	return nil
+}
+
 // Verify every param in the ResourceTemplates is declared with a ParamSpec
 func verifyParamDeclarations(params []ParamSpec, templates []TriggerResourceTemplate) *apis.FieldError {
 	declaredParamNames := sets.NewString()
@@ -18,6 +18,7 @@ package v1beta1_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
@@ -99,6 +100,19 @@ func invalidParamResourceTemplate(t *testing.T) runtime.RawExtension {
 	})
 }
 
+func annotatedResourceTemplate(t *testing.T, annotations map[string]string) runtime.RawExtension {
+	return test.RawExtension(t, pipelinev1.PipelineRun{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "tekton.dev/v1",
+			Kind:       "PipelineRun",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "build-",
+			Annotations:  annotations,
+		},
+	})
+}
+
 func TestTriggerTemplate_Validate(t *testing.T) {
 	tcs := []struct {
 		name     string
@@ -340,6 +354,109 @@ func TestTriggerTemplate_Validate(t *testing.T) {
 			},
 		},
 		want: apis.ErrMissingField("spec", "spec.resourcetemplates"),
+	}, {
+		name: "valid array and object params",
+		template: &v1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: "foo",
+			},
+			Spec: v1beta1.TriggerTemplateSpec{
+				Params: []v1beta1.ParamSpec{{
+					Name:    "files",
+					Type:    v1beta1.ParamTypeArray,
+					Default: ptr.String(`["a.go", "b.go"]`),
+				}, {
+					Name:    "labels",
+					Type:    v1beta1.ParamTypeObject,
+					Default: ptr.String(`{"app": "foo"}`),
+				}, {
+					Name: "foo",
+					Type: v1beta1.ParamTypeString,
+				}},
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+					RawExtension: paramResourceTemplate(t),
+				}},
+			},
+		},
+		want: nil,
+	}, {
+		name: "invalid param type",
+		template: &v1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: "foo",
+			},
+			Spec: v1beta1.TriggerTemplateSpec{
+				Params: []v1beta1.ParamSpec{{
+					Name: "foo",
+					Type: "number",
+				}},
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+					RawExtension: paramResourceTemplate(t),
+				}},
+			},
+		},
+		want: apis.ErrInvalidValue("number", "spec.params[0].type"),
+	}, {
+		name: "default does not match param type",
+		template: &v1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: "foo",
+			},
+			Spec: v1beta1.TriggerTemplateSpec{
+				Params: []v1beta1.ParamSpec{{
+					Name:    "foo",
+					Type:    v1beta1.ParamTypeArray,
+					Default: ptr.String(`{"a": "b"}`),
+				}},
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+					RawExtension: paramResourceTemplate(t),
+				}},
+			},
+		},
+		want: apis.ErrInvalidValue(`value "{\"a\": \"b\"}" is not a JSON array of strings`, "spec.params[0].default"),
+	}, {
+		name: "valid when and for-each annotations",
+		template: &v1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: "foo",
+			},
+			Spec: v1beta1.TriggerTemplateSpec{
+				Params: []v1beta1.ParamSpec{{
+					Name: "dirs",
+					Type: v1beta1.ParamTypeArray,
+				}},
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+					RawExtension: annotatedResourceTemplate(t, map[string]string{
+						"triggers.tekton.dev/when":     "item != 'docs'",
+						"triggers.tekton.dev/for-each": "dirs",
+					}),
+				}},
+			},
+		},
+		want: nil,
+	}, {
+		name: "for-each annotation names a string param",
+		template: &v1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: "foo",
+			},
+			Spec: v1beta1.TriggerTemplateSpec{
+				Params: []v1beta1.ParamSpec{{
+					Name: "dir",
+				}},
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+					RawExtension: annotatedResourceTemplate(t, map[string]string{
+						"triggers.tekton.dev/for-each": "dir",
+					}),
+				}},
+			},
+		},
+		want: apis.ErrInvalidValue(`triggers.tekton.dev/for-each must name a declared array param, got "dir"`, "spec.resourcetemplates[0].metadata.annotations"),
 	}}
 
 	for _, tc := range tcs {
@@ -351,3 +468,85 @@ func TestTriggerTemplate_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerTemplate_ValidateWhenAnnotation(t *testing.T) {
+	template := &v1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tt",
+			Namespace: "foo",
+		},
+		Spec: v1beta1.TriggerTemplateSpec{
+			ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+				RawExtension: annotatedResourceTemplate(t, map[string]string{
+					"triggers.tekton.dev/when": "params.ref ===",
+				}),
+			}},
+		},
+	}
+	err := template.Validate(context.Background())
+	if err == nil {
+		t.Fatal("TriggerTemplate Validation did not fail for an invalid when annotation")
+	}
+	if !strings.HasPrefix(err.Error(), "invalid value: failed to parse the triggers.tekton.dev/when expression") ||
+		!strings.HasSuffix(err.Error(), ": spec.resourcetemplates[0].metadata.annotations") {
+		t.Errorf("TriggerTemplate Validation returned unexpected error: %s", err)
+	}
+}
+
+func TestTriggerTemplate_ValidateActionAnnotation(t *testing.T) {
+	const path = "spec.resourcetemplates[0].metadata.annotations"
+	tcs := []struct {
+		name string
+		rt   string
+		want *apis.FieldError
+	}{{
+		name: "apply with name",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "apply"}}}`,
+	}, {
+		name: "create with generateName",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"generateName": "cm-", "annotations": {"triggers.tekton.dev/action": "create"}}}`,
+	}, {
+		name: "json patch",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "patch", "triggers.tekton.dev/patch-type": "json"}}, "jsonPatch": [{"op": "remove", "path": "/data/a"}]}`,
+	}, {
+		name: "unknown action",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "update"}}}`,
+		want: apis.ErrInvalidValue(`triggers.tekton.dev/action must be one of create, apply, patch or delete, got "update"`, path),
+	}, {
+		name: "delete without name",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"generateName": "cm-", "annotations": {"triggers.tekton.dev/action": "delete"}}}`,
+		want: apis.ErrInvalidValue("resource templates with the delete action must have a metadata.name", path),
+	}, {
+		name: "patch type without patch action",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/patch-type": "merge"}}}`,
+		want: apis.ErrInvalidValue("triggers.tekton.dev/patch-type requires the patch action", path),
+	}, {
+		name: "json patch without operations",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "patch", "triggers.tekton.dev/patch-type": "json"}}}`,
+		want: apis.ErrInvalidValue("resource templates with the json patch type must have a jsonPatch list", path),
+	}, {
+		name: "unknown patch type",
+		rt:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "patch", "triggers.tekton.dev/patch-type": "strategic"}}}`,
+		want: apis.ErrInvalidValue(`triggers.tekton.dev/patch-type must be merge or json, got "strategic"`, path),
+	}}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			template := &v1beta1.TriggerTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tt",
+					Namespace: "foo",
+				},
+				Spec: v1beta1.TriggerTemplateSpec{
+					ResourceTemplates: []v1beta1.TriggerResourceTemplate{{
+						RawExtension: runtime.RawExtension{Raw: []byte(tc.rt)},
+					}},
+				},
+			}
+			got := template.Validate(context.Background())
+			if d := cmp.Diff(tc.want.Error(), got.Error()); d != "" {
+				t.Errorf("TriggerTemplate Validation failed: %s", d)
+			}
+		})
+	}
+}
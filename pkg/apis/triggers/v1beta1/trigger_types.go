@@ -17,11 +17,15 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
+
+	"github.com/robfig/cron/v3"
 	"github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
 // TriggerSpec represents a connection between TriggerSpecBinding,
@@ -40,6 +44,140 @@ type TriggerSpec struct {
 	// as the Trigger itself
 	// +optional
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// Schedule fires the Trigger on a cron schedule. A Trigger with a
+	// Schedule only processes its scheduled events.
+	// +optional
+	Schedule *TriggerSchedule `json:"schedule,omitempty"`
+	// Poll fires the Trigger when the refs of a Git repository change. A
+	// Trigger that polls a repository only processes the events for its
+	// changed refs.
+	// +optional
+	Poll *TriggerPoll `json:"poll,omitempty"`
+	// Route restricts the incoming events processed by the Trigger to the
+	// requests for an HTTP path, and optionally methods. A Trigger without a
+	// Route processes the requests for every path.
+	// +optional
+	Route *TriggerRoute `json:"route,omitempty"`
+}
+
+// TriggerRoute matches the requests received by an EventListener on their
+// HTTP path and method.
+type TriggerRoute struct {
+	// Path is the HTTP path of the requests, for example "/github". A trailing
+	// slash is ignored. A segment "{name}" matches any segment of the path,
+	// and a last segment "{name...}" matches the rest of the path; bindings
+	// access their values with $(context.pathParams.name).
+	Path string `json:"path"`
+	// Methods are the HTTP methods of the requests, for example "POST".
+	// Defaults to every method.
+	// +optional
+	// +listType=atomic
+	Methods []string `json:"methods,omitempty"`
+}
+
+// TriggerSchedule defines the events fired on a cron schedule by the
+// EventListener for a Trigger. The Body and the values of the Header can use
+// the $(context.eventID) and $(context.scheduledTime) variables.
+type TriggerSchedule struct {
+	// Cron is the schedule in cron format, for example "0 */2 * * *" or
+	// "@hourly"
+	Cron string `json:"cron"`
+	// TimeZone is the name of the time zone of the schedule, for example
+	// "Europe/Paris". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Body is the body of the scheduled events. Defaults to an empty JSON
+	// object.
+	// +optional
+	Body string `json:"body,omitempty"`
+	// Header holds the headers of the scheduled events
+	// +optional
+	Header map[string]string `json:"header,omitempty"`
+}
+
+// TriggerPoll defines the Git repository polled by the EventListener for a
+// Trigger. Each new or updated ref that matches Refs fires a push event.
+type TriggerPoll struct {
+	// URL is the HTTP(S) URL of the Git repository
+	URL string `json:"url"`
+	// Refs are the patterns of the refs to watch, for example
+	// "refs/heads/main" or "refs/tags/*". Defaults to "refs/heads/*".
+	// +optional
+	// +listType=atomic
+	Refs []string `json:"refs,omitempty"`
+	// Interval is the time between two polls of the repository. Defaults
+	// to one minute.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// SecretName is the name of a Secret in the namespace of the Trigger
+	// whose username and password keys are used to authenticate to the
+	// repository, such as a kubernetes.io/basic-auth Secret. The URL must use
+	// https, and the Secret must be allowed by the pollSecretNames of the
+	// EventListener or by an EventListenerPolicy.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// Parse returns the cron schedule in the time zone of the TriggerSchedule.
+func (s *TriggerSchedule) Parse() (cron.Schedule, error) {
+	spec := s.Cron
+	if s.TimeZone != "" {
+		spec = "CRON_TZ=" + s.TimeZone + " " + spec
+	}
+	return cron.ParseStandard(spec)
+}
+
+// Match returns whether a request with the HTTP method and path matches the
+// route, and the values of the wildcards of its path. A nil route matches
+// every request.
+func (r *TriggerRoute) Match(method, path string) (map[string]string, bool) {
+	if r == nil {
+		return map[string]string{}, true
+	}
+	if len(r.Methods) > 0 {
+		found := false
+		for _, m := range r.Methods {
+			if strings.EqualFold(m, method) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	pattern, segments := routeSegments(r.Path), routeSegments(path)
+	params := map[string]string{}
+	for i, p := range pattern {
+		name, wildcard := strings.CutPrefix(p, "{")
+		name = strings.TrimSuffix(name, "}")
+		if rest, ok := strings.CutSuffix(name, "..."); wildcard && ok {
+			params[rest] = strings.Join(segments[min(i, len(segments)):], "/")
+			return params, true
+		}
+		switch {
+		case i >= len(segments):
+			return nil, false
+		case wildcard && segments[i] != "":
+			params[name] = segments[i]
+		case p != segments[i]:
+			return nil, false
+		}
+	}
+	if len(pattern) != len(segments) {
+		return nil, false
+	}
+	return params, true
+}
+
+// routeSegments returns the segments of an HTTP path, ignoring its trailing
+// slash.
+func routeSegments(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
 }
 
 type TriggerSpecTemplate struct {
@@ -69,6 +207,7 @@ type TriggerSpecBinding struct {
 }
 
 // +genclient
+// +genreconciler:krshapedlogic=false
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Trigger defines a mapping of an input event to parameters. This is used
@@ -82,6 +221,61 @@ type Trigger struct {
 	// Spec holds the desired state of the Trigger
 	// +optional
 	Spec TriggerSpec `json:"spec"`
+	// Status reports whether the objects the Trigger references exist and
+	// are compatible with each other.
+	// +optional
+	Status TriggerStatus `json:"status,omitempty"`
+}
+
+// TriggerStatus defines the observed state of Trigger.
+type TriggerStatus struct {
+	duckv1.Status `json:",inline"`
+}
+
+// The reasons reported on the Ready condition of Triggers, TriggerBindings
+// and TriggerTemplates, in addition to the ones shared with the
+// EventListener's Trigger statuses.
+const (
+	// InvalidSpecReason is reported when an object doesn't pass validation,
+	// e.g. because it was created before a validation rule was added.
+	InvalidSpecReason = "InvalidSpec"
+	// MissingParamsReason is reported when the TriggerTemplate declares
+	// params without a default that none of the bindings provide.
+	MissingParamsReason = "MissingParams"
+	// UndeclaredParamsReason is reported on a ready Trigger when bindings
+	// provide params the TriggerTemplate doesn't declare, which are ignored.
+	UndeclaredParamsReason = "UndeclaredParams"
+	// UndeclaredReferencesReason is reported when the TriggerTemplate's
+	// resource templates reference params it doesn't declare.
+	UndeclaredReferencesReason = "UndeclaredReferences"
+	// DeprecatedReferencesReason is reported on a ready Trigger when the
+	// TriggerTemplate's resource templates reference its params as
+	// $(params.NAME), which isn't substituted anymore.
+	DeprecatedReferencesReason = "DeprecatedReferences"
+)
+
+var triggerCondSet = apis.NewLivingConditionSet()
+
+// GetCondition returns the Condition matching the given type.
+func (ts *TriggerStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return triggerCondSet.Manage(ts).GetCondition(t)
+}
+
+// MarkReady marks the Trigger as ready.
+func (ts *TriggerStatus) MarkReady() {
+	triggerCondSet.Manage(ts).MarkTrue(apis.ConditionReady)
+}
+
+// MarkReadyWithWarning marks the Trigger as ready, with a reason and message
+// describing something that likely isn't what the author intended.
+func (ts *TriggerStatus) MarkReadyWithWarning(reason, messageFormat string, messageA ...interface{}) {
+	triggerCondSet.Manage(ts).MarkTrueWithReason(apis.ConditionReady, reason, messageFormat, messageA...)
+}
+
+// MarkNotReady marks the Trigger as not ready with the given reason and
+// message.
+func (ts *TriggerStatus) MarkNotReady(reason, messageFormat string, messageA ...interface{}) {
+	triggerCondSet.Manage(ts).MarkFalse(apis.ConditionReady, reason, messageFormat, messageA...)
 }
 
 // TriggerInterceptor provides a hook to intercept and pre-process events
@@ -18,6 +18,8 @@ package v1beta1
 
 import (
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestGetName(t *testing.T) {
@@ -45,3 +47,97 @@ func TestGetName(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerRouteMatch(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		route  *TriggerRoute
+		method string
+		path   string
+		want   map[string]string
+		wantOK bool
+	}{{
+		name:   "no route",
+		method: "POST",
+		path:   "/github",
+		want:   map[string]string{},
+		wantOK: true,
+	}, {
+		name:   "path",
+		route:  &TriggerRoute{Path: "/github"},
+		method: "POST",
+		path:   "/github/",
+		want:   map[string]string{},
+		wantOK: true,
+	}, {
+		name:   "other path",
+		route:  &TriggerRoute{Path: "/github"},
+		method: "POST",
+		path:   "/gitlab",
+	}, {
+		name:   "longer path",
+		route:  &TriggerRoute{Path: "/github"},
+		method: "POST",
+		path:   "/github/org",
+	}, {
+		name:   "root",
+		route:  &TriggerRoute{Path: "/"},
+		method: "POST",
+		path:   "/",
+		want:   map[string]string{},
+		wantOK: true,
+	}, {
+		name:   "method",
+		route:  &TriggerRoute{Path: "/github", Methods: []string{"POST"}},
+		method: "post",
+		path:   "/github",
+		want:   map[string]string{},
+		wantOK: true,
+	}, {
+		name:   "other method",
+		route:  &TriggerRoute{Path: "/github", Methods: []string{"POST"}},
+		method: "GET",
+		path:   "/github",
+	}, {
+		name:   "wildcards",
+		route:  &TriggerRoute{Path: "/repos/{org}/{repo}"},
+		method: "POST",
+		path:   "/repos/tektoncd/triggers",
+		want:   map[string]string{"org": "tektoncd", "repo": "triggers"},
+		wantOK: true,
+	}, {
+		name:   "missing wildcard",
+		route:  &TriggerRoute{Path: "/repos/{org}/{repo}"},
+		method: "POST",
+		path:   "/repos/tektoncd",
+	}, {
+		name:   "empty wildcard",
+		route:  &TriggerRoute{Path: "/repos/{org}/{repo}"},
+		method: "POST",
+		path:   "/repos//triggers",
+	}, {
+		name:   "rest",
+		route:  &TriggerRoute{Path: "/hooks/{rest...}"},
+		method: "POST",
+		path:   "/hooks/a/b/c",
+		want:   map[string]string{"rest": "a/b/c"},
+		wantOK: true,
+	}, {
+		name:   "empty rest",
+		route:  &TriggerRoute{Path: "/hooks/{rest...}"},
+		method: "POST",
+		path:   "/hooks",
+		want:   map[string]string{"rest": ""},
+		wantOK: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.route.Match(tc.method, tc.path)
+			if ok != tc.wantOK {
+				t.Fatalf("Match() = %t, want %t", ok, tc.wantOK)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Match() -want +got: %s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1beta1"
 	"github.com/tektoncd/pipeline/pkg/apis/validate"
@@ -39,7 +45,33 @@ func (t *Trigger) SupportedVerbs() []admissionregistrationv1.OperationType {
 // Validate validates a Trigger
 func (t *Trigger) Validate(ctx context.Context) *apis.FieldError {
 	errs := validate.ObjectMetadata(t.GetObjectMeta()).ViaField("metadata")
-	return errs.Also(t.Spec.validate(ctx).ViaField("spec"))
+	errs = errs.Also(t.Spec.validate(ctx).ViaField("spec"))
+	if errs != nil {
+		return errs
+	}
+	if analyze := getTriggerParamsAnalyzer(ctx); analyze != nil {
+		return analyze(ctx, t).At(apis.WarningLevel).ViaField("spec")
+	}
+	return nil
+}
+
+// triggerParamsAnalyzerKey is used as the key for the Trigger params analyzer
+// in a context.Context.
+// +k8s:openapi-gen=false
+type triggerParamsAnalyzerKey struct{}
+
+// WithTriggerParamsAnalyzer sets the analyzer that statically checks the
+// params the bindings of a valid Trigger provide against the params its
+// TriggerTemplate declares and references. The problems it returns are
+// reported as warnings. The analyzer lives outside of this package since it
+// needs to resolve the bindings and template the Trigger references.
+func WithTriggerParamsAnalyzer(ctx context.Context, analyze func(context.Context, *Trigger) *apis.FieldError) context.Context {
+	return context.WithValue(ctx, triggerParamsAnalyzerKey{}, analyze)
+}
+
+func getTriggerParamsAnalyzer(ctx context.Context) func(context.Context, *Trigger) *apis.FieldError {
+	analyze, _ := ctx.Value(triggerParamsAnalyzerKey{}).(func(context.Context, *Trigger) *apis.FieldError)
+	return analyze
 }
 
 func (t *TriggerSpec) validate(ctx context.Context) *apis.FieldError {
@@ -53,6 +85,114 @@ func (t *TriggerSpec) validate(ctx context.Context) *apis.FieldError {
 		errs = errs.Also(interceptor.validate(ctx).ViaField(fmt.Sprintf("interceptors[%d]", i)))
 	}
 
+	// Validate optional Schedule and Poll
+	if t.Schedule != nil {
+		errs = errs.Also(t.Schedule.validate().ViaField("schedule"))
+	}
+	if t.Poll != nil {
+		errs = errs.Also(t.Poll.validate().ViaField("poll"))
+	}
+	if t.Schedule != nil && t.Poll != nil {
+		errs = errs.Also(apis.ErrMultipleOneOf("schedule", "poll"))
+	}
+
+	// Validate optional Route
+	if t.Route != nil {
+		errs = errs.Also(t.Route.validate().ViaField("route"))
+		if t.Schedule != nil || t.Poll != nil {
+			errs = errs.Also(apis.ErrGeneric("a Trigger with a schedule or poll doesn't process incoming events", "route"))
+		}
+	}
+
+	return errs
+}
+
+// reservedRoutePaths are the HTTP paths served by the EventListener itself.
+var reservedRoutePaths = []string{"/live"}
+
+var routeMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+	http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace,
+}
+
+var routeWildcardRegex = regexp.MustCompile(`^\{[A-Za-z_][A-Za-z0-9_]*(\.\.\.)?\}$`)
+
+func (r *TriggerRoute) validate() (errs *apis.FieldError) {
+	switch {
+	case r.Path == "":
+		errs = errs.Also(apis.ErrMissingField("path"))
+	case !strings.HasPrefix(r.Path, "/"):
+		errs = errs.Also(apis.ErrInvalidValue("must start with /", "path"))
+	default:
+		segments := routeSegments(r.Path)
+		names := map[string]bool{}
+		for i, s := range segments {
+			if !strings.ContainsAny(s, "{}") {
+				continue
+			}
+			if !routeWildcardRegex.MatchString(s) {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("invalid wildcard %q, must be {name} or {name...}", s), "path"))
+				continue
+			}
+			name := strings.TrimSuffix(s[1:len(s)-1], "...")
+			if strings.HasSuffix(s, "...}") && i != len(segments)-1 {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("wildcard %q must be the last segment", s), "path"))
+			}
+			if names[name] {
+				errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("duplicate wildcard %q", name), "path"))
+			}
+			names[name] = true
+		}
+		if slices.Contains(reservedRoutePaths, "/"+strings.Join(segments, "/")) {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("%s is served by the EventListener", r.Path), "path"))
+		}
+	}
+	for i, m := range r.Methods {
+		if !slices.Contains(routeMethods, m) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(m, "methods", i))
+		}
+	}
+	return errs
+}
+
+// MinPollInterval is the shortest interval between two polls of a Git
+// repository.
+const MinPollInterval = 10 * time.Second
+
+func (p *TriggerPoll) validate() (errs *apis.FieldError) {
+	if p.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	} else if u, err := url.Parse(p.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue("must be an http or https URL", "url"))
+	} else if p.SecretName != "" && u.Scheme != "https" {
+		// The credentials would otherwise be sent in clear text.
+		errs = errs.Also(apis.ErrInvalidValue("must be an https URL with secretName", "url"))
+	}
+	for i, ref := range p.Refs {
+		if _, err := path.Match(ref, ""); err != nil || !strings.HasPrefix(ref, "refs/") {
+			errs = errs.Also(apis.ErrInvalidArrayValue(ref, "refs", i))
+		}
+	}
+	if p.Interval != nil && p.Interval.Duration < MinPollInterval {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("must be at least %s", MinPollInterval), "interval"))
+	}
+	return errs
+}
+
+func (s *TriggerSchedule) validate() (errs *apis.FieldError) {
+	if s.Cron == "" {
+		return apis.ErrMissingField("cron")
+	}
+	if strings.HasPrefix(s.Cron, "CRON_TZ=") || strings.HasPrefix(s.Cron, "TZ=") {
+		errs = errs.Also(apis.ErrInvalidValue("use timeZone to set the time zone of the schedule", "cron"))
+	} else if _, err := s.Parse(); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(err.Error(), "cron"))
+	}
+	if s.TimeZone != "" {
+		if _, err := time.LoadLocation(s.TimeZone); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), "timeZone"))
+		}
+	}
 	return errs
 }
 
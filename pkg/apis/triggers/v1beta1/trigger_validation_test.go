@@ -19,12 +19,14 @@ package v1beta1_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	"github.com/tektoncd/triggers/pkg/interceptors/cel"
 	"github.com/tektoncd/triggers/test"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/ptr"
 )
 
@@ -206,6 +208,54 @@ func Test_TriggerValidate(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "Valid Trigger with Schedule",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{
+					Cron:     "0 */2 * * *",
+					TimeZone: "Europe/Paris",
+					Body:     `{"scheduledTime": "$(context.scheduledTime)"}`,
+				},
+			},
+		},
+	}, {
+		name: "Valid Trigger with Poll",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Poll: &v1beta1.TriggerPoll{
+					URL:        "https://git.example.com/org/repo.git",
+					Refs:       []string{"refs/heads/main", "refs/tags/v*"},
+					Interval:   &metav1.Duration{Duration: 5 * time.Minute},
+					SecretName: "git-credentials",
+				},
+			},
+		},
+	}, {
+		name: "Valid Trigger with Route",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route: &v1beta1.TriggerRoute{
+					Path:    "/github/{org}/{repo...}",
+					Methods: []string{"POST", "PUT"},
+				},
+			},
+		},
 	}, {
 		name: "Trigger referenced with deprecated name field", // TODO(#FIXME): Remove when Name is removed.
 		tr: &v1beta1.Trigger{
@@ -242,6 +292,224 @@ func TestTriggerValidate_error(t *testing.T) {
 				Namespace: "namespace",
 			},
 		},
+	}, {
+		name: "Schedule missing cron",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{},
+			},
+		},
+	}, {
+		name: "Schedule with invalid cron",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{Cron: "every minute"},
+			},
+		},
+	}, {
+		name: "Schedule with time zone in cron",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{Cron: "CRON_TZ=UTC 0 * * * *"},
+			},
+		},
+	}, {
+		name: "Schedule with invalid time zone",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{Cron: "@hourly", TimeZone: "Mars/Olympus"},
+			},
+		},
+	}, {
+		name: "Poll missing url",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Poll:     &v1beta1.TriggerPoll{},
+			},
+		},
+	}, {
+		name: "Poll with ssh url",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Poll:     &v1beta1.TriggerPoll{URL: "git@github.com:org/repo.git"},
+			},
+		},
+	}, {
+		name: "Poll with credentials over http",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Poll:     &v1beta1.TriggerPoll{URL: "http://git.example.com/repo", SecretName: "git-credentials"},
+			},
+		},
+	}, {
+		name: "Poll with invalid ref",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Poll:     &v1beta1.TriggerPoll{URL: "https://git.example.com/repo", Refs: []string{"main"}},
+			},
+		},
+	}, {
+		name: "Poll with short interval",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Poll:     &v1beta1.TriggerPoll{URL: "https://git.example.com/repo", Interval: &metav1.Duration{Duration: time.Second}},
+			},
+		},
+	}, {
+		name: "Schedule and Poll",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{Cron: "@hourly"},
+				Poll:     &v1beta1.TriggerPoll{URL: "https://git.example.com/repo"},
+			},
+		},
+	}, {
+		name: "Route missing path",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{},
+			},
+		},
+	}, {
+		name: "Route with relative path",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{Path: "github"},
+			},
+		},
+	}, {
+		name: "Route with invalid wildcard",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{Path: "/github/{org"},
+			},
+		},
+	}, {
+		name: "Route with wildcard for the rest not last",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{Path: "/{rest...}/github"},
+			},
+		},
+	}, {
+		name: "Route with duplicate wildcard",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{Path: "/{org}/{org}"},
+			},
+		},
+	}, {
+		name: "Route with reserved path",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{Path: "/live/"},
+			},
+		},
+	}, {
+		name: "Route with invalid method",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Route:    &v1beta1.TriggerRoute{Path: "/github", Methods: []string{"post"}},
+			},
+		},
+	}, {
+		name: "Schedule and Route",
+		tr: &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "namespace",
+			},
+			Spec: v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				Schedule: &v1beta1.TriggerSchedule{Cron: "@hourly"},
+				Route:    &v1beta1.TriggerRoute{Path: "/github"},
+			},
+		},
 	}, {
 		name: "Bindings missing ref",
 		tr: &v1beta1.Trigger{
@@ -410,3 +678,29 @@ func TestTriggerValidate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerValidate_paramsAnalyzer(t *testing.T) {
+	tr := &v1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		Spec: v1beta1.TriggerSpec{
+			Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+		},
+	}
+	ctx := v1beta1.WithTriggerParamsAnalyzer(context.Background(), func(_ context.Context, _ *v1beta1.Trigger) *apis.FieldError {
+		return apis.ErrGeneric("param url is missing", "bindings")
+	})
+
+	err := tr.Validate(ctx)
+	if err.Filter(apis.ErrorLevel) != nil {
+		t.Errorf("expected the findings to be warnings, got error %v", err)
+	}
+	if got, want := err.Filter(apis.WarningLevel).Error(), "param url is missing: spec.bindings"; got != want {
+		t.Errorf("Trigger.Validate() warnings = %q, want %q", got, want)
+	}
+
+	// Invalid Triggers aren't analyzed.
+	tr.Spec.Template.Ref = nil
+	if err := tr.Validate(ctx); err.Filter(apis.WarningLevel) != nil {
+		t.Errorf("expected an invalid Trigger not to be analyzed, got warnings %v", err.Filter(apis.WarningLevel))
+	}
+}
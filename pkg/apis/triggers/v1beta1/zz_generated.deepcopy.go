@@ -29,13 +29,34 @@ import (
 	apis "knative.dev/pkg/apis"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AMQPSource) DeepCopyInto(out *AMQPSource) {
+	*out = *in
+	if in.Prefetch != nil {
+		in, out := &in.Prefetch, &out.Prefetch
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AMQPSource.
+func (in *AMQPSource) DeepCopy() *AMQPSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AMQPSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterTriggerBinding) DeepCopyInto(out *ClusterTriggerBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -135,6 +156,54 @@ func (in *EventListener) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventListenerAutoscaling) DeepCopyInto(out *EventListenerAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(EventListenerAutoscalingMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventListenerAutoscaling.
+func (in *EventListenerAutoscaling) DeepCopy() *EventListenerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(EventListenerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventListenerAutoscalingMetric) DeepCopyInto(out *EventListenerAutoscalingMetric) {
+	*out = *in
+	out.TargetAverageValue = in.TargetAverageValue.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventListenerAutoscalingMetric.
+func (in *EventListenerAutoscalingMetric) DeepCopy() *EventListenerAutoscalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(EventListenerAutoscalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventListenerConfig) DeepCopyInto(out *EventListenerConfig) {
 	*out = *in
@@ -151,6 +220,27 @@ func (in *EventListenerConfig) DeepCopy() *EventListenerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventListenerExposure) DeepCopyInto(out *EventListenerExposure) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(ExposureGateway)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventListenerExposure.
+func (in *EventListenerExposure) DeepCopy() *EventListenerExposure {
+	if in == nil {
+		return nil
+	}
+	out := new(EventListenerExposure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventListenerList) DeepCopyInto(out *EventListenerList) {
 	*out = *in
@@ -208,6 +298,40 @@ func (in *EventListenerSpec) DeepCopyInto(out *EventListenerSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.KubernetesEventSources != nil {
+		in, out := &in.KubernetesEventSources, &out.KubernetesEventSources
+		*out = make([]KubernetesEventSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MessageSources != nil {
+		in, out := &in.MessageSources, &out.MessageSources
+		*out = make([]MessageSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PollSecretNames != nil {
+		in, out := &in.PollSecretNames, &out.PollSecretNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PayloadDecoders != nil {
+		in, out := &in.PayloadDecoders, &out.PayloadDecoders
+		*out = make([]PayloadDecoder, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(EventListenerExposure)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(EventListenerTLS)
+		**out = **in
+	}
 	return
 }
 
@@ -227,6 +351,11 @@ func (in *EventListenerStatus) DeepCopyInto(out *EventListenerStatus) {
 	in.Status.DeepCopyInto(&out.Status)
 	in.AddressStatus.DeepCopyInto(&out.AddressStatus)
 	out.Configuration = in.Configuration
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]EventListenerTriggerStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -240,6 +369,22 @@ func (in *EventListenerStatus) DeepCopy() *EventListenerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventListenerTLS) DeepCopyInto(out *EventListenerTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventListenerTLS.
+func (in *EventListenerTLS) DeepCopy() *EventListenerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(EventListenerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventListenerTrigger) DeepCopyInto(out *EventListenerTrigger) {
 	*out = *in
@@ -270,6 +415,11 @@ func (in *EventListenerTrigger) DeepCopyInto(out *EventListenerTrigger) {
 			}
 		}
 	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(TriggerRoute)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -298,6 +448,11 @@ func (in *EventListenerTriggerGroup) DeepCopyInto(out *EventListenerTriggerGroup
 		}
 	}
 	in.TriggerSelector.DeepCopyInto(&out.TriggerSelector)
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(TriggerRoute)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -333,6 +488,38 @@ func (in *EventListenerTriggerSelector) DeepCopy() *EventListenerTriggerSelector
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventListenerTriggerStatus) DeepCopyInto(out *EventListenerTriggerStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventListenerTriggerStatus.
+func (in *EventListenerTriggerStatus) DeepCopy() *EventListenerTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventListenerTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureGateway) DeepCopyInto(out *ExposureGateway) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureGateway.
+func (in *ExposureGateway) DeepCopy() *ExposureGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterceptorParams) DeepCopyInto(out *InterceptorParams) {
 	*out = *in
@@ -366,6 +553,91 @@ func (in *InterceptorRef) DeepCopy() *InterceptorRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSource.
+func (in *KafkaSource) DeepCopy() *KafkaSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeServiceResource) DeepCopyInto(out *KnativeServiceResource) {
+	*out = *in
+	if in.MinScale != nil {
+		in, out := &in.MinScale, &out.MinScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxScale != nil {
+		in, out := &in.MaxScale, &out.MaxScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContainerConcurrency != nil {
+		in, out := &in.ContainerConcurrency, &out.ContainerConcurrency
+		*out = new(int64)
+		**out = **in
+	}
+	in.WithPodSpec.DeepCopyInto(&out.WithPodSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeServiceResource.
+func (in *KnativeServiceResource) DeepCopy() *KnativeServiceResource {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeServiceResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesEventSource) DeepCopyInto(out *KubernetesEventSource) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]KubernetesEventType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesEventSource.
+func (in *KubernetesEventSource) DeepCopy() *KubernetesEventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesEventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesResource) DeepCopyInto(out *KubernetesResource) {
 	*out = *in
@@ -384,6 +656,11 @@ func (in *KubernetesResource) DeepCopyInto(out *KubernetesResource) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(EventListenerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	in.WithPodSpec.DeepCopyInto(&out.WithPodSpec)
 	return
 }
@@ -398,6 +675,53 @@ func (in *KubernetesResource) DeepCopy() *KubernetesResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageSource) DeepCopyInto(out *MessageSource) {
+	*out = *in
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NATS != nil {
+		in, out := &in.NATS, &out.NATS
+		*out = new(NATSSource)
+		**out = **in
+	}
+	if in.AMQP != nil {
+		in, out := &in.AMQP, &out.AMQP
+		*out = new(AMQPSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageSource.
+func (in *MessageSource) DeepCopy() *MessageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSSource) DeepCopyInto(out *NATSSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSSource.
+func (in *NATSSource) DeepCopy() *NATSSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceSelector) DeepCopyInto(out *NamespaceSelector) {
 	*out = *in
@@ -456,6 +780,22 @@ func (in *ParamSpec) DeepCopy() *ParamSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PayloadDecoder) DeepCopyInto(out *PayloadDecoder) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PayloadDecoder.
+func (in *PayloadDecoder) DeepCopy() *PayloadDecoder {
+	if in == nil {
+		return nil
+	}
+	out := new(PayloadDecoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resources) DeepCopyInto(out *Resources) {
 	*out = *in
@@ -469,6 +809,11 @@ func (in *Resources) DeepCopyInto(out *Resources) {
 		*out = new(CustomResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KnativeService != nil {
+		in, out := &in.KnativeService, &out.KnativeService
+		*out = new(KnativeServiceResource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -537,6 +882,7 @@ func (in *Trigger) DeepCopyInto(out *Trigger) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -564,7 +910,7 @@ func (in *TriggerBinding) DeepCopyInto(out *TriggerBinding) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -643,6 +989,7 @@ func (in *TriggerBindingSpec) DeepCopy() *TriggerBindingSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerBindingStatus) DeepCopyInto(out *TriggerBindingStatus) {
 	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -739,6 +1086,32 @@ func (in *TriggerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerPoll) DeepCopyInto(out *TriggerPoll) {
+	*out = *in
+	if in.Refs != nil {
+		in, out := &in.Refs, &out.Refs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerPoll.
+func (in *TriggerPoll) DeepCopy() *TriggerPoll {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerPoll)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerResourceTemplate) DeepCopyInto(out *TriggerResourceTemplate) {
 	*out = *in
@@ -756,6 +1129,50 @@ func (in *TriggerResourceTemplate) DeepCopy() *TriggerResourceTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerRoute) DeepCopyInto(out *TriggerRoute) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerRoute.
+func (in *TriggerRoute) DeepCopy() *TriggerRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerSchedule) DeepCopyInto(out *TriggerSchedule) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerSchedule.
+func (in *TriggerSchedule) DeepCopy() *TriggerSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerSpec) DeepCopyInto(out *TriggerSpec) {
 	*out = *in
@@ -782,6 +1199,21 @@ func (in *TriggerSpec) DeepCopyInto(out *TriggerSpec) {
 			}
 		}
 	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(TriggerSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Poll != nil {
+		in, out := &in.Poll, &out.Poll
+		*out = new(TriggerPoll)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(TriggerRoute)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -842,13 +1274,30 @@ func (in *TriggerSpecTemplate) DeepCopy() *TriggerSpecTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerStatus) DeepCopyInto(out *TriggerStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerStatus.
+func (in *TriggerStatus) DeepCopy() *TriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerTemplate) DeepCopyInto(out *TriggerTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -936,6 +1385,7 @@ func (in *TriggerTemplateSpec) DeepCopy() *TriggerTemplateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TriggerTemplateStatus) DeepCopyInto(out *TriggerTemplateStatus) {
 	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -17,11 +17,91 @@ limitations under the License.
 package triggers
 
 import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 )
 
 const (
 	PayloadValidationAnnotation = "tekton.dev/payload-validation"
+
+	// TemplateEngineAnnotation selects the engine used to substitute params
+	// into the resource templates of a TriggerTemplate.
+	TemplateEngineAnnotation = "triggers.tekton.dev/template-engine"
+	// LegacyTemplateEngine substitutes params by replacing the raw bytes of
+	// the resource templates. This is the default.
+	LegacyTemplateEngine = "legacy"
+	// StructuredTemplateEngine substitutes params in the string values of the
+	// parsed resource templates.
+	StructuredTemplateEngine = "structured"
+
+	// WhenAnnotation is set on a TriggerTemplate resource template to a CEL
+	// expression over the resolved params that must evaluate to true for the
+	// resource to be created.
+	WhenAnnotation = "triggers.tekton.dev/when"
+	// ForEachAnnotation is set on a TriggerTemplate resource template to the
+	// name of an array param, to create one resource for each item of the
+	// param.
+	ForEachAnnotation = "triggers.tekton.dev/for-each"
+
+	// ActionAnnotation is set on a TriggerTemplate resource template to select
+	// the action performed for the resource.
+	ActionAnnotation = "triggers.tekton.dev/action"
+	// CreateAction creates the resource. This is the default.
+	CreateAction = "create"
+	// ApplyAction applies the resource with server-side apply.
+	ApplyAction = "apply"
+	// PatchAction patches an existing resource.
+	PatchAction = "patch"
+	// DeleteAction deletes an existing resource.
+	DeleteAction = "delete"
+
+	// PatchTypeAnnotation is set on a resource template with the patch action
+	// to select the type of the patch.
+	PatchTypeAnnotation = "triggers.tekton.dev/patch-type"
+	// MergePatchType uses the resource template as a JSON merge patch. This is
+	// the default.
+	MergePatchType = "merge"
+	// JSONPatchType uses the jsonPatch field of the resource template as a
+	// JSON patch.
+	JSONPatchType = "json"
+
+	// ResourceValidationAnnotation is set on an EventListener to validate the
+	// resources created by its Triggers before any of them is created.
+	ResourceValidationAnnotation = "triggers.tekton.dev/resource-validation"
+	// DryRunResourceValidation validates resources with a server-side dry run.
+	DryRunResourceValidation = "dry-run"
+	// NoResourceValidation disables the validation of resources. This is the
+	// default.
+	NoResourceValidation = "none"
+
+	// RecordInvocationsAnnotation is set to "true" on an EventListener to
+	// record each event it processes in a TriggerInvocation.
+	RecordInvocationsAnnotation = "triggers.tekton.dev/record-invocations"
+	// RecordMaxPayloadBytesAnnotation sets the maximum size of the event body
+	// recorded in a TriggerInvocation. Larger bodies are truncated.
+	RecordMaxPayloadBytesAnnotation = "triggers.tekton.dev/record-max-payload-bytes"
+	// RecordRedactHeadersAnnotation is a comma separated list of headers whose
+	// values are redacted in a TriggerInvocation, in addition to the headers
+	// that are always redacted.
+	RecordRedactHeadersAnnotation = "triggers.tekton.dev/record-redact-headers"
+	// RecordRedactBodyFieldsAnnotation is a comma separated list of the JSON
+	// fields of the event body whose values are redacted in a
+	// TriggerInvocation, each as a dot separated path such as "user.password".
+	RecordRedactBodyFieldsAnnotation = "triggers.tekton.dev/record-redact-body-fields"
+	// RecordTTLAnnotation sets how long a TriggerInvocation is kept, as a
+	// duration such as "24h". A TTL of "0s" keeps TriggerInvocations until
+	// they are deleted.
+	RecordTTLAnnotation = "triggers.tekton.dev/record-ttl"
+
+	// ReplayTokenSecretAnnotation is set on an EventListener to the name of a
+	// Secret in its namespace, whose token key authenticates the replayed
+	// events. Replays are rejected unless it is set.
+	ReplayTokenSecretAnnotation = "triggers.tekton.dev/replay-token-secret"
 )
 
 func ValidateAnnotations(annotations map[string]string) *apis.FieldError {
@@ -33,5 +113,55 @@ func ValidateAnnotations(annotations map[string]string) *apis.FieldError {
 		}
 	}
 
+	if value, ok := annotations[RecordInvocationsAnnotation]; ok {
+		if value != "true" && value != "false" {
+			errs = errs.Also(apis.ErrInvalidValue(RecordInvocationsAnnotation+" annotation must have value 'true' or 'false'", "metadata.annotations"))
+		}
+	}
+
+	if value, ok := annotations[RecordMaxPayloadBytesAnnotation]; ok {
+		if n, err := strconv.Atoi(value); err != nil || n < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(RecordMaxPayloadBytesAnnotation+" annotation must be a non-negative integer", "metadata.annotations"))
+		}
+	}
+
+	if value, ok := annotations[RecordRedactBodyFieldsAnnotation]; ok {
+		for _, field := range strings.Split(value, ",") {
+			if slices.Contains(strings.Split(strings.TrimSpace(field), "."), "") {
+				errs = errs.Also(apis.ErrInvalidValue(RecordRedactBodyFieldsAnnotation+" annotation must be a comma separated list of dot separated field paths", "metadata.annotations"))
+				break
+			}
+		}
+	}
+
+	if value, ok := annotations[RecordTTLAnnotation]; ok {
+		if d, err := time.ParseDuration(value); err != nil || d < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(RecordTTLAnnotation+" annotation must be a non-negative duration", "metadata.annotations"))
+		}
+	}
+
+	if value, ok := annotations[ResourceValidationAnnotation]; ok {
+		if value != DryRunResourceValidation && value != NoResourceValidation {
+			errs = errs.Also(apis.ErrInvalidValue(ResourceValidationAnnotation+" annotation must have value '"+DryRunResourceValidation+"' or '"+NoResourceValidation+"'", "metadata.annotations"))
+		}
+	}
+
+	if value, ok := annotations[ReplayTokenSecretAnnotation]; ok {
+		if msgs := validation.IsDNS1123Subdomain(value); len(msgs) > 0 {
+			errs = errs.Also(apis.ErrInvalidValue(ReplayTokenSecretAnnotation+" annotation must be the name of a Secret: "+strings.Join(msgs, ", "), "metadata.annotations"))
+		}
+	}
+
+	return errs
+}
+
+// ValidateTemplateAnnotations validates the annotations of a TriggerTemplate.
+func ValidateTemplateAnnotations(annotations map[string]string) *apis.FieldError {
+	var errs *apis.FieldError
+	if value, ok := annotations[TemplateEngineAnnotation]; ok {
+		if value != LegacyTemplateEngine && value != StructuredTemplateEngine {
+			errs = errs.Also(apis.ErrInvalidValue(TemplateEngineAnnotation+" annotation must have value '"+LegacyTemplateEngine+"' or '"+StructuredTemplateEngine+"'", "metadata.annotations"))
+		}
+	}
 	return errs
 }
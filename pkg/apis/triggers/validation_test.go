@@ -35,3 +35,74 @@ func Test_PayloadValidationAnnotation_InvalidValue(t *testing.T) {
 		t.Errorf("Expected Error but got nil")
 	}
 }
+
+func Test_TemplateEngineAnnotation_Valid(t *testing.T) {
+	for _, v := range []string{LegacyTemplateEngine, StructuredTemplateEngine} {
+		annotations := map[string]string{TemplateEngineAnnotation: v}
+		if err := ValidateTemplateAnnotations(annotations); err != nil {
+			t.Errorf("Unexpected Error for %q: %v", v, err)
+		}
+	}
+}
+
+func Test_TemplateEngineAnnotation_InvalidValue(t *testing.T) {
+	annotations := map[string]string{TemplateEngineAnnotation: "abc"}
+	err := ValidateTemplateAnnotations(annotations)
+	if err == nil {
+		t.Errorf("Expected Error but got nil")
+	}
+}
+
+func Test_ResourceValidationAnnotation_Valid(t *testing.T) {
+	for _, v := range []string{DryRunResourceValidation, NoResourceValidation} {
+		annotations := map[string]string{ResourceValidationAnnotation: v}
+		if err := ValidateAnnotations(annotations); err != nil {
+			t.Errorf("Unexpected Error for %q: %v", v, err)
+		}
+	}
+}
+
+func Test_ResourceValidationAnnotation_InvalidValue(t *testing.T) {
+	annotations := map[string]string{ResourceValidationAnnotation: "abc"}
+	err := ValidateAnnotations(annotations)
+	if err == nil {
+		t.Errorf("Expected Error but got nil")
+	}
+}
+
+func Test_ReplayTokenSecretAnnotation(t *testing.T) {
+	if err := ValidateAnnotations(map[string]string{ReplayTokenSecretAnnotation: "replay-token"}); err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+	if err := ValidateAnnotations(map[string]string{ReplayTokenSecretAnnotation: "Not_A_Secret"}); err == nil {
+		t.Errorf("Expected Error but got nil")
+	}
+}
+
+func Test_RecordInvocationsAnnotations_Valid(t *testing.T) {
+	annotations := map[string]string{
+		RecordInvocationsAnnotation:      "true",
+		RecordMaxPayloadBytesAnnotation:  "0",
+		RecordRedactHeadersAnnotation:    "X-Api-Key",
+		RecordRedactBodyFieldsAnnotation: "user.password, token",
+		RecordTTLAnnotation:              "72h",
+	}
+	if err := ValidateAnnotations(annotations); err != nil {
+		t.Errorf("Unexpected Error: %v", err)
+	}
+}
+
+func Test_RecordInvocationsAnnotations_InvalidValue(t *testing.T) {
+	for _, annotations := range []map[string]string{
+		{RecordInvocationsAnnotation: "yes"},
+		{RecordMaxPayloadBytesAnnotation: "-1"},
+		{RecordMaxPayloadBytesAnnotation: "1KB"},
+		{RecordTTLAnnotation: "1d"},
+		{RecordRedactBodyFieldsAnnotation: "user..password"},
+		{RecordRedactBodyFieldsAnnotation: "token,"},
+	} {
+		if err := ValidateAnnotations(annotations); err == nil {
+			t.Errorf("Expected Error for %v but got nil", annotations)
+		}
+	}
+}
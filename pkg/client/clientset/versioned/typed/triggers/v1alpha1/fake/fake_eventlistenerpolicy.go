@@ -0,0 +1,52 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/client/clientset/versioned/typed/triggers/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeEventListenerPolicies implements EventListenerPolicyInterface
+type fakeEventListenerPolicies struct {
+	*gentype.FakeClientWithList[*v1alpha1.EventListenerPolicy, *v1alpha1.EventListenerPolicyList]
+	Fake *FakeTriggersV1alpha1
+}
+
+func newFakeEventListenerPolicies(fake *FakeTriggersV1alpha1) triggersv1alpha1.EventListenerPolicyInterface {
+	return &fakeEventListenerPolicies{
+		gentype.NewFakeClientWithList[*v1alpha1.EventListenerPolicy, *v1alpha1.EventListenerPolicyList](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("eventlistenerpolicies"),
+			v1alpha1.SchemeGroupVersion.WithKind("EventListenerPolicy"),
+			func() *v1alpha1.EventListenerPolicy { return &v1alpha1.EventListenerPolicy{} },
+			func() *v1alpha1.EventListenerPolicyList { return &v1alpha1.EventListenerPolicyList{} },
+			func(dst, src *v1alpha1.EventListenerPolicyList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.EventListenerPolicyList) []*v1alpha1.EventListenerPolicy {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.EventListenerPolicyList, items []*v1alpha1.EventListenerPolicy) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
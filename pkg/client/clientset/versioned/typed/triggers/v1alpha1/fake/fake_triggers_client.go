@@ -40,6 +40,10 @@ func (c *FakeTriggersV1alpha1) EventListeners(namespace string) v1alpha1.EventLi
 	return newFakeEventListeners(c, namespace)
 }
 
+func (c *FakeTriggersV1alpha1) EventListenerPolicies() v1alpha1.EventListenerPolicyInterface {
+	return newFakeEventListenerPolicies(c)
+}
+
 func (c *FakeTriggersV1alpha1) Interceptors(namespace string) v1alpha1.InterceptorInterface {
 	return newFakeInterceptors(c, namespace)
 }
@@ -52,6 +56,10 @@ func (c *FakeTriggersV1alpha1) TriggerBindings(namespace string) v1alpha1.Trigge
 	return newFakeTriggerBindings(c, namespace)
 }
 
+func (c *FakeTriggersV1alpha1) TriggerInvocations(namespace string) v1alpha1.TriggerInvocationInterface {
+	return newFakeTriggerInvocations(c, namespace)
+}
+
 func (c *FakeTriggersV1alpha1) TriggerTemplates(namespace string) v1alpha1.TriggerTemplateInterface {
 	return newFakeTriggerTemplates(c, namespace)
 }
@@ -24,10 +24,14 @@ type ClusterTriggerBindingExpansion interface{}
 
 type EventListenerExpansion interface{}
 
+type EventListenerPolicyExpansion interface{}
+
 type InterceptorExpansion interface{}
 
 type TriggerExpansion interface{}
 
 type TriggerBindingExpansion interface{}
 
+type TriggerInvocationExpansion interface{}
+
 type TriggerTemplateExpansion interface{}
@@ -31,9 +31,11 @@ type TriggersV1alpha1Interface interface {
 	ClusterInterceptorsGetter
 	ClusterTriggerBindingsGetter
 	EventListenersGetter
+	EventListenerPoliciesGetter
 	InterceptorsGetter
 	TriggersGetter
 	TriggerBindingsGetter
+	TriggerInvocationsGetter
 	TriggerTemplatesGetter
 }
 
@@ -54,6 +56,10 @@ func (c *TriggersV1alpha1Client) EventListeners(namespace string) EventListenerI
 	return newEventListeners(c, namespace)
 }
 
+func (c *TriggersV1alpha1Client) EventListenerPolicies() EventListenerPolicyInterface {
+	return newEventListenerPolicies(c)
+}
+
 func (c *TriggersV1alpha1Client) Interceptors(namespace string) InterceptorInterface {
 	return newInterceptors(c, namespace)
 }
@@ -66,6 +72,10 @@ func (c *TriggersV1alpha1Client) TriggerBindings(namespace string) TriggerBindin
 	return newTriggerBindings(c, namespace)
 }
 
+func (c *TriggersV1alpha1Client) TriggerInvocations(namespace string) TriggerInvocationInterface {
+	return newTriggerInvocations(c, namespace)
+}
+
 func (c *TriggersV1alpha1Client) TriggerTemplates(namespace string) TriggerTemplateInterface {
 	return newTriggerTemplates(c, namespace)
 }
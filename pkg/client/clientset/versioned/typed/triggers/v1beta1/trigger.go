@@ -39,6 +39,8 @@ type TriggersGetter interface {
 type TriggerInterface interface {
 	Create(ctx context.Context, trigger *triggersv1beta1.Trigger, opts v1.CreateOptions) (*triggersv1beta1.Trigger, error)
 	Update(ctx context.Context, trigger *triggersv1beta1.Trigger, opts v1.UpdateOptions) (*triggersv1beta1.Trigger, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, trigger *triggersv1beta1.Trigger, opts v1.UpdateOptions) (*triggersv1beta1.Trigger, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*triggersv1beta1.Trigger, error)
@@ -30,12 +30,16 @@ type Interface interface {
 	ClusterTriggerBindings() ClusterTriggerBindingInformer
 	// EventListeners returns a EventListenerInformer.
 	EventListeners() EventListenerInformer
+	// EventListenerPolicies returns a EventListenerPolicyInformer.
+	EventListenerPolicies() EventListenerPolicyInformer
 	// Interceptors returns a InterceptorInformer.
 	Interceptors() InterceptorInformer
 	// Triggers returns a TriggerInformer.
 	Triggers() TriggerInformer
 	// TriggerBindings returns a TriggerBindingInformer.
 	TriggerBindings() TriggerBindingInformer
+	// TriggerInvocations returns a TriggerInvocationInformer.
+	TriggerInvocations() TriggerInvocationInformer
 	// TriggerTemplates returns a TriggerTemplateInformer.
 	TriggerTemplates() TriggerTemplateInformer
 }
@@ -66,6 +70,11 @@ func (v *version) EventListeners() EventListenerInformer {
 	return &eventListenerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// EventListenerPolicies returns a EventListenerPolicyInformer.
+func (v *version) EventListenerPolicies() EventListenerPolicyInformer {
+	return &eventListenerPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Interceptors returns a InterceptorInformer.
 func (v *version) Interceptors() InterceptorInformer {
 	return &interceptorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -81,6 +90,11 @@ func (v *version) TriggerBindings() TriggerBindingInformer {
 	return &triggerBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TriggerInvocations returns a TriggerInvocationInformer.
+func (v *version) TriggerInvocations() TriggerInvocationInformer {
+	return &triggerInvocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TriggerTemplates returns a TriggerTemplateInformer.
 func (v *version) TriggerTemplates() TriggerTemplateInformer {
 	return &triggerTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,48 @@
+/*
+Copyright 2019 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EventListenerPolicyLister helps list EventListenerPolicies.
+// All objects returned here must be treated as read-only.
+type EventListenerPolicyLister interface {
+	// List lists all EventListenerPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*triggersv1alpha1.EventListenerPolicy, err error)
+	// Get retrieves the EventListenerPolicy from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*triggersv1alpha1.EventListenerPolicy, error)
+	EventListenerPolicyListerExpansion
+}
+
+// eventListenerPolicyLister implements the EventListenerPolicyLister interface.
+type eventListenerPolicyLister struct {
+	listers.ResourceIndexer[*triggersv1alpha1.EventListenerPolicy]
+}
+
+// NewEventListenerPolicyLister returns a new EventListenerPolicyLister.
+func NewEventListenerPolicyLister(indexer cache.Indexer) EventListenerPolicyLister {
+	return &eventListenerPolicyLister{listers.New[*triggersv1alpha1.EventListenerPolicy](indexer, triggersv1alpha1.Resource("eventlistenerpolicy"))}
+}
@@ -34,6 +34,10 @@ type EventListenerListerExpansion interface{}
 // EventListenerNamespaceLister.
 type EventListenerNamespaceListerExpansion interface{}
 
+// EventListenerPolicyListerExpansion allows custom methods to be added to
+// EventListenerPolicyLister.
+type EventListenerPolicyListerExpansion interface{}
+
 // InterceptorListerExpansion allows custom methods to be added to
 // InterceptorLister.
 type InterceptorListerExpansion interface{}
@@ -58,6 +62,14 @@ type TriggerBindingListerExpansion interface{}
 // TriggerBindingNamespaceLister.
 type TriggerBindingNamespaceListerExpansion interface{}
 
+// TriggerInvocationListerExpansion allows custom methods to be added to
+// TriggerInvocationLister.
+type TriggerInvocationListerExpansion interface{}
+
+// TriggerInvocationNamespaceListerExpansion allows custom methods to be added to
+// TriggerInvocationNamespaceLister.
+type TriggerInvocationNamespaceListerExpansion interface{}
+
 // TriggerTemplateListerExpansion allows custom methods to be added to
 // TriggerTemplateLister.
 type TriggerTemplateListerExpansion interface{}
@@ -0,0 +1,235 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package decode decodes the bodies of incoming events into the JSON body
+// passed to interceptors and bindings, based on their content type.
+package decode
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+// formContentType is the content type of HTML forms, which are decoded by
+// default for backwards compatibility.
+const formContentType = "application/x-www-form-urlencoded"
+
+// decoder decodes a body into JSON, using the parameters of its content type.
+type decoder func(body []byte, params map[string]string) ([]byte, error)
+
+var decoders = map[triggersv1.PayloadFormat]decoder{
+	triggersv1.PayloadFormatJSON:      decodeJSON,
+	triggersv1.PayloadFormatForm:      decodeForm,
+	triggersv1.PayloadFormatMultipart: decodeMultipart,
+	triggersv1.PayloadFormatXML:       decodeXML,
+	triggersv1.PayloadFormatYAML:      decodeYAML,
+	triggersv1.PayloadFormatText:      decodeText,
+}
+
+// Format returns the format of the bodies of a content type, which is the
+// format of the first decoder matching it, or JSON if none does.
+func Format(pds []triggersv1.PayloadDecoder, contentType string) triggersv1.PayloadFormat {
+	format, _, _ := match(pds, contentType)
+	return format
+}
+
+// Body decodes a body into JSON, using the decoder matching the Content-Type
+// header. Bodies without a matching decoder are left as is, except for forms
+// with more than one field, which are decoded into their fields.
+func Body(pds []triggersv1.PayloadDecoder, header http.Header, body []byte) ([]byte, error) {
+	format, params, ok := match(pds, header.Get("Content-Type"))
+	if !ok && mediaType(header.Get("Content-Type")) == formContentType {
+		if fields, err := url.ParseQuery(string(body)); err == nil && len(fields) > 1 {
+			format = triggersv1.PayloadFormatForm
+		}
+	}
+	decode, ok := decoders[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown payload format %q", format)
+	}
+	out, err := decode(body, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s body: %w", format, err)
+	}
+	return out, nil
+}
+
+// match returns the format of the first decoder matching a content type and
+// the parameters of the content type, and whether a decoder matched it.
+func match(pds []triggersv1.PayloadDecoder, contentType string) (triggersv1.PayloadFormat, map[string]string, bool) {
+	mt, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return triggersv1.PayloadFormatJSON, nil, false
+	}
+	for _, pd := range pds {
+		if ok, _ := path.Match(strings.ToLower(pd.ContentType), mt); ok {
+			return pd.Format, params, true
+		}
+	}
+	return triggersv1.PayloadFormatJSON, params, false
+}
+
+func mediaType(contentType string) string {
+	mt, _, _ := mime.ParseMediaType(contentType)
+	return mt
+}
+
+// decodeJSON leaves the body as is, so that interceptors validating its
+// signature get the body that was signed.
+func decodeJSON(body []byte, _ map[string]string) ([]byte, error) {
+	return body, nil
+}
+
+// decodeForm decodes the fields of a form into arrays of their values.
+func decodeForm(body []byte, _ map[string]string) ([]byte, error) {
+	fields, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// file is a file uploaded with a multipart form.
+type file struct {
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType,omitempty"`
+	// Content is encoded in base64.
+	Content string `json:"content"`
+}
+
+// decodeMultipart decodes the parts of a multipart form into arrays of the
+// values of its fields and of its files.
+func decodeMultipart(body []byte, params map[string]string) ([]byte, error) {
+	boundary := params["boundary"]
+	if boundary == "" {
+		return nil, errors.New("no boundary in the content type")
+	}
+	fields := map[string][]interface{}{}
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			return nil, err
+		}
+		name := part.FormName()
+		if part.FileName() == "" {
+			fields[name] = append(fields[name], string(content))
+			continue
+		}
+		fields[name] = append(fields[name], file{
+			Filename:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Content:     base64.StdEncoding.EncodeToString(content),
+		})
+	}
+	return json.Marshal(fields)
+}
+
+// decodeXML decodes an XML document into an object with its root element.
+// Elements with neither attributes nor children are decoded into their text,
+// and other elements into objects where attributes are prefixed with -,
+// children are keyed by name, with an array for repeated children, and the
+// text is #text.
+func decodeXML(body []byte, _ map[string]string) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			root, err := decodeElement(d, start)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(map[string]interface{}{start.Name.Local: root})
+		}
+	}
+}
+
+func decodeElement(d *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	obj := map[string]interface{}{}
+	for _, attr := range start.Attr {
+		obj["-"+attr.Name.Local] = attr.Value
+	}
+	var text strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			child, err := decodeElement(d, tok)
+			if err != nil {
+				return nil, err
+			}
+			name := tok.Name.Local
+			switch prev := obj[name].(type) {
+			case nil:
+				obj[name] = child
+			case []interface{}:
+				obj[name] = append(prev, child)
+			default:
+				obj[name] = []interface{}{prev, child}
+			}
+		case xml.CharData:
+			text.Write(tok)
+		case xml.EndElement:
+			t := strings.TrimSpace(text.String())
+			if len(obj) == 0 {
+				return t, nil
+			}
+			if t != "" {
+				obj["#text"] = t
+			}
+			return obj, nil
+		}
+	}
+}
+
+func decodeYAML(body []byte, _ map[string]string) ([]byte, error) {
+	return yaml.YAMLToJSON(body)
+}
+
+// decodeText decodes a body into a string.
+func decodeText(body []byte, _ map[string]string) ([]byte, error) {
+	return json.Marshal(string(body))
+}
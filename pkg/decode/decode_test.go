@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package decode
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+var allDecoders = []triggersv1.PayloadDecoder{{
+	ContentType: "application/*+xml",
+	Format:      triggersv1.PayloadFormatXML,
+}, {
+	ContentType: "application/xml",
+	Format:      triggersv1.PayloadFormatXML,
+}, {
+	ContentType: "application/x-www-form-urlencoded",
+	Format:      triggersv1.PayloadFormatForm,
+}, {
+	ContentType: "multipart/form-data",
+	Format:      triggersv1.PayloadFormatMultipart,
+}, {
+	ContentType: "application/yaml",
+	Format:      triggersv1.PayloadFormatYAML,
+}, {
+	ContentType: "text/*",
+	Format:      triggersv1.PayloadFormatText,
+}}
+
+func TestFormat(t *testing.T) {
+	for _, tc := range []struct {
+		contentType string
+		want        triggersv1.PayloadFormat
+	}{
+		{contentType: "application/atom+xml", want: triggersv1.PayloadFormatXML},
+		{contentType: "Application/XML; charset=utf-8", want: triggersv1.PayloadFormatXML},
+		{contentType: "text/plain", want: triggersv1.PayloadFormatText},
+		{contentType: "application/json", want: triggersv1.PayloadFormatJSON},
+		{contentType: "", want: triggersv1.PayloadFormatJSON},
+	} {
+		t.Run(tc.contentType, func(t *testing.T) {
+			if got := Format(allDecoders, tc.contentType); got != tc.want {
+				t.Errorf("Format() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBody(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		decoders    []triggersv1.PayloadDecoder
+		contentType string
+		body        string
+		want        string
+	}{{
+		name:        "json",
+		decoders:    allDecoders,
+		contentType: "application/json",
+		body:        `{"ref": "main"}`,
+		want:        `{"ref": "main"}`,
+	}, {
+		name:        "form",
+		decoders:    allDecoders,
+		contentType: "application/x-www-form-urlencoded",
+		body:        "ref=main",
+		want:        `{"ref":["main"]}`,
+	}, {
+		name:        "form without decoders",
+		contentType: "application/x-www-form-urlencoded",
+		body:        "ref=main&repo=triggers",
+		want:        `{"ref":["main"],"repo":["triggers"]}`,
+	}, {
+		name:        "form with a single field without decoders",
+		contentType: "application/x-www-form-urlencoded",
+		body:        `payload={"ref":"main"}`,
+		want:        `payload={"ref":"main"}`,
+	}, {
+		name:        "multipart",
+		decoders:    allDecoders,
+		contentType: "multipart/form-data; boundary=xyz",
+		body: strings.Join([]string{
+			"--xyz",
+			`Content-Disposition: form-data; name="ref"`,
+			"",
+			"main",
+			"--xyz",
+			`Content-Disposition: form-data; name="report"; filename="report.txt"`,
+			"Content-Type: text/plain",
+			"",
+			"ok",
+			"--xyz--",
+			"",
+		}, "\r\n"),
+		want: `{"ref":["main"],"report":[{"filename":"report.txt","contentType":"text/plain","content":"b2s="}]}`,
+	}, {
+		name:        "xml",
+		decoders:    allDecoders,
+		contentType: "application/atom+xml",
+		body: `<?xml version="1.0"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>releases</title>
+  <entry id="1"><title>v1</title></entry>
+  <entry id="2"><title>v2</title></entry>
+  <link rel="self">https://example.com</link>
+</feed>`,
+		want: `{"feed":{"-xmlns":"http://www.w3.org/2005/Atom","entry":[{"-id":"1","title":"v1"},{"-id":"2","title":"v2"}],"link":{"#text":"https://example.com","-rel":"self"},"title":"releases"}}`,
+	}, {
+		name:        "yaml",
+		decoders:    allDecoders,
+		contentType: "application/yaml",
+		body:        "ref: main\nfiles:\n- a.go\n",
+		want:        `{"files":["a.go"],"ref":"main"}`,
+	}, {
+		name:        "text",
+		decoders:    allDecoders,
+		contentType: "text/plain; charset=utf-8",
+		body:        "deploy \"prod\"\n",
+		want:        `"deploy \"prod\"\n"`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{"Content-Type": []string{tc.contentType}}
+			got, err := Body(tc.decoders, header, []byte(tc.body))
+			if err != nil {
+				t.Fatalf("Body() returned error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("Body() (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestBody_Error(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{{
+		name:        "invalid xml",
+		contentType: "application/xml",
+		body:        "<feed><title>releases</feed>",
+		want:        "failed to decode xml body: XML syntax error on line 1: element <title> closed by </feed>",
+	}, {
+		name:        "xml without root element",
+		contentType: "application/xml",
+		body:        `<?xml version="1.0"?>`,
+		want:        "failed to decode xml body: no root element",
+	}, {
+		name:        "multipart without boundary",
+		contentType: "multipart/form-data",
+		body:        "--xyz--",
+		want:        "failed to decode multipart body: no boundary in the content type",
+	}, {
+		name:        "invalid yaml",
+		contentType: "application/yaml",
+		body:        "ref: [main",
+		want:        "failed to decode yaml body: yaml: line 1: did not find expected ',' or ']'",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{"Content-Type": []string{tc.contentType}}
+			_, err := Body(allDecoders, header, []byte(tc.body))
+			if err == nil {
+				t.Fatal("Body() did not return an error")
+			}
+			if diff := cmp.Diff(tc.want, err.Error()); diff != "" {
+				t.Errorf("Body() error (-want, +got): %s", diff)
+			}
+		})
+	}
+}
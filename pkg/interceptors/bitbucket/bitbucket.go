@@ -68,8 +68,9 @@ func (w *InterceptorImpl) Process(ctx context.Context, r *triggersv1.Interceptor
 		}
 	}
 
-	// Next validate secrets if set
-	if p.SecretRef != nil {
+	// Next validate secrets if set, unless the event is a replay verified by
+	// the EventListener
+	if p.SecretRef != nil && !interceptors.VerifiedReplay(r) {
 		// Check the secret to see if it is empty
 		if p.SecretRef.SecretKey == "" {
 			return interceptors.Fail(codes.FailedPrecondition, "bitbucket interceptor secretRef.secretKey is empty")
@@ -89,7 +90,7 @@ func (w *InterceptorImpl) Process(ctx context.Context, r *triggersv1.Interceptor
 			return interceptors.Failf(codes.FailedPrecondition, "error getting secret: %v", err)
 		}
 
-		if err := gh.ValidateSignature(header, []byte(r.Body), secretToken); err != nil {
+		if err := gh.ValidateSignature(header, interceptors.SignedBody(r), secretToken); err != nil {
 			return interceptors.Failf(codes.FailedPrecondition, "error validating signature: %s", err.Error())
 		}
 	}
@@ -45,6 +45,7 @@ func TestInterceptor_ExecuteTrigger_Signature(t *testing.T) {
 		secret            *corev1.Secret
 		headers           map[string][]string
 		eventType         string
+		verifiedReplay    bool
 	}{{
 		name:              "no secret",
 		interceptorParams: &InterceptorParams{},
@@ -120,6 +121,16 @@ func TestInterceptor_ExecuteTrigger_Signature(t *testing.T) {
 		interceptorParams: &InterceptorParams{},
 		payload:           nil,
 		headers:           map[string][]string{"X-Hub-Signature-256": {"foo"}},
+	}, {
+		name: "verified replay without signature",
+		interceptorParams: &InterceptorParams{
+			SecretRef: &triggersv1.SecretRef{
+				SecretName: "mysecret",
+				SecretKey:  "token",
+			},
+		},
+		payload:        emptyJSONBody,
+		verifiedReplay: true,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -140,9 +151,10 @@ func TestInterceptor_ExecuteTrigger_Signature(t *testing.T) {
 					"secretRef":  tt.interceptorParams.SecretRef,
 				},
 				Context: &triggersv1.TriggerContext{
-					EventURL:  "https://testing.example.com",
-					EventID:   "abcde",
-					TriggerID: "namespaces/default/triggers/example-trigger",
+					EventURL:       "https://testing.example.com",
+					EventID:        "abcde",
+					TriggerID:      "namespaces/default/triggers/example-trigger",
+					VerifiedReplay: tt.verifiedReplay,
 				},
 			}
 
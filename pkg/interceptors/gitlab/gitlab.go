@@ -67,8 +67,9 @@ func (w *InterceptorImpl) Process(ctx context.Context, r *triggersv1.Interceptor
 		}
 	}
 
-	// Next validate secrets
-	if p.SecretRef != nil {
+	// Next validate secrets, unless the event is a replay verified by the
+	// EventListener
+	if p.SecretRef != nil && !interceptors.VerifiedReplay(r) {
 		// Check the secret to see if it is empty
 		if p.SecretRef.SecretKey == "" {
 			return interceptors.Fail(codes.FailedPrecondition, "gitlab interceptor secretRef.secretKey is empty")
@@ -36,6 +36,7 @@ func TestInterceptor_ExecuteTrigger_ShouldContinue(t *testing.T) {
 		secret            *corev1.Secret
 		token             string
 		eventType         string
+		verifiedReplay    bool
 	}{{
 		name:              "no secret",
 		interceptorParams: &InterceptorParams{},
@@ -89,6 +90,16 @@ func TestInterceptor_ExecuteTrigger_ShouldContinue(t *testing.T) {
 				"token": []byte("secrettoken"),
 			},
 		},
+	}, {
+		name: "verified replay without token",
+		interceptorParams: &InterceptorParams{
+			SecretRef: &triggersv1.SecretRef{
+				SecretName: "mysecret",
+				SecretKey:  "token",
+			},
+		},
+		payload:        []byte("somepayload"),
+		verifiedReplay: true,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -103,9 +114,10 @@ func TestInterceptor_ExecuteTrigger_ShouldContinue(t *testing.T) {
 					"secretRef":  tt.interceptorParams.SecretRef,
 				},
 				Context: &triggersv1.TriggerContext{
-					EventURL:  "https://testing.example.com",
-					EventID:   "abcde",
-					TriggerID: "namespaces/default/triggers/example-trigger",
+					EventURL:       "https://testing.example.com",
+					EventID:        "abcde",
+					TriggerID:      "namespaces/default/triggers/example-trigger",
+					VerifiedReplay: tt.verifiedReplay,
 				},
 			}
 			if tt.token != "" {
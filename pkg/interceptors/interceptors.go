@@ -84,6 +84,12 @@ func Canonical(h map[string][]string) http.Header {
 	return http.Header(c)
 }
 
+// VerifiedReplay returns true if the request is for a replayed event that the
+// EventListener authenticated, whose signature isn't verified again.
+func VerifiedReplay(r *triggersv1beta1.InterceptorRequest) bool {
+	return r.Context != nil && r.Context.VerifiedReplay
+}
+
 // UnmarshalParams unmarshalls the passed in InterceptorParams into the provided param struct
 func UnmarshalParams(ip map[string]interface{}, p interface{}) error {
 	b, err := json.Marshal(ip)
@@ -98,6 +104,19 @@ func UnmarshalParams(ip map[string]interface{}, p interface{}) error {
 	return nil
 }
 
+// RawBodyExtension is the extension holding the body of an event as it was
+// received, when the EventListener decoded it from another format than JSON.
+const RawBodyExtension = "raw_body"
+
+// SignedBody returns the body of the event as it was received, which is the
+// body its signature is computed over.
+func SignedBody(r *triggersv1beta1.InterceptorRequest) []byte {
+	if raw, ok := r.Extensions[RawBodyExtension].(string); ok {
+		return []byte(raw)
+	}
+	return []byte(r.Body)
+}
+
 type InterceptorGetter func(name string) (*triggersv1alpha1.ClusterInterceptor, error)
 
 // ResolveToURL finds an Interceptor's URL.
@@ -235,6 +235,31 @@ func TestCanonical(t *testing.T) {
 	}
 }
 
+func TestSignedBody(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		req  *triggersv1.InterceptorRequest
+		want string
+	}{{
+		name: "body",
+		req:  &triggersv1.InterceptorRequest{Body: `{"ref":"main"}`},
+		want: `{"ref":"main"}`,
+	}, {
+		name: "raw body of a decoded body",
+		req: &triggersv1.InterceptorRequest{
+			Body:       `{"ref":"main"}`,
+			Extensions: map[string]interface{}{interceptors.RawBodyExtension: "ref: main\n"},
+		},
+		want: "ref: main\n",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := string(interceptors.SignedBody(tc.req)); got != tc.want {
+				t.Errorf("SignedBody() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestUnmarshalParam(t *testing.T) {
 	in := map[string]interface{}{
 		"secretKey":  "key",
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+// defaultAMQPPrefetch is the number of unacknowledged messages delivered to a
+// replica, unless set by the source.
+const defaultAMQPPrefetch = 10
+
+// amqpConsumer consumes an AMQP 0-9-1 queue, and acknowledges each processed
+// message. A message that wasn't processed is rejected and requeued, so that
+// it is delivered again.
+type amqpConsumer struct {
+	conn       *amqp.Connection
+	deliveries <-chan amqp.Delivery
+}
+
+func newAMQPConsumer(s *triggersv1.AMQPSource, creds *credentials, tlsConfig *tls.Config) (consumer, error) {
+	config := amqp.Config{TLSClientConfig: tlsConfig}
+	if creds != nil {
+		config.SASL = []amqp.Authentication{&amqp.PlainAuth{Username: creds.username, Password: creds.password}}
+	}
+	conn, err := amqp.DialConfig(s.URL, config)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	prefetch := defaultAMQPPrefetch
+	if s.Prefetch != nil {
+		prefetch = int(*s.Prefetch)
+	}
+	if err := ch.Qos(prefetch, 0, false); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	deliveries, err := ch.Consume(s.Queue, "", false, false, false, false, nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &amqpConsumer{conn: conn, deliveries: deliveries}, nil
+}
+
+func (a *amqpConsumer) consume(ctx context.Context, handle Handler) error {
+	for {
+		var d amqp.Delivery
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case d, ok = <-a.deliveries:
+		}
+		if !ok {
+			return errors.New("the AMQP channel was closed")
+		}
+		if err := handle(ctx, d.Body, amqpHeader(d)); err != nil {
+			if nackErr := d.Nack(false, true); nackErr != nil {
+				return errors.Join(err, nackErr)
+			}
+			return err
+		}
+		if err := d.Ack(false); err != nil {
+			return err
+		}
+	}
+}
+
+func (a *amqpConsumer) close() error {
+	if a.conn.IsClosed() {
+		return nil
+	}
+	return a.conn.Close()
+}
+
+// amqpHeader returns the headers of an AMQP message as HTTP headers.
+func amqpHeader(d amqp.Delivery) http.Header {
+	header := http.Header{}
+	for k, v := range d.Headers {
+		header.Add(k, fmt.Sprint(v))
+	}
+	removeReservedHeaders(header)
+	if d.ContentType != "" {
+		header.Set("Content-Type", d.ContentType)
+	}
+	header.Set(SubjectHeader, d.RoutingKey)
+	if d.MessageId != "" {
+		header.Set(IDHeader, d.MessageId)
+	}
+	return header
+}
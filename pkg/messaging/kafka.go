@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+// kafkaConsumer consumes Kafka topics as a member of a consumer group, and
+// commits the offset of each processed message. Closing the reader before a
+// message is processed makes the group deliver it again from the last
+// committed offset.
+type kafkaConsumer struct {
+	reader *kafka.Reader
+}
+
+func newKafkaConsumer(s *triggersv1.KafkaSource, creds *credentials, tlsConfig *tls.Config) (consumer, error) {
+	dialer := &kafka.Dialer{
+		Timeout:   10 * time.Second,
+		DualStack: true,
+		TLS:       tlsConfig,
+	}
+	if creds != nil {
+		mechanism, err := kafkaSASLMechanism(s.SASLMechanism, creds)
+		if err != nil {
+			return nil, err
+		}
+		dialer.SASLMechanism = mechanism
+	}
+	return &kafkaConsumer{reader: kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     s.Brokers,
+		GroupID:     s.ConsumerGroup,
+		GroupTopics: s.Topics,
+		Dialer:      dialer,
+		StartOffset: kafka.LastOffset,
+	})}, nil
+}
+
+func kafkaSASLMechanism(m triggersv1.KafkaSASLMechanism, creds *credentials) (sasl.Mechanism, error) {
+	switch m {
+	case triggersv1.KafkaSASLScramSHA256:
+		return scram.Mechanism(scram.SHA256, creds.username, creds.password)
+	case triggersv1.KafkaSASLScramSHA512:
+		return scram.Mechanism(scram.SHA512, creds.username, creds.password)
+	default:
+		return plain.Mechanism{Username: creds.username, Password: creds.password}, nil
+	}
+}
+
+func (k *kafkaConsumer) consume(ctx context.Context, handle Handler) error {
+	for {
+		m, err := k.reader.FetchMessage(ctx)
+		if err != nil {
+			return err
+		}
+		if err := handle(ctx, m.Value, kafkaHeader(m)); err != nil {
+			return err
+		}
+		if err := k.reader.CommitMessages(ctx, m); err != nil {
+			return err
+		}
+	}
+}
+
+func (k *kafkaConsumer) close() error {
+	return k.reader.Close()
+}
+
+// kafkaHeader returns the headers of a Kafka message as HTTP headers.
+func kafkaHeader(m kafka.Message) http.Header {
+	header := http.Header{}
+	for _, h := range m.Headers {
+		header.Add(h.Key, string(h.Value))
+	}
+	removeReservedHeaders(header)
+	header.Set(SubjectHeader, m.Topic)
+	header.Set(IDHeader, fmt.Sprintf("%s/%d/%d", m.Topic, m.Partition, m.Offset))
+	return header
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package messaging consumes the events of an EventListener from message
+// brokers.
+package messaging
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// SourceHeader is the header of the consumed messages that holds the
+	// name of their message source.
+	SourceHeader = "Tekton-Message-Source"
+	// SubjectHeader is the header of the consumed messages that holds their
+	// Kafka topic, NATS subject or AMQP routing key.
+	SubjectHeader = "Tekton-Message-Subject"
+	// IDHeader is the header of the consumed messages that holds their ID in
+	// the broker, if any.
+	IDHeader = "Tekton-Message-Id"
+	// reservedHeaderPrefix is the prefix of the headers set by Triggers, such
+	// as the headers of replayed events, which are removed from the headers
+	// of the consumed messages.
+	reservedHeaderPrefix = "Tekton-"
+)
+
+// processedIDs is the number of IDs of processed messages remembered for each
+// source, so that the messages delivered again are not processed again.
+const processedIDs = 1000
+
+var (
+	// retryInterval is the time before a consumer that stopped on an error
+	// starts over, and before a message is processed again.
+	retryInterval = 5 * time.Second
+	// maxAttempts is the number of times a message that failed with a
+	// RetryableError is processed before it is dropped.
+	maxAttempts = 5
+)
+
+// Handler processes a consumed message. A message is acknowledged once it is
+// processed, or once it failed with an error other than a RetryableError, in
+// which case it is dropped.
+type Handler func(ctx context.Context, body []byte, header http.Header) error
+
+// RetryableError is returned by a Handler when a message failed to be
+// processed, but may succeed when processed again, such as after a timeout of
+// the API server.
+type RetryableError struct {
+	Err error
+	// Retry processes the message again. It only repeats the processing that
+	// failed, so that retries are idempotent.
+	Retry Handler
+}
+
+func (e *RetryableError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryableError) Unwrap() error {
+	return e.Err
+}
+
+// consumer consumes the messages of a message source.
+type consumer interface {
+	// consume processes the messages with the handler until the context is
+	// done, an error occurs, or the handler fails, in which case the message
+	// is delivered again.
+	consume(ctx context.Context, handle Handler) error
+	close() error
+}
+
+// Consumers consume the messages of the message sources of an EventListener.
+type Consumers struct {
+	KubeClient kubernetes.Interface
+	// Namespace is the namespace of the EventListener, and of the Secrets of
+	// its message sources.
+	Namespace string
+	Handler   Handler
+	Logger    *zap.SugaredLogger
+
+	sources []triggersv1.MessageSource
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	// newConsumer is overridden in tests.
+	newConsumer func(ctx context.Context, source triggersv1.MessageSource) (consumer, error)
+}
+
+// Sync consumes the messages of the sources, and starts over if they changed
+// since the last call.
+func (c *Consumers) Sync(ctx context.Context, sources []triggersv1.MessageSource) {
+	if c.cancel != nil && equality.Semantic.DeepEqual(c.sources, sources) {
+		return
+	}
+	c.Stop()
+	c.sources = make([]triggersv1.MessageSource, len(sources))
+	for i := range sources {
+		sources[i].DeepCopyInto(&c.sources[i])
+	}
+	ctx, c.cancel = context.WithCancel(ctx)
+	for _, source := range c.sources {
+		c.wg.Add(1)
+		go func(source triggersv1.MessageSource) {
+			defer c.wg.Done()
+			c.run(ctx, source)
+		}(source)
+	}
+}
+
+// Stop stops consuming messages, and waits for the messages being processed.
+func (c *Consumers) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+	c.wg.Wait()
+}
+
+// run consumes the messages of the source until the context is done, and
+// starts over after errors so that unacknowledged messages are delivered
+// again.
+func (c *Consumers) run(ctx context.Context, source triggersv1.MessageSource) {
+	newConsumer := c.newConsumer
+	if newConsumer == nil {
+		newConsumer = c.connect
+	}
+	processed := newIDSet(processedIDs)
+	handle := func(ctx context.Context, body []byte, header http.Header) error {
+		header.Set(SourceHeader, source.Name)
+		if header.Get("Content-Type") == "" {
+			header.Set("Content-Type", "application/json")
+		}
+		id := header.Get(IDHeader)
+		if processed.has(id) {
+			c.Logger.Infof("skipping message %s of message source %s, which was already processed", id, source.Name)
+			return nil
+		}
+		if err := c.process(ctx, source, body, header); err != nil {
+			return err
+		}
+		processed.add(id)
+		return nil
+	}
+	for {
+		cons, err := newConsumer(ctx, source)
+		if err == nil {
+			c.Logger.Infof("consuming the messages of message source %s", source.Name)
+			err = cons.consume(ctx, handle)
+			if cerr := cons.close(); cerr != nil {
+				c.Logger.Warnf("failed to close message source %s: %v", source.Name, cerr)
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		c.Logger.Errorf("message source %s stopped, starting over in %s: %v", source.Name, retryInterval, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// process processes a message with the handler, and processes it again after
+// a RetryableError, up to maxAttempts times. It only returns an error if the
+// context is done before the message is processed or dropped, in which case
+// the message is not acknowledged.
+func (c *Consumers) process(ctx context.Context, source triggersv1.MessageSource, body []byte, header http.Header) error {
+	id := header.Get(IDHeader)
+	handle := c.Handler
+	for attempt := 1; ; attempt++ {
+		err := handle(ctx, body, header)
+		if err == nil {
+			return nil
+		}
+		var retryable *RetryableError
+		if !errors.As(err, &retryable) {
+			c.Logger.Errorf("dropping message %s of message source %s: %v", id, source.Name, err)
+			return nil
+		}
+		if attempt >= maxAttempts {
+			c.Logger.Errorf("dropping message %s of message source %s after %d attempts: %v", id, source.Name, attempt, err)
+			return nil
+		}
+		c.Logger.Warnf("message %s of message source %s failed, retrying in %s: %v", id, source.Name, retryInterval, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryInterval):
+		}
+		handle = retryable.Retry
+	}
+}
+
+// idSet holds the IDs most recently added to it, up to its size.
+type idSet struct {
+	size  int
+	ids   map[string]struct{}
+	order []string
+}
+
+func newIDSet(size int) *idSet {
+	return &idSet{size: size, ids: make(map[string]struct{}, size)}
+}
+
+func (s *idSet) has(id string) bool {
+	_, ok := s.ids[id]
+	return id != "" && ok
+}
+
+func (s *idSet) add(id string) {
+	if id == "" || s.has(id) {
+		return
+	}
+	if len(s.order) == s.size {
+		delete(s.ids, s.order[0])
+		s.order = s.order[1:]
+	}
+	s.ids[id] = struct{}{}
+	s.order = append(s.order, id)
+}
+
+// removeReservedHeaders removes the reserved headers from the headers of a
+// message, since messages can't set them.
+func removeReservedHeaders(header http.Header) {
+	for k := range header {
+		if strings.HasPrefix(k, reservedHeaderPrefix) {
+			delete(header, k)
+		}
+	}
+}
+
+// connect connects to the broker of the source.
+func (c *Consumers) connect(ctx context.Context, source triggersv1.MessageSource) (consumer, error) {
+	creds, err := c.credentials(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := c.tlsConfig(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case source.Kafka != nil:
+		return newKafkaConsumer(source.Kafka, creds, tlsConfig)
+	case source.NATS != nil:
+		return newNATSConsumer(source.NATS, creds, tlsConfig)
+	case source.AMQP != nil:
+		return newAMQPConsumer(source.AMQP, creds, tlsConfig)
+	}
+	return nil, errors.New("no broker is set")
+}
+
+// credentials authenticate to a broker.
+type credentials struct {
+	username string
+	password string
+}
+
+func (c *Consumers) credentials(ctx context.Context, source triggersv1.MessageSource) (*credentials, error) {
+	if source.AuthSecretName == "" {
+		return nil, nil
+	}
+	secret, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Get(ctx, source.AuthSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the credentials of the broker: %w", err)
+	}
+	return &credentials{
+		username: string(secret.Data[corev1.BasicAuthUsernameKey]),
+		password: string(secret.Data[corev1.BasicAuthPasswordKey]),
+	}, nil
+}
+
+func (c *Consumers) tlsConfig(ctx context.Context, source triggersv1.MessageSource) (*tls.Config, error) {
+	if source.TLSSecretName == "" {
+		return nil, nil
+	}
+	secret, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Get(ctx, source.TLSSecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the TLS configuration of the broker: %w", err)
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if ca, ok := secret.Data["ca.crt"]; ok {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("invalid ca.crt in Secret %s", source.TLSSecretName)
+		}
+		config.RootCAs = pool
+	}
+	cert, hasCert := secret.Data[corev1.TLSCertKey]
+	key, hasKey := secret.Data[corev1.TLSPrivateKeyKey]
+	if hasCert || hasKey {
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate in Secret %s: %w", source.TLSSecretName, err)
+		}
+		config.Certificates = []tls.Certificate{pair}
+	}
+	return config, nil
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nats-io/nats.go"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/segmentio/kafka-go"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap/zaptest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekube "k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeMessage is a message of a fakeConsumer.
+type fakeMessage struct {
+	id   string
+	body string
+}
+
+// fakeConsumer delivers the messages of a queue from the last acknowledged
+// one, like a consumer group or a durable consumer.
+type fakeConsumer struct {
+	mu    sync.Mutex
+	queue []fakeMessage
+	acked int
+}
+
+func (f *fakeConsumer) consume(ctx context.Context, handle Handler) error {
+	for {
+		f.mu.Lock()
+		if f.acked == len(f.queue) {
+			f.mu.Unlock()
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		m := f.queue[f.acked]
+		f.mu.Unlock()
+		if err := handle(ctx, []byte(m.body), http.Header{IDHeader: {m.id}}); err != nil {
+			return err
+		}
+		f.mu.Lock()
+		f.acked++
+		f.mu.Unlock()
+	}
+}
+
+func (f *fakeConsumer) close() error { return nil }
+
+func TestConsumers(t *testing.T) {
+	defer func(d time.Duration, n int) { retryInterval, maxAttempts = d, n }(retryInterval, maxAttempts)
+	retryInterval = time.Millisecond
+	maxAttempts = 3
+
+	fake := &fakeConsumer{queue: []fakeMessage{
+		{id: "1", body: "first"},
+		{id: "2", body: "transient"},
+		{id: "3", body: "invalid"},
+		{id: "4", body: "unavailable"},
+		// Delivered again, after it was processed
+		{id: "1", body: "first"},
+		{id: "5", body: "last"},
+	}}
+	var mu sync.Mutex
+	var handled []string
+	done := make(chan struct{})
+	var handler Handler
+	handler = func(_ context.Context, body []byte, header http.Header) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if got := header.Get(SourceHeader); got != "deployments" {
+			t.Errorf("%s header = %q, want deployments", SourceHeader, got)
+		}
+		if got := header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Content-Type header = %q, want application/json", got)
+		}
+		handled = append(handled, string(body))
+		switch string(body) {
+		case "transient":
+			// Fails once, and only the failed processing is retried
+			return &RetryableError{
+				Err: errors.New("the API server timed out"),
+				Retry: func(_ context.Context, body []byte, _ http.Header) error {
+					mu.Lock()
+					defer mu.Unlock()
+					handled = append(handled, "retried "+string(body))
+					return nil
+				},
+			}
+		case "invalid":
+			return errors.New("a Trigger failed")
+		case "unavailable":
+			return &RetryableError{Err: errors.New("the API server is unavailable"), Retry: handler}
+		case "last":
+			close(done)
+		}
+		return nil
+	}
+	c := &Consumers{
+		Logger:  zaptest.NewLogger(t).Sugar(),
+		Handler: handler,
+		newConsumer: func(context.Context, triggersv1.MessageSource) (consumer, error) {
+			return fake, nil
+		},
+	}
+	sources := []triggersv1.MessageSource{{Name: "deployments", Kafka: &triggersv1.KafkaSource{}}}
+	c.Sync(context.Background(), sources)
+	// Unchanged sources don't start over
+	c.Sync(context.Background(), sources)
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the messages to be processed")
+	}
+	c.Stop()
+
+	want := []string{"first", "transient", "retried transient", "invalid", "unavailable", "unavailable", "unavailable", "last"}
+	if diff := cmp.Diff(want, handled); diff != "" {
+		t.Errorf("handled messages -want +got: %s", diff)
+	}
+	if fake.acked != len(fake.queue) {
+		t.Errorf("acknowledged %d messages, want %d", fake.acked, len(fake.queue))
+	}
+}
+
+func TestIDSet(t *testing.T) {
+	s := newIDSet(2)
+	s.add("a")
+	s.add("b")
+	s.add("a")
+	if !s.has("a") || !s.has("b") {
+		t.Errorf("idSet doesn't hold the added IDs")
+	}
+	s.add("c")
+	if s.has("a") || !s.has("b") || !s.has("c") {
+		t.Errorf("idSet didn't drop the oldest ID")
+	}
+	s.add("")
+	if s.has("") {
+		t.Errorf("idSet holds an empty ID")
+	}
+}
+
+func testCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "broker"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestSecrets(t *testing.T) {
+	cert, key := testCertificate(t)
+	c := &Consumers{
+		Namespace: "foo",
+		KubeClient: fakekube.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "auth", Namespace: "foo"},
+			Data:       map[string][]byte{"username": []byte("user"), "password": []byte("pass")},
+		}, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "foo"},
+			Data:       map[string][]byte{"ca.crt": cert, "tls.crt": cert, "tls.key": key},
+		}, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "bad-tls", Namespace: "foo"},
+			Data:       map[string][]byte{"ca.crt": []byte("not a certificate")},
+		}),
+	}
+	ctx := context.Background()
+
+	creds, err := c.credentials(ctx, triggersv1.MessageSource{AuthSecretName: "auth"})
+	if err != nil {
+		t.Fatalf("credentials() returned error: %v", err)
+	}
+	if diff := cmp.Diff(&credentials{username: "user", password: "pass"}, creds, cmp.AllowUnexported(credentials{})); diff != "" {
+		t.Errorf("credentials() -want +got: %s", diff)
+	}
+	if creds, err := c.credentials(ctx, triggersv1.MessageSource{}); creds != nil || err != nil {
+		t.Errorf("credentials() without a Secret = %v, %v, want nil", creds, err)
+	}
+	if _, err := c.credentials(ctx, triggersv1.MessageSource{AuthSecretName: "missing"}); err == nil {
+		t.Error("credentials() with a missing Secret didn't return an error")
+	}
+
+	config, err := c.tlsConfig(ctx, triggersv1.MessageSource{TLSSecretName: "tls"})
+	if err != nil {
+		t.Fatalf("tlsConfig() returned error: %v", err)
+	}
+	if config.RootCAs == nil || len(config.Certificates) != 1 {
+		t.Errorf("tlsConfig() = %v, want the certificate authorities and a client certificate", config)
+	}
+	if _, err := c.tlsConfig(ctx, triggersv1.MessageSource{TLSSecretName: "bad-tls"}); err == nil || !strings.Contains(err.Error(), "invalid ca.crt") {
+		t.Errorf("tlsConfig() with an invalid ca.crt = %v, want an error", err)
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header http.Header
+		want   http.Header
+	}{{
+		name: "kafka",
+		header: kafkaHeader(kafka.Message{
+			Topic:   "deployments",
+			Headers: []kafka.Header{{Key: "ce-type", Value: []byte("deployed")}, {Key: "tekton-replay-of", Value: []byte("1")}},
+		}),
+		want: http.Header{"Ce-Type": {"deployed"}, SubjectHeader: {"deployments"}, IDHeader: {"deployments/0/0"}},
+	}, {
+		name: "nats",
+		header: natsHeader(&nats.Msg{
+			Subject: "releases.app",
+			Header:  nats.Header{"Ce-Type": {"released"}, "Tekton-Replay-Dry-Run": {"true"}},
+		}),
+		want: http.Header{"Ce-Type": {"released"}, SubjectHeader: {"releases.app"}},
+	}, {
+		name: "amqp",
+		header: amqpHeader(amqp.Delivery{
+			RoutingKey:  "builds",
+			MessageId:   "build-1",
+			ContentType: "application/cloudevents+json",
+			Headers:     amqp.Table{"attempt": int32(2), "Tekton-Message-Source": "other"},
+		}),
+		want: http.Header{"Attempt": {"2"}, "Content-Type": {"application/cloudevents+json"}, SubjectHeader: {"builds"}, IDHeader: {"build-1"}},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.header); diff != "" {
+				t.Errorf("header -want +got: %s", diff)
+			}
+		})
+	}
+}
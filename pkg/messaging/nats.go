@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+// natsFetchWait is how long a fetch waits for messages.
+const natsFetchWait = 5 * time.Second
+
+// natsConsumer consumes a NATS JetStream subject with a durable pull
+// consumer, and acknowledges each processed message. A message that wasn't
+// processed is negatively acknowledged, so that it is delivered again.
+type natsConsumer struct {
+	conn *nats.Conn
+	sub  *nats.Subscription
+}
+
+func newNATSConsumer(s *triggersv1.NATSSource, creds *credentials, tlsConfig *tls.Config) (consumer, error) {
+	opts := []nats.Option{nats.Name("tekton-triggers")}
+	if creds != nil {
+		opts = append(opts, nats.UserInfo(creds.username, creds.password))
+	}
+	if tlsConfig != nil {
+		opts = append(opts, nats.Secure(tlsConfig))
+	}
+	conn, err := nats.Connect(s.URL, opts...)
+	if err != nil {
+		return nil, err
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	var subOpts []nats.SubOpt
+	if s.Stream != "" {
+		subOpts = append(subOpts, nats.BindStream(s.Stream))
+	}
+	sub, err := js.PullSubscribe(s.Subject, s.Durable, subOpts...)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &natsConsumer{conn: conn, sub: sub}, nil
+}
+
+func (n *natsConsumer) consume(ctx context.Context, handle Handler) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msgs, err := n.sub.Fetch(1, nats.MaxWait(natsFetchWait))
+		if errors.Is(err, nats.ErrTimeout) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if err := handle(ctx, m.Data, natsHeader(m)); err != nil {
+				if nakErr := m.Nak(); nakErr != nil {
+					return errors.Join(err, nakErr)
+				}
+				return err
+			}
+			if err := m.Ack(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (n *natsConsumer) close() error {
+	n.conn.Close()
+	return nil
+}
+
+// natsHeader returns the headers of a NATS message as HTTP headers.
+func natsHeader(m *nats.Msg) http.Header {
+	header := http.Header{}
+	for k, values := range m.Header {
+		for _, v := range values {
+			header.Add(k, v)
+		}
+	}
+	removeReservedHeaders(header)
+	header.Set(SubjectHeader, m.Subject)
+	if meta, err := m.Metadata(); err == nil {
+		header.Set(IDHeader, fmt.Sprintf("%s/%d", meta.Stream, meta.Sequence.Stream))
+	}
+	return header
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package messaging
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+// startNATSServer starts an embedded NATS server with JetStream, which
+// requires the username "user" and the password "pass".
+func startNATSServer(t *testing.T) *server.Server {
+	t.Helper()
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		Username:  "user",
+		Password:  "pass",
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create the NATS server: %v", err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("the NATS server isn't ready for connections")
+	}
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func TestNATSConsumer(t *testing.T) {
+	s := startNATSServer(t)
+
+	conn, err := nats.Connect(s.ClientURL(), nats.UserInfo("user", "pass"))
+	if err != nil {
+		t.Fatalf("failed to connect to the NATS server: %v", err)
+	}
+	defer conn.Close()
+	js, err := conn.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := js.AddStream(&nats.StreamConfig{Name: "RELEASES", Subjects: []string{"releases.>"}}); err != nil {
+		t.Fatalf("failed to create the stream: %v", err)
+	}
+	for _, body := range []string{"first", "failing", "last"} {
+		if _, err := js.PublishMsg(&nats.Msg{
+			Subject: "releases.app",
+			Data:    []byte(body),
+			Header:  nats.Header{"Ce-Type": {"released"}, "Tekton-Replay-Of": {"1"}},
+		}); err != nil {
+			t.Fatalf("failed to publish %s: %v", body, err)
+		}
+	}
+
+	source := &triggersv1.NATSSource{URL: s.ClientURL(), Subject: "releases.>", Stream: "RELEASES", Durable: "triggers"}
+	creds := &credentials{username: "user", password: "pass"}
+	errFailing := errors.New("a Trigger failed")
+	var handled []string
+	var headers []http.Header
+	failed := false
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	handle := func(_ context.Context, body []byte, header http.Header) error {
+		handled = append(handled, string(body))
+		headers = append(headers, header)
+		switch string(body) {
+		case "failing":
+			if !failed {
+				failed = true
+				return errFailing
+			}
+		case "last":
+			cancel()
+		}
+		return nil
+	}
+
+	// The failed message is negatively acknowledged, and delivered again to
+	// the durable consumer once it starts over
+	c, err := newNATSConsumer(source, creds, nil)
+	if err != nil {
+		t.Fatalf("newNATSConsumer() returned error: %v", err)
+	}
+	if err := c.consume(ctx, handle); !errors.Is(err, errFailing) {
+		t.Errorf("consume() = %v, want %v", err, errFailing)
+	}
+	if err := c.close(); err != nil {
+		t.Errorf("close() returned error: %v", err)
+	}
+	c, err = newNATSConsumer(source, creds, nil)
+	if err != nil {
+		t.Fatalf("newNATSConsumer() returned error: %v", err)
+	}
+	if err := c.consume(ctx, handle); !errors.Is(err, context.Canceled) {
+		t.Errorf("consume() = %v, want %v", err, context.Canceled)
+	}
+	if err := c.close(); err != nil {
+		t.Errorf("close() returned error: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"first", "failing", "failing", "last"}, handled); diff != "" {
+		t.Fatalf("handled messages -want +got: %s", diff)
+	}
+	want := http.Header{"Ce-Type": {"released"}, SubjectHeader: {"releases.app"}, IDHeader: {"RELEASES/1"}}
+	if diff := cmp.Diff(want, headers[0]); diff != "" {
+		t.Errorf("header -want +got: %s", diff)
+	}
+
+	// Every message was acknowledged, which the server records
+	// asynchronously
+	var info *nats.ConsumerInfo
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if info, err = js.ConsumerInfo("RELEASES", "triggers"); err != nil {
+			t.Fatal(err)
+		}
+		if info.NumPending == 0 && info.NumAckPending == 0 {
+			break
+		}
+	}
+	if info.NumPending != 0 || info.NumAckPending != 0 {
+		t.Errorf("consumer has %d pending and %d unacknowledged messages, want none", info.NumPending, info.NumAckPending)
+	}
+
+	if _, err := newNATSConsumer(source, &credentials{username: "user", password: "wrong"}, nil); err == nil {
+		t.Error("newNATSConsumer() with wrong credentials didn't return an error")
+	}
+}
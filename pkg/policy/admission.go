@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Admission enforces the EventListenerPolicies when Triggers and
+// EventListeners are created or updated. Both the v1alpha1 and v1beta1
+// versions of the resources are read as v1beta1, since the fields checked by
+// the policies are the same.
+type Admission struct {
+	PolicyLister          listersv1alpha1.EventListenerPolicyLister
+	EventListenerLister   listers.EventListenerLister
+	TriggerTemplateLister listers.TriggerTemplateLister
+}
+
+// ValidateTrigger rejects a Trigger that one of the EventListeners
+// processing it isn't allowed to process, or whose repository it isn't
+// allowed to poll with the Secret of the Trigger.
+// revive:disable:unused-parameter
+func (a *Admission) ValidateTrigger(ctx context.Context, u *unstructured.Unstructured) error {
+	var t triggersv1.Trigger
+	if err := fromUnstructured(u, &t); err != nil {
+		return err
+	}
+	els, err := a.EventListenerLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, el := range els {
+		if !Selects(el, &t) {
+			continue
+		}
+		policies, err := For(a.PolicyLister, el.Namespace, el.Name)
+		if err != nil {
+			return err
+		}
+		if err := policies.CheckTrigger(t.Namespace, t.Spec.ServiceAccountName); err != nil {
+			return fmt.Errorf("EventListener %s/%s can't process Trigger: %w", el.Namespace, el.Name, err)
+		}
+		if err := policies.CheckResources(t.Namespace, a.templateResources(t.Namespace, t.Spec.Template)); err != nil {
+			return fmt.Errorf("EventListener %s/%s can't process Trigger: %w", el.Namespace, el.Name, err)
+		}
+		if t.Spec.Poll != nil {
+			if err := policies.CheckPollSecret(el, t.Spec.Poll.SecretName); err != nil {
+				return fmt.Errorf("EventListener %s/%s can't poll the repository of Trigger: %w", el.Namespace, el.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateEventListener rejects an EventListener that selects Triggers in
+// namespaces it isn't allowed to process, whose inline Triggers it isn't
+// allowed to process, or whose event sources it isn't allowed to run.
+// Selecting all namespaces is allowed, since the Triggers it selects are
+// checked on their own.
+// revive:disable:unused-parameter
+func (a *Admission) ValidateEventListener(ctx context.Context, u *unstructured.Unstructured) error {
+	var el triggersv1.EventListener
+	if err := fromUnstructured(u, &el); err != nil {
+		return err
+	}
+	policies, err := For(a.PolicyLister, el.Namespace, el.Name)
+	if err != nil || policies.Empty() {
+		return err
+	}
+	selectors := []triggersv1.NamespaceSelector{el.Spec.NamespaceSelector}
+	for _, g := range el.Spec.TriggerGroups {
+		selectors = append(selectors, g.TriggerSelector.NamespaceSelector)
+	}
+	for _, selector := range selectors {
+		for _, ns := range selector.MatchNames {
+			if ns == "*" {
+				continue
+			}
+			if err := policies.CheckNamespace(ns); err != nil {
+				return err
+			}
+		}
+	}
+	for _, source := range el.Spec.KubernetesEventSources {
+		if err := policies.CheckKubernetesEventSource(source); err != nil {
+			return err
+		}
+	}
+	for _, source := range el.Spec.MessageSources {
+		if err := policies.CheckMessageSource(source); err != nil {
+			return err
+		}
+	}
+	for _, t := range el.Spec.Triggers {
+		if t.Template == nil {
+			continue
+		}
+		if err := policies.CheckTrigger(el.Namespace, t.ServiceAccountName); err != nil {
+			return fmt.Errorf("can't process Trigger %s: %w", t.Name, err)
+		}
+		if err := policies.CheckResources(el.Namespace, a.templateResources(el.Namespace, *t.Template)); err != nil {
+			return fmt.Errorf("can't process Trigger %s: %w", t.Name, err)
+		}
+	}
+	return nil
+}
+
+// templateResources returns the resource templates of a Trigger, or none if
+// its TriggerTemplate doesn't exist yet, in which case they are only checked
+// by the EventListener.
+func (a *Admission) templateResources(namespace string, tmpl triggersv1.TriggerSpecTemplate) []json.RawMessage {
+	spec := tmpl.Spec
+	if tmpl.Ref != nil {
+		tt, err := a.TriggerTemplateLister.TriggerTemplates(namespace).Get(*tmpl.Ref)
+		if err != nil {
+			return nil
+		}
+		spec = &tt.Spec
+	}
+	if spec == nil {
+		return nil
+	}
+	var out []json.RawMessage
+	for _, rt := range spec.ResourceTemplates {
+		out = append(out, rt.Raw)
+	}
+	return out
+}
+
+func fromUnstructured(u *unstructured.Unstructured, obj interface{}) error {
+	b, err := u.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, obj)
+}
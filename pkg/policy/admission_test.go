@@ -0,0 +1,373 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	eventlistenerpolicyinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/eventlistenerpolicy"
+	eventlistenerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/eventlistener"
+	triggertemplateinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/ptr"
+)
+
+var (
+	tenantPolicy = &triggersv1alpha1.EventListenerPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+		Spec: triggersv1alpha1.EventListenerPolicySpec{
+			EventListeners:      []triggersv1alpha1.EventListenerReference{{Namespace: "triggers", Name: "shared"}},
+			Namespaces:          []string{"team-a"},
+			ServiceAccountNames: []string{"triggers"},
+			Resources:           []triggersv1alpha1.PolicyResource{{Group: "tekton.dev", Kind: "PipelineRun"}},
+			Actions:             []string{"create", "apply"},
+			SecretNames:         []string{"git-credentials"},
+			KubernetesEventSources: &triggersv1alpha1.PolicyKubernetesEventSources{
+				Resources:  []triggersv1alpha1.PolicyResource{{Group: "tekton.dev", Kind: "*"}},
+				Namespaces: []string{"team-a"},
+			},
+		},
+	}
+	sharedEL = &triggersv1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+		Spec: triggersv1.EventListenerSpec{
+			NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"*"}},
+			LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"shared": "true"}},
+		},
+	}
+	// teamEL isn't constrained by a policy.
+	teamEL = &triggersv1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team-c"},
+		Spec: triggersv1.EventListenerSpec{
+			LabelSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}},
+			PollSecretNames: []string{"team-credentials"},
+		},
+	}
+)
+
+func resourceTemplate(t *testing.T, apiVersion, kind string) triggersv1.TriggerResourceTemplate {
+	t.Helper()
+	return triggersv1.TriggerResourceTemplate{RawExtension: test.RawExtension(t, map[string]string{"apiVersion": apiVersion, "kind": kind})}
+}
+
+func getAdmission(t *testing.T) *Admission {
+	t.Helper()
+	ctx, _ := test.SetupFakeContext(t)
+	test.SeedResources(t, ctx, test.Resources{
+		EventListenerPolicies: []*triggersv1alpha1.EventListenerPolicy{tenantPolicy},
+		EventListeners:        []*triggersv1.EventListener{sharedEL, teamEL},
+		TriggerTemplates: []*triggersv1.TriggerTemplate{{
+			ObjectMeta: metav1.ObjectMeta{Name: "taskrun", Namespace: "team-a"},
+			Spec: triggersv1.TriggerTemplateSpec{
+				ResourceTemplates: []triggersv1.TriggerResourceTemplate{resourceTemplate(t, "tekton.dev/v1", "TaskRun")},
+			},
+		}},
+	})
+	return &Admission{
+		PolicyLister:          eventlistenerpolicyinformer.Get(ctx).Lister(),
+		EventListenerLister:   eventlistenerinformer.Get(ctx).Lister(),
+		TriggerTemplateLister: triggertemplateinformer.Get(ctx).Lister(),
+	}
+}
+
+func toUnstructured(t *testing.T, obj interface{}) *unstructured.Unstructured {
+	t.Helper()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &unstructured.Unstructured{Object: u}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func TestValidateTrigger(t *testing.T) {
+	shared := map[string]string{"shared": "true"}
+	pipelineRun := &triggersv1.TriggerTemplateSpec{
+		ResourceTemplates: []triggersv1.TriggerResourceTemplate{resourceTemplate(t, "tekton.dev/v1", "PipelineRun")},
+	}
+	for _, tc := range []struct {
+		name    string
+		trigger *triggersv1.Trigger
+		want    string
+	}{{
+		name: "allowed",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Template:           triggersv1.TriggerSpecTemplate{Spec: pipelineRun},
+			},
+		},
+	}, {
+		name: "not selected",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-b"},
+		},
+	}, {
+		name: "other namespace",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-b", Labels: shared},
+			Spec:       triggersv1.TriggerSpec{ServiceAccountName: "triggers"},
+		},
+		want: "EventListener triggers/shared can't process Trigger: EventListenerPolicy tenants doesn't allow Triggers in namespace team-b",
+	}, {
+		name: "other service account",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec:       triggersv1.TriggerSpec{ServiceAccountName: "admin"},
+		},
+		want: "EventListener triggers/shared can't process Trigger: EventListenerPolicy tenants doesn't allow ServiceAccount admin",
+	}, {
+		name: "referenced template",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Template:           triggersv1.TriggerSpecTemplate{Ref: ptr.String("taskrun")},
+			},
+		},
+		want: "EventListener triggers/shared can't process Trigger: EventListenerPolicy tenants doesn't allow creating TaskRun.tekton.dev",
+	}, {
+		name: "other action",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Template: triggersv1.TriggerSpecTemplate{Spec: &triggersv1.TriggerTemplateSpec{
+					ResourceTemplates: []triggersv1.TriggerResourceTemplate{{RawExtension: test.RawExtension(t, map[string]interface{}{
+						"apiVersion": "tekton.dev/v1",
+						"kind":       "PipelineRun",
+						"metadata": map[string]interface{}{
+							"name":        "run",
+							"annotations": map[string]string{"triggers.tekton.dev/action": "delete"},
+						},
+					})}},
+				}},
+			},
+		},
+		want: "EventListener triggers/shared can't process Trigger: EventListenerPolicy tenants doesn't allow the delete action on PipelineRun.tekton.dev",
+	}, {
+		name: "other target namespace",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Template: triggersv1.TriggerSpecTemplate{Spec: &triggersv1.TriggerTemplateSpec{
+					ResourceTemplates: []triggersv1.TriggerResourceTemplate{{RawExtension: test.RawExtension(t, map[string]interface{}{
+						"apiVersion": "tekton.dev/v1",
+						"kind":       "PipelineRun",
+						"metadata":   map[string]interface{}{"name": "run", "namespace": "triggers"},
+					})}},
+				}},
+			},
+		},
+		want: "EventListener triggers/shared can't process Trigger: EventListenerPolicy tenants doesn't allow Triggers in namespace team-a to create PipelineRun.tekton.dev in namespace triggers",
+	}, {
+		name: "target namespace from a param",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Template: triggersv1.TriggerSpecTemplate{Spec: &triggersv1.TriggerTemplateSpec{
+					ResourceTemplates: []triggersv1.TriggerResourceTemplate{{RawExtension: test.RawExtension(t, map[string]interface{}{
+						"apiVersion": "tekton.dev/v1",
+						"kind":       "PipelineRun",
+						"metadata":   map[string]interface{}{"name": "run", "namespace": "$(tt.params.namespace)"},
+					})}},
+				}},
+			},
+		},
+	}, {
+		name: "allowed poll secret",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Poll:               &triggersv1.TriggerPoll{URL: "https://example.com/repo.git", SecretName: "git-credentials"},
+			},
+		},
+	}, {
+		name: "other poll secret",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: shared},
+			Spec: triggersv1.TriggerSpec{
+				ServiceAccountName: "triggers",
+				Poll:               &triggersv1.TriggerPoll{URL: "https://example.com/repo.git", SecretName: "admin-token"},
+			},
+		},
+		want: "EventListener triggers/shared can't poll the repository of Trigger: EventListenerPolicy tenants doesn't allow Secret admin-token",
+	}, {
+		name: "poll secret allowed by the EventListener",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-c", Labels: map[string]string{"team": "true"}},
+			Spec: triggersv1.TriggerSpec{
+				Poll: &triggersv1.TriggerPoll{URL: "https://example.com/repo.git", SecretName: "team-credentials"},
+			},
+		},
+	}, {
+		name: "poll secret not allowed without a policy",
+		trigger: &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "tr", Namespace: "team-c", Labels: map[string]string{"team": "true"}},
+			Spec: triggersv1.TriggerSpec{
+				Poll: &triggersv1.TriggerPoll{URL: "https://example.com/repo.git", SecretName: "admin-token"},
+			},
+		},
+		want: "EventListener team-c/team can't poll the repository of Trigger: neither EventListener team-c/team nor an EventListenerPolicy allows polling with Secret admin-token",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := getAdmission(t)
+			err := a.ValidateTrigger(context.Background(), toUnstructured(t, tc.trigger))
+			if diff := cmp.Diff(tc.want, errString(err)); diff != "" {
+				t.Errorf("ValidateTrigger() error -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateEventListener(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		el   *triggersv1.EventListener
+		want string
+	}{{
+		name: "allowed",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"team-a"}},
+				Triggers: []triggersv1.EventListenerTrigger{{
+					Name:     "inline",
+					Template: &triggersv1.TriggerSpecTemplate{Ref: ptr.String("pipelinerun")},
+				}},
+			},
+		},
+	}, {
+		name: "not constrained",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"*"}},
+			},
+		},
+	}, {
+		name: "all namespaces",
+		el:   sharedEL,
+	}, {
+		name: "trigger group namespace",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				TriggerGroups: []triggersv1.EventListenerTriggerGroup{{
+					Name: "group",
+					TriggerSelector: triggersv1.EventListenerTriggerSelector{
+						NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"team-b"}},
+					},
+				}},
+			},
+		},
+		want: "EventListenerPolicy tenants doesn't allow Triggers in namespace team-b",
+	}, {
+		name: "inline trigger service account",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				Triggers: []triggersv1.EventListenerTrigger{{
+					Name:               "inline",
+					ServiceAccountName: "admin",
+					Template:           &triggersv1.TriggerSpecTemplate{Ref: ptr.String("pipelinerun")},
+				}},
+			},
+		},
+		want: "can't process Trigger inline: EventListenerPolicy tenants doesn't allow ServiceAccount admin",
+	}, {
+		name: "allowed event sources",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				KubernetesEventSources: []triggersv1.KubernetesEventSource{{
+					Name:       "runs",
+					APIVersion: "tekton.dev/v1",
+					Kind:       "PipelineRun",
+				}, {
+					Name:              "all-runs",
+					APIVersion:        "tekton.dev/v1",
+					Kind:              "PipelineRun",
+					NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"*"}},
+				}, {
+					Name:              "team-runs",
+					APIVersion:        "tekton.dev/v1",
+					Kind:              "TaskRun",
+					NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"team-a"}},
+				}},
+				MessageSources: []triggersv1.MessageSource{{Name: "kafka", AuthSecretName: "git-credentials"}},
+			},
+		},
+	}, {
+		name: "kubernetes event source kind",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				KubernetesEventSources: []triggersv1.KubernetesEventSource{{Name: "configmaps", APIVersion: "v1", Kind: "ConfigMap"}},
+			},
+		},
+		want: "can't watch the resources of Kubernetes event source configmaps: EventListenerPolicy tenants doesn't allow watching ConfigMap in namespace triggers",
+	}, {
+		name: "kubernetes event source namespace",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				KubernetesEventSources: []triggersv1.KubernetesEventSource{{
+					Name:              "runs",
+					APIVersion:        "tekton.dev/v1",
+					Kind:              "PipelineRun",
+					NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"team-b"}},
+				}},
+			},
+		},
+		want: "can't watch the resources of Kubernetes event source runs: EventListenerPolicy tenants doesn't allow watching PipelineRun.tekton.dev in namespace team-b",
+	}, {
+		name: "message source secret",
+		el: &triggersv1.EventListener{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "triggers"},
+			Spec: triggersv1.EventListenerSpec{
+				MessageSources: []triggersv1.MessageSource{{Name: "kafka", TLSSecretName: "broker-tls"}},
+			},
+		},
+		want: "can't consume the messages of message source kafka: EventListenerPolicy tenants doesn't allow Secret broker-tls",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := getAdmission(t)
+			err := a.ValidateEventListener(context.Background(), toUnstructured(t, tc.el))
+			if diff := cmp.Diff(tc.want, errString(err)); diff != "" {
+				t.Errorf("ValidateEventListener() error -want +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy enforces the EventListenerPolicies constraining the Triggers
+// processed by EventListeners.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Policies are the EventListenerPolicies constraining an EventListener.
+type Policies struct {
+	namespace string
+	items     []*triggersv1alpha1.EventListenerPolicy
+}
+
+// For returns the policies constraining the EventListener with the given
+// namespace and name. A nil lister returns no policies.
+func For(lister listersv1alpha1.EventListenerPolicyLister, namespace, name string) (*Policies, error) {
+	p := &Policies{namespace: namespace}
+	if lister == nil {
+		return p, nil
+	}
+	all, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list EventListenerPolicies: %w", err)
+	}
+	for _, policy := range all {
+		if policy.Spec.Constrains(namespace, name) {
+			p.items = append(p.items, policy)
+		}
+	}
+	return p, nil
+}
+
+// Empty returns whether no policy constrains the EventListener.
+func (p *Policies) Empty() bool {
+	return len(p.items) == 0
+}
+
+// CheckNamespace returns an error if a policy doesn't allow the EventListener
+// to process the Triggers of a namespace.
+func (p *Policies) CheckNamespace(namespace string) error {
+	for _, policy := range p.items {
+		if !policy.Spec.AllowsNamespace(p.namespace, namespace) {
+			return fmt.Errorf("EventListenerPolicy %s doesn't allow Triggers in namespace %s", policy.Name, namespace)
+		}
+	}
+	return nil
+}
+
+// CheckTrigger returns an error if a policy doesn't allow the EventListener to
+// process a Trigger of a namespace with a ServiceAccount.
+func (p *Policies) CheckTrigger(namespace, serviceAccount string) error {
+	if err := p.CheckNamespace(namespace); err != nil {
+		return err
+	}
+	for _, policy := range p.items {
+		if policy.Spec.AllowsServiceAccount(p.namespace, namespace, serviceAccount) {
+			continue
+		}
+		if serviceAccount == "" {
+			return fmt.Errorf("EventListenerPolicy %s requires Triggers in namespace %s to set a ServiceAccount", policy.Name, namespace)
+		}
+		return fmt.Errorf("EventListenerPolicy %s doesn't allow ServiceAccount %s", policy.Name, serviceAccount)
+	}
+	return nil
+}
+
+// CheckResources returns an error if a policy doesn't allow the Triggers of a
+// namespace to create one of the resources, to perform its action, or to
+// create it in its namespace. Resources without a namespace are created in
+// the namespace of the Trigger, and namespaces that depend on params are only
+// checked once the resources are rendered.
+func (p *Policies) CheckResources(namespace string, resources []json.RawMessage) error {
+	if p.Empty() {
+		return nil
+	}
+	for _, r := range resources {
+		var obj metav1.PartialObjectMetadata
+		if err := json.Unmarshal(r, &obj); err != nil {
+			return fmt.Errorf("failed to get the kind of resource: %w", err)
+		}
+		gv, err := schema.ParseGroupVersion(obj.APIVersion)
+		if err != nil {
+			return err
+		}
+		gk := gv.WithKind(obj.Kind).GroupKind()
+		action := obj.Annotations[triggers.ActionAnnotation]
+		if action == "" {
+			action = triggers.CreateAction
+		}
+		for _, policy := range p.items {
+			if !policy.Spec.AllowsKind(gk) {
+				return fmt.Errorf("EventListenerPolicy %s doesn't allow creating %s", policy.Name, gk)
+			}
+			if !policy.Spec.AllowsAction(action) {
+				return fmt.Errorf("EventListenerPolicy %s doesn't allow the %s action on %s", policy.Name, action, gk)
+			}
+			if target := obj.Namespace; target != "" && !strings.Contains(target, "$(") && !policy.Spec.AllowsResourceNamespace(namespace, target) {
+				return fmt.Errorf("EventListenerPolicy %s doesn't allow Triggers in namespace %s to create %s in namespace %s", policy.Name, namespace, gk, target)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckSecret returns an error if a policy doesn't allow the EventListener to
+// read a Secret to poll a repository or consume messages.
+func (p *Policies) CheckSecret(name string) error {
+	if name == "" {
+		return nil
+	}
+	for _, policy := range p.items {
+		if !policy.Spec.AllowsSecret(name) {
+			return fmt.Errorf("EventListenerPolicy %s doesn't allow Secret %s", policy.Name, name)
+		}
+	}
+	return nil
+}
+
+// CheckPollSecret returns an error if the EventListener isn't allowed to read
+// a Secret to poll the repository of one of its Triggers. Unlike the Secrets
+// of message sources, which are set on the EventListener itself, the Secret
+// must be listed in the pollSecretNames of the EventListener or in the
+// secretNames of a policy, since anyone who can create a Trigger selected by
+// the EventListener picks it.
+func (p *Policies) CheckPollSecret(el *triggersv1.EventListener, name string) error {
+	if name == "" {
+		return nil
+	}
+	if err := p.CheckSecret(name); err != nil {
+		return err
+	}
+	if slices.Contains(el.Spec.PollSecretNames, name) {
+		return nil
+	}
+	for _, policy := range p.items {
+		if slices.Contains(policy.Spec.SecretNames, name) {
+			return nil
+		}
+	}
+	return fmt.Errorf("neither EventListener %s/%s nor an EventListenerPolicy allows polling with Secret %s", el.Namespace, el.Name, name)
+}
+
+// CheckMessageSource returns an error if a policy doesn't allow the
+// EventListener to read the Secrets of a message source.
+func (p *Policies) CheckMessageSource(source triggersv1.MessageSource) error {
+	for _, name := range []string{source.AuthSecretName, source.TLSSecretName} {
+		if err := p.CheckSecret(name); err != nil {
+			return fmt.Errorf("can't consume the messages of message source %s: %w", source.Name, err)
+		}
+	}
+	return nil
+}
+
+// CheckKubernetesEventSource returns an error if a policy doesn't allow the
+// EventListener to watch the kind of resources of a Kubernetes event source,
+// or one of the namespaces it selects. Selecting all namespaces is allowed,
+// since the resources of each namespace are checked by CheckWatch when they
+// change.
+func (p *Policies) CheckKubernetesEventSource(source triggersv1.KubernetesEventSource) error {
+	gv, err := schema.ParseGroupVersion(source.APIVersion)
+	if err != nil {
+		return err
+	}
+	gk := gv.WithKind(source.Kind).GroupKind()
+	namespaces := source.NamespaceSelector.MatchNames
+	if len(namespaces) == 0 {
+		namespaces = []string{p.namespace}
+	}
+	for _, ns := range namespaces {
+		if ns == "*" {
+			ns = p.namespace
+		}
+		if err := p.CheckWatch(gk, ns); err != nil {
+			return fmt.Errorf("can't watch the resources of Kubernetes event source %s: %w", source.Name, err)
+		}
+	}
+	return nil
+}
+
+// CheckWatch returns an error if a policy doesn't allow the EventListener to
+// watch the resources of a kind in a namespace, which is empty for
+// cluster-scoped resources.
+func (p *Policies) CheckWatch(gk schema.GroupKind, namespace string) error {
+	for _, policy := range p.items {
+		if policy.Spec.AllowsWatch(p.namespace, gk, namespace) {
+			continue
+		}
+		if namespace == "" {
+			return fmt.Errorf("EventListenerPolicy %s doesn't allow watching %s", policy.Name, gk)
+		}
+		return fmt.Errorf("EventListenerPolicy %s doesn't allow watching %s in namespace %s", policy.Name, gk, namespace)
+	}
+	return nil
+}
+
+// Selects returns whether an EventListener processes a Trigger, either
+// referenced by the EventListener or selected by it or one of its trigger
+// groups.
+func Selects(el *triggersv1.EventListener, t *triggersv1.Trigger) bool {
+	if t.Namespace == el.Namespace {
+		for _, elt := range el.Spec.Triggers {
+			if elt.Template == nil && elt.TriggerRef == t.Name {
+				return true
+			}
+		}
+	}
+	if selects(el.Namespace, el.Spec.NamespaceSelector, el.Spec.LabelSelector, t) {
+		return true
+	}
+	for _, g := range el.Spec.TriggerGroups {
+		if selects(el.Namespace, g.TriggerSelector.NamespaceSelector, g.TriggerSelector.LabelSelector, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// selects mirrors how the EventListener selects the Triggers of its
+// namespace and label selectors.
+func selects(namespace string, nsSelector triggersv1.NamespaceSelector, labelSelector *metav1.LabelSelector, t *triggersv1.Trigger) bool {
+	switch {
+	case len(nsSelector.MatchNames) == 1 && nsSelector.MatchNames[0] == "*":
+	case len(nsSelector.MatchNames) != 0:
+		found := false
+		for _, ns := range nsSelector.MatchNames {
+			if ns == t.Namespace {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	case labelSelector != nil:
+		if t.Namespace != namespace {
+			return false
+		}
+	default:
+		return false
+	}
+	if labelSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(t.Labels))
+}
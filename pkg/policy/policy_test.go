@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSelects(t *testing.T) {
+	labels := &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	for _, tc := range []struct {
+		name    string
+		spec    triggersv1.EventListenerSpec
+		trigger metav1.ObjectMeta
+		want    bool
+	}{{
+		name:    "trigger ref",
+		spec:    triggersv1.EventListenerSpec{Triggers: []triggersv1.EventListenerTrigger{{TriggerRef: "tr"}}},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "el"},
+		want:    true,
+	}, {
+		name:    "trigger ref in another namespace",
+		spec:    triggersv1.EventListenerSpec{Triggers: []triggersv1.EventListenerTrigger{{TriggerRef: "tr"}}},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "team-a"},
+	}, {
+		name:    "label selector",
+		spec:    triggersv1.EventListenerSpec{LabelSelector: labels},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "el", Labels: map[string]string{"team": "a"}},
+		want:    true,
+	}, {
+		name:    "label selector in another namespace",
+		spec:    triggersv1.EventListenerSpec{LabelSelector: labels},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "team-a", Labels: map[string]string{"team": "a"}},
+	}, {
+		name: "namespace selector",
+		spec: triggersv1.EventListenerSpec{
+			NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"team-a"}},
+		},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "team-a"},
+		want:    true,
+	}, {
+		name: "namespace and label selectors",
+		spec: triggersv1.EventListenerSpec{
+			NamespaceSelector: triggersv1.NamespaceSelector{MatchNames: []string{"*"}},
+			LabelSelector:     labels,
+		},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "team-b", Labels: map[string]string{"team": "b"}},
+	}, {
+		name: "trigger group",
+		spec: triggersv1.EventListenerSpec{
+			TriggerGroups: []triggersv1.EventListenerTriggerGroup{{
+				TriggerSelector: triggersv1.EventListenerTriggerSelector{LabelSelector: labels},
+			}},
+		},
+		trigger: metav1.ObjectMeta{Name: "tr", Namespace: "el", Labels: map[string]string{"team": "a"}},
+		want:    true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1.EventListener{ObjectMeta: metav1.ObjectMeta{Name: "el", Namespace: "el"}, Spec: tc.spec}
+			if got := Selects(el, &triggersv1.Trigger{ObjectMeta: tc.trigger}); got != tc.want {
+				t.Errorf("Selects() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
@@ -22,8 +22,15 @@ import (
 	cfg "github.com/tektoncd/triggers/pkg/apis/config"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	triggersclient "github.com/tektoncd/triggers/pkg/client/injection/client"
+	clusterinterceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/clusterinterceptor"
+	interceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/interceptor"
+	clustertriggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding"
 	eventlistenerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/eventlistener"
+	triggerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/trigger"
+	triggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggerbinding"
+	triggertemplateinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate"
 	eventlistenerreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/eventlistener"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
 	dynamicduck "github.com/tektoncd/triggers/pkg/dynamic"
 	"github.com/tektoncd/triggers/pkg/reconciler/eventlistener/resources"
 	"github.com/tektoncd/triggers/pkg/reconciler/metrics"
@@ -33,10 +40,15 @@ import (
 	duckinformer "knative.dev/pkg/client/injection/ducks/duck/v1/podspecable"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	filtereddeployinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment/filtered"
+	filteredhpainformer "knative.dev/pkg/client/injection/kube/informers/autoscaling/v2/horizontalpodautoscaler/filtered"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	filteredserviceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/service/filtered"
+	filteredingressinformer "knative.dev/pkg/client/injection/kube/informers/networking/v1/ingress/filtered"
+	filteredpdbinformer "knative.dev/pkg/client/injection/kube/informers/policy/v1/poddisruptionbudget/filtered"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/clients/dynamicclient"
+	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
 )
 
@@ -50,6 +62,16 @@ func NewController(config resources.Config) func(context.Context, configmap.Watc
 		eventListenerInformer := eventlistenerinformer.Get(ctx)
 		deploymentInformer := filtereddeployinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
 		serviceInformer := filteredserviceinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+		hpaInformer := filteredhpainformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+		pdbInformer := filteredpdbinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+		ingressInformer := filteredingressinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+		secretInformer := filteredsecretinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+		triggerInformer := triggerinformer.Get(ctx)
+		triggerBindingInformer := triggerbindinginformer.Get(ctx)
+		clusterTriggerBindingInformer := clustertriggerbindinginformer.Get(ctx)
+		triggerTemplateInformer := triggertemplateinformer.Get(ctx)
+		interceptorInformer := interceptorinformer.Get(ctx)
+		clusterInterceptorInformer := clusterinterceptorinformer.Get(ctx)
 
 		reconciler := &Reconciler{
 			DynamicClientSet:  dynamicclientset,
@@ -57,9 +79,22 @@ func NewController(config resources.Config) func(context.Context, configmap.Watc
 			TriggersClientSet: triggersclientset,
 			deploymentLister:  deploymentInformer.Lister(),
 			serviceLister:     serviceInformer.Lister(),
-			configAcc:         reconcilersource.WatchConfigurations(ctx, "eventlistener", cmw),
-			config:            config,
-			Metrics:           metrics.Get(ctx),
+
+			horizontalPodAutoscalerLister: hpaInformer.Lister(),
+			podDisruptionBudgetLister:     pdbInformer.Lister(),
+			ingressLister:                 ingressInformer.Lister(),
+			secretLister:                  secretInformer.Lister(),
+
+			triggerLister:               triggerInformer.Lister(),
+			triggerBindingLister:        triggerBindingInformer.Lister(),
+			clusterTriggerBindingLister: clusterTriggerBindingInformer.Lister(),
+			triggerTemplateLister:       triggerTemplateInformer.Lister(),
+			interceptorLister:           interceptorInformer.Lister(),
+			clusterInterceptorLister:    clusterInterceptorInformer.Lister(),
+
+			configAcc: reconcilersource.WatchConfigurations(ctx, "eventlistener", cmw),
+			config:    config,
+			Metrics:   metrics.Get(ctx),
 		}
 
 		impl := eventlistenerreconciler.NewImpl(ctx, reconciler, func(_ *controller.Impl) controller.Options {
@@ -91,6 +126,72 @@ func NewController(config resources.Config) func(context.Context, configmap.Watc
 			logging.FromContext(ctx).Panicf("Couldn't register Service informer event handler: %w", err)
 		}
 
+		if _, err := hpaInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1beta1.EventListener{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register HorizontalPodAutoscaler informer event handler: %w", err)
+		}
+
+		if _, err := pdbInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1beta1.EventListener{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register PodDisruptionBudget informer event handler: %w", err)
+		}
+
+		if _, err := ingressInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1beta1.EventListener{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register Ingress informer event handler: %w", err)
+		}
+
+		if _, err := secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterController(&v1beta1.EventListener{}),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		}); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register Secret informer event handler: %w", err)
+		}
+
+		// Requeue the EventListeners whose Triggers may reference an object
+		// when it changes, so that the TriggersResolved condition stays current.
+		referenced := controller.HandleAll(enqueueEventListeners(impl, eventListenerInformer.Lister()))
+		for kind, informer := range map[string]cache.SharedIndexInformer{
+			"Trigger":               triggerInformer.Informer(),
+			"TriggerBinding":        triggerBindingInformer.Informer(),
+			"ClusterTriggerBinding": clusterTriggerBindingInformer.Informer(),
+			"TriggerTemplate":       triggerTemplateInformer.Informer(),
+			"Interceptor":           interceptorInformer.Informer(),
+			"ClusterInterceptor":    clusterInterceptorInformer.Informer(),
+		} {
+			if _, err := informer.AddEventHandler(referenced); err != nil {
+				logging.FromContext(ctx).Panicf("Couldn't register %s informer event handler: %w", kind, err)
+			}
+		}
+
 		return impl
 	}
 }
+
+// enqueueEventListeners returns a handler that enqueues every EventListener
+// that may process Triggers referencing the changed object: those in the
+// object's namespace, those selecting Triggers across namespaces, and all of
+// them for cluster scoped objects.
+func enqueueEventListeners(impl *controller.Impl, lister listers.EventListenerLister) func(interface{}) {
+	return func(obj interface{}) {
+		object, err := kmeta.DeletionHandlingAccessor(obj)
+		if err != nil {
+			return
+		}
+		els, err := lister.List(labels.Everything())
+		if err != nil {
+			return
+		}
+		for _, el := range els {
+			if object.GetNamespace() == "" || object.GetNamespace() == el.Namespace || selectsAcrossNamespaces(el) {
+				impl.Enqueue(el)
+			}
+		}
+	}
+}
@@ -17,17 +17,20 @@ limitations under the License.
 package eventlistener
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/tektoncd/triggers/pkg/apis/config"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/contexts"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	triggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	eventlistenerreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/eventlistener"
+	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
 	dynamicduck "github.com/tektoncd/triggers/pkg/dynamic"
 	"github.com/tektoncd/triggers/pkg/reconciler/eventlistener/resources"
 	"github.com/tektoncd/triggers/pkg/reconciler/metrics"
@@ -38,15 +41,27 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	appsv1lister "k8s.io/client-go/listers/apps/v1"
+	autoscalingv2lister "k8s.io/client-go/listers/autoscaling/v2"
 	corev1lister "k8s.io/client-go/listers/core/v1"
+	networkingv1lister "k8s.io/client-go/listers/networking/v1"
+	policyv1lister "k8s.io/client-go/listers/policy/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/csaupgrade"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
 	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
@@ -62,11 +77,28 @@ const (
 	eventListenerContainerPort = 8080
 	// eventListenerMetricsPort defines metrics port for EventListener Service
 	eventListenerMetricsPort = 9000
+	// eventListenerAdminPort defines the port the EventListener Container serves its probes, metrics and profiles on
+	eventListenerAdminPort = 8081
 	// GeneratedResourcePrefix is the name prefix for resources generated in the
 	// EventListener reconciler
 	GeneratedResourcePrefix = "el"
 )
 
+var (
+	httpRouteGVR = gatewayv1.SchemeGroupVersion.WithResource("httproutes")
+	gatewayGVR   = gatewayv1.SchemeGroupVersion.WithResource("gateways")
+
+	// controllerFieldManager is the field manager the API server records for
+	// the requests of the controller that don't set one, derived from its
+	// user agent.
+	controllerFieldManager = strings.SplitN(rest.DefaultKubernetesUserAgent(), "/", 2)[0]
+
+	// createTLSCA and createTLSCertificate generate the CAs and certificates
+	// of EventListeners with generated TLS, overridden in tests.
+	createTLSCA          = resources.CreateTLSCA
+	createTLSCertificate = resources.CreateTLSCertificate
+)
+
 // Reconciler implements controller.Reconciler for Configuration resources.
 type Reconciler struct {
 	DynamicClientSet dynamic.Interface
@@ -78,8 +110,20 @@ type Reconciler struct {
 	TriggersClientSet triggersclientset.Interface
 
 	// listers index properties about resources
-	deploymentLister appsv1lister.DeploymentLister
-	serviceLister    corev1lister.ServiceLister
+	deploymentLister              appsv1lister.DeploymentLister
+	serviceLister                 corev1lister.ServiceLister
+	horizontalPodAutoscalerLister autoscalingv2lister.HorizontalPodAutoscalerLister
+	podDisruptionBudgetLister     policyv1lister.PodDisruptionBudgetLister
+	ingressLister                 networkingv1lister.IngressLister
+	secretLister                  corev1lister.SecretLister
+
+	// listers for the objects referenced by the EventListener's Triggers
+	triggerLister               listers.TriggerLister
+	triggerBindingLister        listers.TriggerBindingLister
+	clusterTriggerBindingLister listers.ClusterTriggerBindingLister
+	triggerTemplateLister       listers.TriggerTemplateLister
+	interceptorLister           listersv1alpha1.InterceptorLister
+	clusterInterceptorLister    listersv1alpha1.ClusterInterceptorLister
 
 	// config accessor for observability/logging/tracing
 	configAcc reconcilersource.ConfigAccessor
@@ -88,6 +132,7 @@ type Reconciler struct {
 	config             resources.Config
 	podspecableTracker dynamicduck.ListableTracker
 	onlyOnce           sync.Once
+	knativeServiceOnce sync.Once
 
 	// Metrics Recorder config
 	Metrics *metrics.Recorder
@@ -111,11 +156,26 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, el *v1beta1.EventListene
 
 	cfg := config.FromContextOrDefaults(ctx)
 
+	el.Status.SetTriggerStatuses(r.resolveTriggers(el))
+
 	if el.Spec.Resources.CustomResource != nil {
 		return r.reconcileCustomObject(ctx, el, cfg)
 	}
-	deploymentReconcileError := r.reconcileDeployment(ctx, el, cfg)
+	if el.Spec.Resources.KnativeService != nil {
+		if err := r.reconcileKnativeService(ctx, el, cfg); err != nil {
+			return err
+		}
+		el.Status.SetReadyCondition()
+		return nil
+	}
+	// Delete the Knative Service the EventListener may have run as before.
+	deploymentReconcileError := r.reconcileKnativeService(ctx, el, cfg)
+	deploymentReconcileError = wrapError(deploymentReconcileError, r.reconcileTLSSecret(ctx, el))
+	deploymentReconcileError = wrapError(deploymentReconcileError, r.reconcileDeployment(ctx, el, cfg))
+	deploymentReconcileError = wrapError(deploymentReconcileError, r.reconcileHorizontalPodAutoscaler(ctx, el))
+	deploymentReconcileError = wrapError(deploymentReconcileError, r.reconcilePodDisruptionBudget(ctx, el))
 	serviceReconcileError := r.reconcileService(ctx, el)
+	serviceReconcileError = wrapError(serviceReconcileError, r.reconcileExposure(ctx, el))
 	if el.Spec.Resources.CustomResource == nil {
 		el.Status.SetReadyCondition()
 	}
@@ -250,6 +310,444 @@ func (r *Reconciler) reconcileDeployment(ctx context.Context, el *v1beta1.EventL
 	return nil
 }
 
+// reconcileTLSSecret creates, rotates or deletes the Secret storing the
+// certificate generated for the EventListener, and the Secret storing the CA
+// that signs it. Only the certificate is rotated while the CA is valid, so
+// that clients keep trusting it. The certificate is checked on every resync
+// of the EventListener, which happens well within
+// resources.TLSCertificateRenewBefore, and the sink reloads it once the
+// kubelet updates the mounted Secret.
+func (r *Reconciler) reconcileTLSSecret(ctx context.Context, el *v1beta1.EventListener) error {
+	if el.Spec.TLS == nil || !el.Spec.TLS.Generate {
+		return r.deleteTLSSecrets(ctx, el)
+	}
+	caKey, caCert, err := r.reconcileTLSCASecret(ctx, el)
+	if err != nil {
+		return err
+	}
+
+	name := resources.GeneratedTLSSecretName(el)
+	existing, err := r.secretLister.Secrets(el.Namespace).Get(name)
+	switch {
+	case err == nil:
+		if !metav1.IsControlledBy(existing, el) {
+			return fmt.Errorf("secret %s in namespace %s is not owned by the EventListener", name, el.Namespace)
+		}
+		rotationTime, err := resources.TLSSecretRotationTime(existing)
+		if err == nil && time.Now().Before(rotationTime) && bytes.Equal(existing.Data[resources.TLSCACertKey], caCert) {
+			return nil
+		}
+		secret, err := r.makeTLSSecret(ctx, el, caKey, caCert)
+		if err != nil {
+			return err
+		}
+		existing = existing.DeepCopy() // Don't modify the lister cache
+		existing.Labels = secret.Labels
+		existing.Data = secret.Data
+		if _, err := r.KubeClientSet.CoreV1().Secrets(el.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error rotating EventListener TLS certificate: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Rotated EventListener TLS certificate %s in Namespace %s", name, el.Namespace)
+
+	case errors.IsNotFound(err):
+		secret, err := r.makeTLSSecret(ctx, el, caKey, caCert)
+		if err != nil {
+			return err
+		}
+		if _, err := r.KubeClientSet.CoreV1().Secrets(el.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener TLS Secret: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener TLS Secret %s in Namespace %s", name, el.Namespace)
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// reconcileTLSCASecret creates the Secret storing the CA generated for the
+// EventListener, or rotates it when it expires, and returns the PEM encoded
+// private key and certificate of the CA.
+func (r *Reconciler) reconcileTLSCASecret(ctx context.Context, el *v1beta1.EventListener) ([]byte, []byte, error) {
+	name := resources.GeneratedTLSCASecretName(el)
+	existing, err := r.secretLister.Secrets(el.Namespace).Get(name)
+	switch {
+	case err == nil:
+		if !metav1.IsControlledBy(existing, el) {
+			return nil, nil, fmt.Errorf("secret %s in namespace %s is not owned by the EventListener", name, el.Namespace)
+		}
+		rotationTime, err := resources.TLSCASecretRotationTime(existing)
+		if err == nil && time.Now().Before(rotationTime) {
+			return existing.Data[resources.TLSCAKeyKey], existing.Data[resources.TLSCACertKey], nil
+		}
+		secret, err := r.makeTLSCASecret(ctx, el)
+		if err != nil {
+			return nil, nil, err
+		}
+		existing = existing.DeepCopy() // Don't modify the lister cache
+		existing.Labels = secret.Labels
+		existing.Data = secret.Data
+		if _, err := r.KubeClientSet.CoreV1().Secrets(el.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error rotating EventListener TLS CA: %s", err)
+			return nil, nil, err
+		}
+		logging.FromContext(ctx).Infof("Rotated EventListener TLS CA %s in Namespace %s", name, el.Namespace)
+		return secret.Data[resources.TLSCAKeyKey], secret.Data[resources.TLSCACertKey], nil
+
+	case errors.IsNotFound(err):
+		secret, err := r.makeTLSCASecret(ctx, el)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := r.KubeClientSet.CoreV1().Secrets(el.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener TLS CA Secret: %s", err)
+			return nil, nil, err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener TLS CA Secret %s in Namespace %s", name, el.Namespace)
+		return secret.Data[resources.TLSCAKeyKey], secret.Data[resources.TLSCACertKey], nil
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return nil, nil, err
+	}
+}
+
+// deleteTLSSecrets deletes the Secrets storing the certificate and the CA
+// generated for the EventListener, if it doesn't generate them anymore.
+func (r *Reconciler) deleteTLSSecrets(ctx context.Context, el *v1beta1.EventListener) error {
+	for _, name := range []string{resources.GeneratedTLSSecretName(el), resources.GeneratedTLSCASecretName(el)} {
+		existing, err := r.secretLister.Secrets(el.Namespace).Get(name)
+		switch {
+		case errors.IsNotFound(err):
+			continue
+		case err != nil:
+			logging.FromContext(ctx).Error(err)
+			return err
+		case !metav1.IsControlledBy(existing, el):
+			continue
+		}
+		if err := r.KubeClientSet.CoreV1().Secrets(el.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener TLS Secret: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener TLS Secret %s in Namespace %s", name, el.Namespace)
+	}
+	return nil
+}
+
+// makeTLSCASecret generates a CA for the Service of the EventListener, and
+// returns the Secret storing it.
+func (r *Reconciler) makeTLSCASecret(ctx context.Context, el *v1beta1.EventListener) (*corev1.Secret, error) {
+	caKey, caCert, err := createTLSCA(el.Status.Configuration.GeneratedResourceName, el.Namespace,
+		time.Now().Add(resources.TLSCAValidity))
+	if err != nil {
+		logging.FromContext(ctx).Errorf("Error generating EventListener TLS CA: %s", err)
+		return nil, err
+	}
+	return resources.MakeTLSCASecret(ctx, el, r.config, caKey, caCert), nil
+}
+
+// makeTLSSecret generates a certificate for the Service of the EventListener,
+// signed by the CA, and returns the Secret storing it.
+func (r *Reconciler) makeTLSSecret(ctx context.Context, el *v1beta1.EventListener, caKey, caCert []byte) (*corev1.Secret, error) {
+	serverKey, serverCert, err := createTLSCertificate(el.Status.Configuration.GeneratedResourceName, el.Namespace,
+		caKey, caCert, time.Now().Add(resources.TLSCertificateValidity))
+	if err != nil {
+		logging.FromContext(ctx).Errorf("Error generating EventListener TLS certificate: %s", err)
+		return nil, err
+	}
+	return resources.MakeTLSSecret(ctx, el, r.config, serverKey, serverCert, caCert), nil
+}
+
+// reconcileHorizontalPodAutoscaler creates, updates or deletes the
+// HorizontalPodAutoscaler scaling the EventListener Deployment.
+func (r *Reconciler) reconcileHorizontalPodAutoscaler(ctx context.Context, el *v1beta1.EventListener) error {
+	hpa := resources.MakeHorizontalPodAutoscaler(ctx, el, r.config)
+	name := el.Status.Configuration.GeneratedResourceName
+
+	existing, err := r.horizontalPodAutoscalerLister.HorizontalPodAutoscalers(el.Namespace).Get(name)
+	switch {
+	case hpa == nil && err == nil:
+		if !metav1.IsControlledBy(existing, el) {
+			return nil
+		}
+		if err := r.KubeClientSet.AutoscalingV2().HorizontalPodAutoscalers(el.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener HorizontalPodAutoscaler: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener HorizontalPodAutoscaler %s in Namespace %s", name, el.Namespace)
+
+	case hpa == nil && errors.IsNotFound(err):
+		return nil
+
+	case err == nil:
+		// Preserve user-added annotations.
+		if len(existing.Annotations) > 0 {
+			hpa.Annotations = kmeta.UnionMaps(hpa.Annotations, existing.Annotations)
+		}
+		if !equality.Semantic.DeepEqual(existing.Spec, hpa.Spec) ||
+			!equality.Semantic.DeepEqual(existing.Labels, hpa.Labels) ||
+			!equality.Semantic.DeepEqual(existing.Annotations, hpa.Annotations) {
+			existing = existing.DeepCopy() // Don't modify the lister cache
+			existing.Labels = hpa.Labels
+			existing.Annotations = hpa.Annotations
+			existing.Spec = hpa.Spec
+			if updated, err := r.KubeClientSet.AutoscalingV2().HorizontalPodAutoscalers(el.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+				logging.FromContext(ctx).Errorf("Error updating EventListener HorizontalPodAutoscaler: %s", err)
+				return err
+			} else if existing.ResourceVersion != updated.ResourceVersion {
+				logging.FromContext(ctx).Infof("Updated EventListener HorizontalPodAutoscaler %s in Namespace %s", name, el.Namespace)
+			}
+		}
+
+	case errors.IsNotFound(err):
+		if _, err := r.KubeClientSet.AutoscalingV2().HorizontalPodAutoscalers(el.Namespace).Create(ctx, hpa, metav1.CreateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener HorizontalPodAutoscaler: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener HorizontalPodAutoscaler %s in Namespace %s", name, el.Namespace)
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// reconcilePodDisruptionBudget creates, updates or deletes the
+// PodDisruptionBudget of an EventListener running multiple replicas.
+func (r *Reconciler) reconcilePodDisruptionBudget(ctx context.Context, el *v1beta1.EventListener) error {
+	pdb := resources.MakePodDisruptionBudget(ctx, el, r.config)
+	name := el.Status.Configuration.GeneratedResourceName
+
+	existing, err := r.podDisruptionBudgetLister.PodDisruptionBudgets(el.Namespace).Get(name)
+	switch {
+	case pdb == nil && err == nil:
+		if !metav1.IsControlledBy(existing, el) {
+			return nil
+		}
+		if err := r.KubeClientSet.PolicyV1().PodDisruptionBudgets(el.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener PodDisruptionBudget: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener PodDisruptionBudget %s in Namespace %s", name, el.Namespace)
+
+	case pdb == nil && errors.IsNotFound(err):
+		return nil
+
+	case err == nil:
+		// Preserve user-added annotations.
+		if len(existing.Annotations) > 0 {
+			pdb.Annotations = kmeta.UnionMaps(pdb.Annotations, existing.Annotations)
+		}
+		if !equality.Semantic.DeepEqual(existing.Spec, pdb.Spec) ||
+			!equality.Semantic.DeepEqual(existing.Labels, pdb.Labels) ||
+			!equality.Semantic.DeepEqual(existing.Annotations, pdb.Annotations) {
+			existing = existing.DeepCopy() // Don't modify the lister cache
+			existing.Labels = pdb.Labels
+			existing.Annotations = pdb.Annotations
+			existing.Spec = pdb.Spec
+			if updated, err := r.KubeClientSet.PolicyV1().PodDisruptionBudgets(el.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+				logging.FromContext(ctx).Errorf("Error updating EventListener PodDisruptionBudget: %s", err)
+				return err
+			} else if existing.ResourceVersion != updated.ResourceVersion {
+				logging.FromContext(ctx).Infof("Updated EventListener PodDisruptionBudget %s in Namespace %s", name, el.Namespace)
+			}
+		}
+
+	case errors.IsNotFound(err):
+		if _, err := r.KubeClientSet.PolicyV1().PodDisruptionBudgets(el.Namespace).Create(ctx, pdb, metav1.CreateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener PodDisruptionBudget: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener PodDisruptionBudget %s in Namespace %s", name, el.Namespace)
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// reconcileExposure creates, updates or deletes the Ingress or HTTPRoute
+// exposing the EventListener outside of the cluster, and reports the URL it's
+// reachable at.
+func (r *Reconciler) reconcileExposure(ctx context.Context, el *v1beta1.EventListener) error {
+	if err := wrapError(r.reconcileIngress(ctx, el), r.reconcileHTTPRoute(ctx, el)); err != nil {
+		// Keep reporting the previous address, which is also how a removed
+		// HTTPRoute is known to still need cleaning up.
+		return err
+	}
+
+	tls := false
+	if e := el.Spec.Exposure; e != nil {
+		switch e.GetType() {
+		case v1beta1.IngressExposure:
+			tls = e.TLSSecretName != ""
+		case v1beta1.HTTPRouteExposure:
+			tls = r.gatewayTerminatesTLS(ctx, el)
+		}
+	}
+	el.Status.SetExternalAddress(resources.ExternalURL(el, tls))
+	return nil
+}
+
+func (r *Reconciler) reconcileIngress(ctx context.Context, el *v1beta1.EventListener) error {
+	ingress := resources.MakeIngress(ctx, el, r.config)
+	name := el.Status.Configuration.GeneratedResourceName
+
+	existing, err := r.ingressLister.Ingresses(el.Namespace).Get(name)
+	switch {
+	case ingress == nil && err == nil:
+		if !metav1.IsControlledBy(existing, el) {
+			return nil
+		}
+		if err := r.KubeClientSet.NetworkingV1().Ingresses(el.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener Ingress: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener Ingress %s in Namespace %s", name, el.Namespace)
+
+	case ingress == nil && errors.IsNotFound(err):
+		return nil
+
+	case err == nil:
+		// Preserve user-added annotations, which configure most ingress controllers.
+		if len(existing.Annotations) > 0 {
+			ingress.Annotations = kmeta.UnionMaps(ingress.Annotations, existing.Annotations)
+		}
+		if !equality.Semantic.DeepEqual(existing.Spec, ingress.Spec) ||
+			!equality.Semantic.DeepEqual(existing.Labels, ingress.Labels) ||
+			!equality.Semantic.DeepEqual(existing.Annotations, ingress.Annotations) {
+			existing = existing.DeepCopy() // Don't modify the lister cache
+			existing.Labels = ingress.Labels
+			existing.Annotations = ingress.Annotations
+			existing.Spec = ingress.Spec
+			if updated, err := r.KubeClientSet.NetworkingV1().Ingresses(el.Namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+				logging.FromContext(ctx).Errorf("Error updating EventListener Ingress: %s", err)
+				return err
+			} else if existing.ResourceVersion != updated.ResourceVersion {
+				logging.FromContext(ctx).Infof("Updated EventListener Ingress %s in Namespace %s", name, el.Namespace)
+			}
+		}
+
+	case errors.IsNotFound(err):
+		if _, err := r.KubeClientSet.NetworkingV1().Ingresses(el.Namespace).Create(ctx, ingress, metav1.CreateOptions{}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener Ingress: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener Ingress %s in Namespace %s", name, el.Namespace)
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// reconcileHTTPRoute creates, updates or deletes the Gateway API HTTPRoute
+// exposing the EventListener. The Gateway API is an optional CRD, so
+// HTTPRoutes are managed with the dynamic client instead of an informer.
+func (r *Reconciler) reconcileHTTPRoute(ctx context.Context, el *v1beta1.EventListener) error {
+	route := resources.MakeHTTPRoute(ctx, el, r.config)
+	if route == nil && !hasExternalAddress(el) {
+		// An EventListener without an external address was never exposed,
+		// so there's no HTTPRoute to clean up.
+		return nil
+	}
+	name := el.Status.Configuration.GeneratedResourceName
+	client := r.DynamicClientSet.Resource(httpRouteGVR).Namespace(el.Namespace)
+
+	existingData, err := client.Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case route == nil && err == nil:
+		if !metav1.IsControlledBy(existingData, el) {
+			return nil
+		}
+		if err := client.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener HTTPRoute: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener HTTPRoute %s in Namespace %s", name, el.Namespace)
+
+	case route == nil && errors.IsNotFound(err):
+		return nil
+
+	case err == nil:
+		// Apply the generated HTTPRoute with server-side apply, which
+		// preserves the fields set by other field managers, such as the
+		// defaults of the Gateway API, and reverts the generated fields
+		// changed outside of the EventListener.
+		data, err := applyConfiguration(route)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("failed to convert HTTPRoute: %v", err)
+			return err
+		}
+		appliedData, err := client.Apply(ctx, name, data, metav1.ApplyOptions{FieldManager: resources.EventListenerFieldManager, Force: true})
+		if err != nil {
+			logging.FromContext(ctx).Errorf("Error applying EventListener HTTPRoute: %s", err)
+			return err
+		}
+		if existingData.GetResourceVersion() != appliedData.GetResourceVersion() {
+			logging.FromContext(ctx).Infof("Updated EventListener HTTPRoute %s in Namespace %s", name, el.Namespace)
+		}
+
+	case errors.IsNotFound(err):
+		data, err := applyConfiguration(route)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("failed to convert HTTPRoute: %v", err)
+			return err
+		}
+		if _, err := client.Create(ctx, data, metav1.CreateOptions{FieldManager: resources.EventListenerFieldManager}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener HTTPRoute: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener HTTPRoute %s in Namespace %s", name, el.Namespace)
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// gatewayTerminatesTLS reports whether the Gateway the HTTPRoute of the
+// EventListener is attached to receives requests with TLS. Gateways that
+// can't be read are assumed not to.
+func (r *Reconciler) gatewayTerminatesTLS(ctx context.Context, el *v1beta1.EventListener) bool {
+	ref := el.Spec.Exposure.Gateway
+	if ref == nil {
+		return false
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = el.Namespace
+	}
+	data, err := r.DynamicClientSet.Resource(gatewayGVR).Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		logging.FromContext(ctx).Warnf("Couldn't get Gateway %s/%s of the EventListener HTTPRoute: %v", namespace, ref.Name, err)
+		return false
+	}
+	gateway := &gatewayv1.Gateway{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(data.Object, gateway); err != nil {
+		logging.FromContext(ctx).Warnf("failed to convert Gateway: %v", err)
+		return false
+	}
+	return resources.GatewayTerminatesTLS(gateway, ref.SectionName)
+}
+
+func hasExternalAddress(el *v1beta1.EventListener) bool {
+	for _, a := range el.Status.Addresses {
+		if a.Name != nil && *a.Name == v1beta1.ExternalAddressName {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *Reconciler) reconcileCustomObject(ctx context.Context, el *v1beta1.EventListener, cfg *config.Config) error {
 	data, err := resources.MakeCustomObject(ctx, el, r.configAcc, r.config, cfg)
 	if err != nil {
@@ -287,49 +785,35 @@ func (r *Reconciler) reconcileCustomObject(ctx context.Context, el *v1beta1.Even
 			}
 		}
 
-		var updated bool
-		// Preserve any externally added annotations
-		data.SetAnnotations(kmeta.UnionMaps(data.GetAnnotations(), existingCustomObject.GetAnnotations()))
-
-		if !equality.Semantic.DeepEqual(data.GetLabels(), existingCustomObject.GetLabels()) ||
-			!equality.Semantic.DeepEqual(data.GetAnnotations(), existingCustomObject.GetAnnotations()) {
-			// Don't modify informer copy
-			existingCustomObject = existingCustomObject.DeepCopy()
-			existingCustomObject.SetLabels(data.GetLabels())
-			existingCustomObject.SetAnnotations(data.GetAnnotations())
-
-			updated = true
+		// Apply the whole generated object with server-side apply, which
+		// preserves the fields set by other field managers and removes the
+		// ones that aren't generated anymore.
+		drifted := resources.CustomObjectDrift(data, existingCustomObject)
+		client := r.DynamicClientSet.Resource(gvr).Namespace(data.GetNamespace())
+		if existingCustomObject, err = upgradeManagedFields(ctx, client, existingCustomObject); err != nil {
+			logging.FromContext(ctx).Errorf("error migrating the managed fields of eventListener custom object: %v", err)
+			el.Status.SetCustomObjectSyncedCondition(nil, err)
+			return err
 		}
-
-		if !equality.Semantic.DeepEqual(data.Object["spec"], existingCustomObject.Object["spec"]) {
-			diffExist, existingObject, err := resources.UpdateCustomObject(data, existingCustomObject)
-			if err != nil {
-				return err
-			}
-			// To avoid un necessary marshalling when there is no updates.
-			if diffExist {
-				existingMarshaledData, err := json.Marshal(existingObject)
-				if err != nil {
-					logging.FromContext(ctx).Errorf("failed to marshal custom object: %v", err)
-					return err
-				}
-				existingCustomObject = new(unstructured.Unstructured)
-				if err := existingCustomObject.UnmarshalJSON(existingMarshaledData); err != nil {
-					logging.FromContext(ctx).Errorf("failed to unmarshal to unstructured object: %v", err)
-					return err
-				}
-				updated = diffExist
-			}
+		// A resourceVersion in the applied object would make it conflict with
+		// the updates of the other field managers.
+		data.SetResourceVersion("")
+		appliedCustomObject, err := client.Apply(ctx, data.GetName(), data,
+			metav1.ApplyOptions{FieldManager: resources.EventListenerFieldManager, Force: true})
+		if err != nil {
+			logging.FromContext(ctx).Errorf("error applying eventListener custom object: %v", err)
+			el.Status.SetCustomObjectSyncedCondition(nil, err)
+			return err
 		}
-		if updated {
-			updatedData, err := r.DynamicClientSet.Resource(gvr).Namespace(data.GetNamespace()).Update(ctx, existingCustomObject, metav1.UpdateOptions{})
-			if err != nil {
-				logging.FromContext(ctx).Errorf("error updating to eventListener custom object: %v", err)
-				return err
-			} else if data.GetResourceVersion() != updatedData.GetResourceVersion() {
-				logging.FromContext(ctx).Infof("Updated EventListener Custom Object %s in Namespace %s", data.GetName(), el.Namespace)
-			}
+		if existingCustomObject.GetResourceVersion() != appliedCustomObject.GetResourceVersion() {
+			logging.FromContext(ctx).Infof("Updated EventListener Custom Object %s in Namespace %s", data.GetName(), el.Namespace)
+		}
+		if len(drifted) > 0 {
+			logging.FromContext(ctx).Warnf("Corrected fields of EventListener Custom Object %s in Namespace %s changed outside of the EventListener: %s",
+				data.GetName(), el.Namespace, strings.Join(drifted, ", "))
 		}
+		el.Status.SetCustomObjectSyncedCondition(drifted, nil)
+		existingCustomObject = appliedCustomObject
 
 		// TODO(mattmoor): Consider replacing this stuff with the "addressable resolver"
 		// from knative.dev/pkg, which is purpose built for this kind of thing.
@@ -356,12 +840,129 @@ func (r *Reconciler) reconcileCustomObject(ctx context.Context, el *v1beta1.Even
 		}
 
 	case errors.IsNotFound(err):
-		createDynamicObject, err := r.DynamicClientSet.Resource(gvr).Namespace(data.GetNamespace()).Create(ctx, data, metav1.CreateOptions{})
+		createDynamicObject, err := r.DynamicClientSet.Resource(gvr).Namespace(data.GetNamespace()).Create(ctx, data,
+			metav1.CreateOptions{FieldManager: resources.EventListenerFieldManager})
 		if err != nil {
 			logging.FromContext(ctx).Errorf("Error creating EventListener Dynamic object: ", err)
 			return err
 		}
 		logging.FromContext(ctx).Infof("Created EventListener Deployment %s in Namespace %s", createDynamicObject.GetName(), el.Namespace)
+		el.Status.SetCustomObjectSyncedCondition(nil, nil)
+
+	default:
+		logging.FromContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// reconcileKnativeService creates, updates or deletes the Knative Service
+// running the EventListener. Knative Serving is an optional CRD, so Knative
+// Services are managed with the dynamic client instead of a lister.
+func (r *Reconciler) reconcileKnativeService(ctx context.Context, el *v1beta1.EventListener, cfg *config.Config) error {
+	ksvc := resources.MakeKnativeService(ctx, el, r.configAcc, r.config, cfg)
+	runsAsKnativeService := el.Status.GetCondition(v1beta1.KnativeServiceReady) != nil
+	if ksvc == nil && !runsAsKnativeService {
+		// An EventListener without the KnativeServiceReady condition never
+		// ran as a Knative Service, so there's none to clean up.
+		return nil
+	}
+	if ksvc != nil {
+		// Scheduled and polling Triggers are fired by the EventListener's
+		// replicas, so keep one running even if minScale allows zero.
+		if ptr.Int32Value(el.Spec.Resources.KnativeService.MinScale) < 1 {
+			fires, err := r.firesTriggers(el)
+			if err != nil {
+				return err
+			}
+			if fires {
+				ksvc.Spec.Template.Annotations[autoscaling.MinScaleAnnotationKey] = "1"
+			}
+		}
+		if !runsAsKnativeService {
+			// Clean up the resources of an EventListener that ran as a
+			// Deployment, whose Service would conflict with the one
+			// created by Knative Serving.
+			if err := r.cleanUpDeployment(ctx, el); err != nil {
+				return err
+			}
+		}
+		var watchError error
+		r.knativeServiceOnce.Do(func() {
+			watchError = r.podspecableTracker.WatchOnDynamicObject(ctx, resources.KnativeServiceGVR)
+		})
+		if watchError != nil {
+			logging.FromContext(ctx).Errorf("failed to watch on Knative Services: %v", watchError)
+			return watchError
+		}
+	}
+	name := el.Status.Configuration.GeneratedResourceName
+	client := r.DynamicClientSet.Resource(resources.KnativeServiceGVR).Namespace(el.Namespace)
+
+	existingData, err := client.Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case ksvc == nil && err == nil:
+		if metav1.IsControlledBy(existingData, el) {
+			if err := client.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				logging.FromContext(ctx).Errorf("Error deleting EventListener Knative Service: %s", err)
+				return err
+			}
+			logging.FromContext(ctx).Infof("Deleted EventListener Knative Service %s in Namespace %s", name, el.Namespace)
+		}
+		return el.Status.ClearKnativeServiceCondition()
+
+	case ksvc == nil && errors.IsNotFound(err):
+		return el.Status.ClearKnativeServiceCondition()
+
+	case err == nil:
+		if !metav1.IsControlledBy(existingData, el) {
+			err := fmt.Errorf("Knative Service %s in Namespace %s is not owned by the EventListener", name, el.Namespace)
+			logging.FromContext(ctx).Error(err)
+			return err
+		}
+		// Apply the whole generated Knative Service with server-side apply,
+		// which preserves the fields set by other field managers, such as
+		// the defaults of Knative Serving, and reverts the generated fields
+		// changed outside of the EventListener.
+		if existingData, err = upgradeManagedFields(ctx, client, existingData); err != nil {
+			logging.FromContext(ctx).Errorf("Error migrating the managed fields of EventListener Knative Service: %s", err)
+			return err
+		}
+		data, err := applyConfiguration(ksvc)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("failed to convert Knative Service: %v", err)
+			return err
+		}
+		appliedData, err := client.Apply(ctx, name, data, metav1.ApplyOptions{FieldManager: resources.EventListenerFieldManager, Force: true})
+		if err != nil {
+			logging.FromContext(ctx).Errorf("Error applying EventListener Knative Service: %s", err)
+			return err
+		}
+		if existingData.GetResourceVersion() != appliedData.GetResourceVersion() {
+			logging.FromContext(ctx).Infof("Updated EventListener Knative Service %s in Namespace %s", name, el.Namespace)
+		}
+		existing := &servingv1.Service{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(appliedData.Object, existing); err != nil {
+			logging.FromContext(ctx).Errorf("failed to convert Knative Service: %v", err)
+			return err
+		}
+		el.Status.SetExistsCondition(v1beta1.ServiceExists, nil)
+		el.Status.SetExistsCondition(v1beta1.DeploymentExists, nil)
+		el.Status.SetKnativeServiceCondition(existing.Status.GetCondition(apis.ConditionReady))
+		setKnativeServiceAddresses(el, existing)
+
+	case errors.IsNotFound(err):
+		data, err := applyConfiguration(ksvc)
+		if err != nil {
+			logging.FromContext(ctx).Errorf("failed to convert Knative Service: %v", err)
+			return err
+		}
+		if _, err := client.Create(ctx, data, metav1.CreateOptions{FieldManager: resources.EventListenerFieldManager}); err != nil {
+			logging.FromContext(ctx).Errorf("Error creating EventListener Knative Service: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Created EventListener Knative Service %s in Namespace %s", name, el.Namespace)
+		el.Status.SetKnativeServiceCondition(nil)
 
 	default:
 		logging.FromContext(ctx).Error(err)
@@ -370,6 +971,76 @@ func (r *Reconciler) reconcileCustomObject(ctx context.Context, el *v1beta1.Even
 	return nil
 }
 
+// upgradeManagedFields moves the fields the EventListener created or updated,
+// as previous releases did, to its server-side apply field manager, so that
+// applying the generated object removes the fields that aren't generated
+// anymore instead of leaving them owned by an update.
+func upgradeManagedFields(ctx context.Context, client dynamic.ResourceInterface, existing *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing,
+		sets.New(resources.EventListenerFieldManager, controllerFieldManager), resources.EventListenerFieldManager)
+	if err != nil || patch == nil {
+		return existing, err
+	}
+	return client.Patch(ctx, existing.GetName(), types.JSONPatchType, patch, metav1.PatchOptions{})
+}
+
+// applyConfiguration converts a generated object to the object sent to the
+// API server, without its empty status.
+func applyConfiguration(obj interface{}) (*unstructured.Unstructured, error) {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(data, "status")
+	return &unstructured.Unstructured{Object: data}, nil
+}
+
+// cleanUpDeployment deletes the Deployment, the Service and the other
+// resources generated for an EventListener that doesn't run as a Deployment
+// anymore.
+func (r *Reconciler) cleanUpDeployment(ctx context.Context, el *v1beta1.EventListener) error {
+	name := el.Status.Configuration.GeneratedResourceName
+	if existing, err := r.deploymentLister.Deployments(el.Namespace).Get(name); err == nil && metav1.IsControlledBy(existing, el) {
+		if err := r.KubeClientSet.AppsV1().Deployments(el.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener Deployment: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener Deployment %s in Namespace %s", name, el.Namespace)
+	}
+	if existing, err := r.serviceLister.Services(el.Namespace).Get(name); err == nil && metav1.IsControlledBy(existing, el) {
+		if err := r.KubeClientSet.CoreV1().Services(el.Namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			logging.FromContext(ctx).Errorf("Error deleting EventListener Service: %s", err)
+			return err
+		}
+		logging.FromContext(ctx).Infof("Deleted EventListener Service %s in Namespace %s", name, el.Namespace)
+	}
+	if err := el.Status.ClearDeploymentConditions(); err != nil {
+		return err
+	}
+	// The other resources aren't generated for an EventListener run as a
+	// Knative Service, so reconciling them deletes them.
+	err := r.reconcileTLSSecret(ctx, el)
+	err = wrapError(err, r.reconcileHorizontalPodAutoscaler(ctx, el))
+	err = wrapError(err, r.reconcilePodDisruptionBudget(ctx, el))
+	return wrapError(err, r.reconcileExposure(ctx, el))
+}
+
+// setKnativeServiceAddresses reports the cluster-local address of the
+// Knative Service running the EventListener, and its public URL if it has a
+// different one.
+func setKnativeServiceAddresses(el *v1beta1.EventListener, ksvc *servingv1.Service) {
+	if ksvc.Status.Address != nil && ksvc.Status.Address.URL != nil {
+		el.Status.SetAddress(ksvc.Status.Address.URL.Host)
+	} else {
+		el.Status.SetAddress("")
+	}
+	if ksvc.Status.URL != nil && (el.Status.Address.URL == nil || ksvc.Status.URL.Host != el.Status.Address.URL.Host) {
+		el.Status.SetExternalAddress(ksvc.Status.URL)
+	} else {
+		el.Status.SetExternalAddress(nil)
+	}
+}
+
 func (r *Reconciler) removeFinalizer(ctx context.Context, el *v1beta1.EventListener) {
 	// We used to need Finalizers in older versions of Triggers.
 	// They are not necessary anymore so let's remove them from any old EventListener objects
@@ -33,9 +33,14 @@ import (
 	"github.com/tektoncd/triggers/pkg/system"
 	"github.com/tektoncd/triggers/test"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -48,6 +53,9 @@ import (
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/ptr"
 	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 var (
@@ -201,13 +209,16 @@ func makeDeployment(ops ...func(d *appsv1.Deployment)) *appsv1.Deployment {
 						}, {
 							ContainerPort: int32(9000),
 							Protocol:      corev1.ProtocolTCP,
+						}, {
+							ContainerPort: int32(eventListenerAdminPort),
+							Protocol:      corev1.ProtocolTCP,
 						}},
 						LivenessProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
 									Path:   "/live",
 									Scheme: corev1.URISchemeHTTP,
-									Port:   intstr.FromInt(eventListenerContainerPort),
+									Port:   intstr.FromInt(eventListenerAdminPort),
 								},
 							},
 							PeriodSeconds:    int32(resources.DefaultPeriodSeconds),
@@ -216,14 +227,21 @@ func makeDeployment(ops ...func(d *appsv1.Deployment)) *appsv1.Deployment {
 						ReadinessProbe: &corev1.Probe{
 							ProbeHandler: corev1.ProbeHandler{
 								HTTPGet: &corev1.HTTPGetAction{
-									Path:   "/live",
+									Path:   "/ready",
 									Scheme: corev1.URISchemeHTTP,
-									Port:   intstr.FromInt(eventListenerContainerPort),
+									Port:   intstr.FromInt(eventListenerAdminPort),
 								},
 							},
 							PeriodSeconds:    int32(resources.DefaultPeriodSeconds),
 							FailureThreshold: int32(resources.DefaultFailureThreshold),
 						},
+						Lifecycle: &corev1.Lifecycle{
+							PreStop: &corev1.LifecycleHandler{
+								Exec: &corev1.ExecAction{
+									Command: []string{"/ko-app/eventlistenersink", "sleep", "5s"},
+								},
+							},
+						},
 						Args: []string{
 							"--el-name=" + eventListenerName,
 							"--el-namespace=" + namespace,
@@ -240,6 +258,8 @@ func makeDeployment(ops ...func(d *appsv1.Deployment)) *appsv1.Deployment {
 							"--is-multi-ns=false",
 							"--payload-validation=true",
 							"--cloudevent-uri=",
+							"--admin-port=" + strconv.Itoa(eventListenerAdminPort),
+							"--admin-profiling=false",
 							"--tls-cert=",
 							"--tls-key=",
 						},
@@ -320,10 +340,6 @@ var withTLSConfig = func(d *appsv1.Deployment) {
 	// Replace the 2 TLS args with the right values
 	container := &d.Spec.Template.Spec.Containers[0]
 
-	// Set probes to use HTTPS
-	container.LivenessProbe.HTTPGet.Scheme = corev1.URISchemeHTTPS
-	container.ReadinessProbe.HTTPGet.Scheme = corev1.URISchemeHTTPS
-
 	// Pass keys as container args
 	for i, arg := range container.Args {
 		if arg == "--tls-key=" {
@@ -370,6 +386,15 @@ var withTLSConfig = func(d *appsv1.Deployment) {
 	}}
 }
 
+// withGeneratedTLSConfig configures the Deployment to serve the certificate
+// generated for the EventListener.
+var withGeneratedTLSConfig = func(d *appsv1.Deployment) {
+	withTLSConfig(d)
+	container := &d.Spec.Template.Spec.Containers[0]
+	container.Env = container.Env[:len(container.Env)-2]
+	d.Spec.Template.Spec.Volumes[0].Secret.SecretName = generatedResourceName + "-tls"
+}
+
 // makeWithPod is a helper to build a Knative Service that is created by an EventListener.
 // It generates a basic Knative Service for the simplest EventListener and accepts functions for modification
 func makeWithPod(ops ...func(d *duckv1.WithPod)) *duckv1.WithPod {
@@ -517,6 +542,170 @@ func makeService(ops ...func(*corev1.Service)) *corev1.Service {
 	return &s
 }
 
+func makePodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	maxUnavailable := intstr.FromInt32(1)
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector:       &metav1.LabelSelector{MatchLabels: generatedLabels},
+		},
+	}
+}
+
+func makeHorizontalPodAutoscaler(ops ...func(*autoscalingv2.HorizontalPodAutoscaler)) *autoscalingv2.HorizontalPodAutoscaler {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       generatedResourceName,
+			},
+			MinReplicas: ptr.Int32(2),
+			MaxReplicas: 5,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ResourceMetricSourceType,
+				Resource: &autoscalingv2.ResourceMetricSource{
+					Name: corev1.ResourceCPU,
+					Target: autoscalingv2.MetricTarget{
+						Type:               autoscalingv2.UtilizationMetricType,
+						AverageUtilization: ptr.Int32(70),
+					},
+				},
+			}},
+		},
+	}
+	for _, op := range ops {
+		op(hpa)
+	}
+	return hpa
+}
+
+func makeTLSSecret(serverKey, serverCert, caCert []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName + "-tls",
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: serverKey,
+			corev1.TLSCertKey:       serverCert,
+			resources.TLSCACertKey:  caCert,
+		},
+	}
+}
+
+func makeTLSCASecret(caKey, caCert []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName + "-tls-ca",
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			resources.TLSCAKeyKey:  caKey,
+			resources.TLSCACertKey: caCert,
+		},
+	}
+}
+
+func makeIngress() *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Spec: networkingv1.IngressSpec{
+			TLS: []networkingv1.IngressTLS{{
+				Hosts:      []string{"events.example.com"},
+				SecretName: "events-tls",
+			}},
+			Rules: []networkingv1.IngressRule{{
+				Host: "events.example.com",
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: generatedResourceName,
+									Port: networkingv1.ServiceBackendPort{Number: int32(*resources.MakeConfig().Port)},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func makeHTTPRoute() *gatewayv1.HTTPRoute {
+	pathType := gatewayv1.PathMatchPathPrefix
+	port := gatewayv1.PortNumber(*resources.MakeConfig().Port)
+	return &gatewayv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1",
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gateway"}},
+			},
+			Hostnames: []gatewayv1.Hostname{"events.example.com"},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  &pathType,
+						Value: ptr.String("/github"),
+					},
+				}},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: gatewayv1.ObjectName(generatedResourceName),
+							Port: &port,
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+func withExternalAddress(url string) func(*v1beta1.EventListener) {
+	return func(el *v1beta1.EventListener) {
+		u, _ := apis.ParseURL(url)
+		el.Status.SetExternalAddress(u)
+	}
+}
+
 func withTLSPort(el *v1beta1.EventListener) {
 	el.Status.SetAddress(resources.ListenerHostname(el, *resources.MakeConfig(func(c *resources.Config) {
 		x := 8443
@@ -535,6 +724,14 @@ func withKnativeStatus(el *v1beta1.EventListener) {
 		}, {
 			Type:   apis.ConditionReady,
 			Status: corev1.ConditionFalse,
+		}, {
+			Type:    v1beta1.TriggersResolved,
+			Status:  corev1.ConditionTrue,
+			Message: "All Triggers resolved",
+		}, {
+			Type:    v1beta1.CustomObjectSynced,
+			Status:  corev1.ConditionTrue,
+			Message: "Custom object matches the EventListener",
 		}},
 	}
 }
@@ -564,6 +761,10 @@ func withStatus(el *v1beta1.EventListener) {
 				Type:    v1alpha1.ServiceExists,
 				Status:  corev1.ConditionTrue,
 				Message: "Service exists",
+			}, {
+				Type:    v1beta1.TriggersResolved,
+				Status:  corev1.ConditionTrue,
+				Message: "All Triggers resolved",
 			}},
 		},
 		Configuration: v1beta1.EventListenerConfig{
@@ -661,6 +862,51 @@ func TestReconcile(t *testing.T) {
 		}
 	})
 
+	elWithAutoscaling := makeEL(withStatus, func(el *v1beta1.EventListener) {
+		el.Spec.Resources.KubernetesResource = &v1beta1.KubernetesResource{
+			Autoscaling: &v1beta1.EventListenerAutoscaling{
+				MinReplicas:                    ptr.Int32(2),
+				MaxReplicas:                    5,
+				TargetCPUUtilizationPercentage: ptr.Int32(70),
+			},
+		}
+	})
+
+	ingressExposure := func(el *v1beta1.EventListener) {
+		el.Spec.Exposure = &v1beta1.EventListenerExposure{
+			Hostname:      "events.example.com",
+			TLSSecretName: "events-tls",
+		}
+	}
+	elWithIngressExposure := makeEL(withStatus, ingressExposure, withExternalAddress("https://events.example.com"))
+
+	httpRouteExposure := func(el *v1beta1.EventListener) {
+		el.Spec.Exposure = &v1beta1.EventListenerExposure{
+			Type:     v1beta1.HTTPRouteExposure,
+			Hostname: "events.example.com",
+			Path:     "/github",
+			Gateway:  &v1beta1.ExposureGateway{Name: "gateway"},
+		}
+	}
+	elWithHTTPRouteExposure := makeEL(withStatus, httpRouteExposure, withExternalAddress("https://events.example.com/github"))
+	gateway := &gatewayv1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1",
+			Kind:       "Gateway",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: namespace,
+		},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{
+				Name:     "https",
+				Port:     443,
+				Protocol: gatewayv1.HTTPSProtocolType,
+			}},
+		},
+	}
+
 	elWithDeploymentReplicaFailure := makeEL(withStatus, func(el *v1beta1.EventListener) {
 		el.Status.SetCondition(&apis.Condition{
 			Type: apis.ConditionType(appsv1.DeploymentReplicaFailure),
@@ -1071,6 +1317,18 @@ func TestReconcile(t *testing.T) {
 		}}
 	})
 
+	// The image was changed and a volume was added outside of the
+	// EventListener. Only the generated fields are applied again.
+	driftedEnvForCustomResource := envForCustomResource.DeepCopy()
+	driftedEnvForCustomResource.Annotations = map[string]string{"other-manager": "kept"}
+	driftedEnvForCustomResource.Spec.Template.Spec.Containers[0].Image = "drifted"
+	driftedEnvForCustomResource.Spec.Template.Spec.Volumes = []corev1.Volume{{Name: "kept"}}
+	correctedEnvForCustomResource := envForCustomResource.DeepCopy()
+	correctedEnvForCustomResource.Annotations = driftedEnvForCustomResource.Annotations
+	correctedEnvForCustomResource.Spec.Template.Spec.Volumes = driftedEnvForCustomResource.Spec.Template.Spec.Volumes
+	elWithCorrectedCustomResource := elWithCustomResourceForEnv.DeepCopy()
+	elWithCorrectedCustomResource.Status.SetCustomObjectSyncedCondition([]string{"spec.template.spec.containers"}, nil)
+
 	elService := makeService()
 
 	elServiceWithLabels := makeService(func(s *corev1.Service) {
@@ -1099,6 +1357,42 @@ func TestReconcile(t *testing.T) {
 		s.Spec.Ports[0].Port = int32(8443)
 	})
 
+	// Generated certificates are deterministic so that the Secrets storing
+	// them can be compared.
+	caKey, caCert, err := resources.CreateTLSCA(generatedResourceName, namespace, time.Now().Add(resources.TLSCAValidity))
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverKey, serverCert, err := resources.CreateTLSCertificate(generatedResourceName, namespace, caKey, caCert,
+		time.Now().Add(resources.TLSCertificateValidity))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The certificate signed by an existing CA is rotated with the same CA
+	existingCAKey, existingCACert, err := resources.CreateTLSCA(generatedResourceName, namespace, time.Now().Add(resources.TLSCAValidity))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiringKey, expiringCert, err := resources.CreateTLSCertificate(generatedResourceName, namespace, existingCAKey, existingCACert,
+		time.Now().Add(resources.TLSCertificateRenewBefore/2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	createTLSCA = func(string, string, time.Time) ([]byte, []byte, error) {
+		return caKey, caCert, nil
+	}
+	createTLSCertificate = func(string, string, []byte, []byte, time.Time) ([]byte, []byte, error) {
+		return serverKey, serverCert, nil
+	}
+	defer func() {
+		createTLSCA = resources.CreateTLSCA
+		createTLSCertificate = resources.CreateTLSCertificate
+	}()
+
+	elWithGeneratedTLS := makeEL(func(el *v1beta1.EventListener) {
+		el.Spec.TLS = &v1beta1.EventListenerTLS{Generate: true}
+	}, withStatus, withTLSPort)
+
 	elServiceWithPortSet := makeService(func(s *corev1.Service) {
 		s.Spec.Ports[0].Port = int32(customPort)
 	})
@@ -1353,9 +1647,138 @@ func TestReconcile(t *testing.T) {
 			Services:       []*corev1.Service{elService},
 		},
 		endResources: test.Resources{
+			Namespaces:           []*corev1.Namespace{namespaceResource},
+			EventListeners:       []*v1beta1.EventListener{elWithReplicas},
+			Deployments:          []*appsv1.Deployment{deploymentWithUpdatedReplicasNotConsidered},
+			Services:             []*corev1.Service{elService},
+			PodDisruptionBudgets: []*policyv1.PodDisruptionBudget{makePodDisruptionBudget()},
+		},
+	}, {
+		// Checks that the replicas of the deployment are left to the HorizontalPodAutoscaler
+		name: "eventlistener with autoscaling",
+		key:  reconcileKey,
+		startResources: test.Resources{
 			Namespaces:     []*corev1.Namespace{namespaceResource},
-			EventListeners: []*v1beta1.EventListener{elWithReplicas},
-			Deployments:    []*appsv1.Deployment{deploymentWithUpdatedReplicasNotConsidered},
+			EventListeners: []*v1beta1.EventListener{elWithAutoscaling},
+			Deployments:    []*appsv1.Deployment{deploymentWithUpdatedReplicas},
+			Services:       []*corev1.Service{elService},
+		},
+		endResources: test.Resources{
+			Namespaces:               []*corev1.Namespace{namespaceResource},
+			EventListeners:           []*v1beta1.EventListener{elWithAutoscaling},
+			Deployments:              []*appsv1.Deployment{deploymentWithUpdatedReplicas},
+			Services:                 []*corev1.Service{elService},
+			HorizontalPodAutoscalers: []*autoscalingv2.HorizontalPodAutoscaler{makeHorizontalPodAutoscaler()},
+			PodDisruptionBudgets:     []*policyv1.PodDisruptionBudget{makePodDisruptionBudget()},
+		},
+	}, {
+		name: "eventlistener with updated autoscaling",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithAutoscaling},
+			Deployments:    []*appsv1.Deployment{deploymentWithUpdatedReplicas},
+			Services:       []*corev1.Service{elService},
+			HorizontalPodAutoscalers: []*autoscalingv2.HorizontalPodAutoscaler{makeHorizontalPodAutoscaler(func(hpa *autoscalingv2.HorizontalPodAutoscaler) {
+				hpa.Spec.MaxReplicas = 3
+			})},
+			PodDisruptionBudgets: []*policyv1.PodDisruptionBudget{makePodDisruptionBudget()},
+		},
+		endResources: test.Resources{
+			Namespaces:               []*corev1.Namespace{namespaceResource},
+			EventListeners:           []*v1beta1.EventListener{elWithAutoscaling},
+			Deployments:              []*appsv1.Deployment{deploymentWithUpdatedReplicas},
+			Services:                 []*corev1.Service{elService},
+			HorizontalPodAutoscalers: []*autoscalingv2.HorizontalPodAutoscaler{makeHorizontalPodAutoscaler()},
+			PodDisruptionBudgets:     []*policyv1.PodDisruptionBudget{makePodDisruptionBudget()},
+		},
+	}, {
+		name: "eventlistener with autoscaling removed",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:               []*corev1.Namespace{namespaceResource},
+			EventListeners:           []*v1beta1.EventListener{elWithStatus},
+			Deployments:              []*appsv1.Deployment{elDeployment},
+			Services:                 []*corev1.Service{elService},
+			HorizontalPodAutoscalers: []*autoscalingv2.HorizontalPodAutoscaler{makeHorizontalPodAutoscaler()},
+			PodDisruptionBudgets:     []*policyv1.PodDisruptionBudget{makePodDisruptionBudget()},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithStatus},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+		},
+	}, {
+		name: "eventlistener exposed through an Ingress",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, ingressExposure)},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithIngressExposure},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+			Ingresses:      []*networkingv1.Ingress{makeIngress()},
+		},
+	}, {
+		name: "eventlistener with updated Ingress",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithIngressExposure},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+			Ingresses: []*networkingv1.Ingress{func() *networkingv1.Ingress {
+				i := makeIngress()
+				i.Spec.TLS = nil
+				return i
+			}()},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithIngressExposure},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+			Ingresses:      []*networkingv1.Ingress{makeIngress()},
+		},
+	}, {
+		// Checks that the scheme of the external address follows the listener of the Gateway
+		name: "eventlistener exposed through an HTTPRoute",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, httpRouteExposure)},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+			Gateways:       []*gatewayv1.Gateway{gateway},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithHTTPRouteExposure},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+			HTTPRoutes:     []*gatewayv1.HTTPRoute{makeHTTPRoute()},
+		},
+	}, {
+		name: "eventlistener with exposure removed",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withExternalAddress("https://events.example.com/github"))},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+			Ingresses:      []*networkingv1.Ingress{makeIngress()},
+			HTTPRoutes:     []*gatewayv1.HTTPRoute{makeHTTPRoute()},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithStatus},
+			Deployments:    []*appsv1.Deployment{elDeployment},
 			Services:       []*corev1.Service{elService},
 		},
 	}, {
@@ -1397,6 +1820,70 @@ func TestReconcile(t *testing.T) {
 			Deployments:    []*appsv1.Deployment{deploymentWithTLSConnection},
 			Services:       []*corev1.Service{elServiceWithTLSConnection},
 		},
+	}, {
+		name: "eventlistener with generated TLS certificate",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithGeneratedTLS},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithGeneratedTLS},
+			Deployments:    []*appsv1.Deployment{makeDeployment(withGeneratedTLSConfig)},
+			Services:       []*corev1.Service{elServiceWithTLSConnection},
+			Secrets:        []*corev1.Secret{makeTLSSecret(serverKey, serverCert, caCert), makeTLSCASecret(caKey, caCert)},
+		},
+	}, {
+		name: "eventlistener with expiring generated TLS certificate",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithGeneratedTLS},
+			Deployments:    []*appsv1.Deployment{makeDeployment(withGeneratedTLSConfig)},
+			Services:       []*corev1.Service{elServiceWithTLSConnection},
+			Secrets:        []*corev1.Secret{makeTLSSecret(expiringKey, expiringCert, existingCACert), makeTLSCASecret(existingCAKey, existingCACert)},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithGeneratedTLS},
+			Deployments:    []*appsv1.Deployment{makeDeployment(withGeneratedTLSConfig)},
+			Services:       []*corev1.Service{elServiceWithTLSConnection},
+			Secrets:        []*corev1.Secret{makeTLSSecret(serverKey, serverCert, existingCACert), makeTLSCASecret(existingCAKey, existingCACert)},
+		},
+	}, {
+		name: "eventlistener with generated TLS certificate signed by another CA",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithGeneratedTLS},
+			Deployments:    []*appsv1.Deployment{makeDeployment(withGeneratedTLSConfig)},
+			Services:       []*corev1.Service{elServiceWithTLSConnection},
+			Secrets:        []*corev1.Secret{makeTLSSecret(serverKey, serverCert, existingCACert)},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithGeneratedTLS},
+			Deployments:    []*appsv1.Deployment{makeDeployment(withGeneratedTLSConfig)},
+			Services:       []*corev1.Service{elServiceWithTLSConnection},
+			Secrets:        []*corev1.Secret{makeTLSSecret(serverKey, serverCert, caCert), makeTLSCASecret(caKey, caCert)},
+		},
+	}, {
+		name: "eventlistener with generated TLS certificate removed",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withTLSPort)},
+			Deployments:    []*appsv1.Deployment{makeDeployment(withGeneratedTLSConfig)},
+			Services:       []*corev1.Service{elServiceWithTLSConnection},
+			Secrets:        []*corev1.Secret{makeTLSSecret(serverKey, serverCert, caCert), makeTLSCASecret(caKey, caCert)},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithStatus},
+			Deployments:    []*appsv1.Deployment{elDeployment},
+			Services:       []*corev1.Service{elService},
+		},
 	}, {
 		name:   "eventlistener with security context",
 		key:    reconcileKey,
@@ -1485,6 +1972,19 @@ func TestReconcile(t *testing.T) {
 			EventListeners: []*v1beta1.EventListener{elWithCustomResourceForEnv},
 			WithPod:        []*duckv1.WithPod{envForCustomResource},
 		},
+	}, {
+		name: "eventlistener with drifted custom resource",
+		key:  reconcileKey,
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithCustomResourceForEnv},
+			WithPod:        []*duckv1.WithPod{driftedEnvForCustomResource},
+		},
+		endResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{elWithCorrectedCustomResource},
+			WithPod:        []*duckv1.WithPod{correctedEnvForCustomResource},
+		},
 	}, {
 		name: "eventlistener with added NodeSelector for custom resource",
 		key:  reconcileKey,
@@ -1819,6 +2319,396 @@ func TestReconcile_Delete(t *testing.T) {
 	}
 }
 
+func withKnativeService(el *v1beta1.EventListener) {
+	el.Spec.Resources.KnativeService = &v1beta1.KnativeServiceResource{
+		MaxScale:             ptr.Int32(5),
+		ContainerConcurrency: ptr.Int64(10),
+	}
+}
+
+func makeKnativeService(ops ...func(*servingv1.Service)) *servingv1.Service {
+	ksvc := &servingv1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: servingv1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            generatedResourceName,
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			Labels:          generatedLabels,
+		},
+		Spec: servingv1.ServiceSpec{
+			ConfigurationSpec: servingv1.ConfigurationSpec{
+				Template: servingv1.RevisionTemplateSpec{
+					Spec: servingv1.RevisionSpec{
+						ContainerConcurrency: ptr.Int64(1),
+					},
+				},
+			},
+		},
+	}
+	for _, op := range ops {
+		op(ksvc)
+	}
+	return ksvc
+}
+
+func TestReconcile_KnativeService(t *testing.T) {
+	t.Setenv("METRICS_PROMETHEUS_PORT", "9000")
+	t.Setenv("SYSTEM_NAMESPACE", "tekton-pipelines")
+	t.Setenv("KUBERNETES_MIN_VERSION", "v1.28.0")
+
+	clusterLocal := fmt.Sprintf("%s.%s.svc.cluster.local", generatedResourceName, namespace)
+	external := &apis.URL{Scheme: "https", Host: fmt.Sprintf("%s.%s.example.com", generatedResourceName, namespace)}
+	withKnativeServiceCondition := func(el *v1beta1.EventListener) {
+		el.Status.SetKnativeServiceCondition(nil)
+	}
+	readyKnativeService := func(ksvc *servingv1.Service) {
+		ksvc.Status.URL = external
+		ksvc.Status.Address = &duckv1.Addressable{URL: &apis.URL{Scheme: "http", Host: clusterLocal}}
+		ksvc.Status.SetConditions(apis.Conditions{{
+			Type:   apis.ConditionReady,
+			Status: corev1.ConditionTrue,
+		}})
+	}
+
+	tests := []struct {
+		name                     string
+		startResources           test.Resources
+		knativeService           *servingv1.Service
+		wantContainerConcurrency int64
+		wantMinScale             string
+		wantAnnotations          map[string]string
+		wantDeleted              bool
+		check                    func(*testing.T, *v1beta1.EventListener)
+	}{{
+		name: "switch from a Deployment",
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withKnativeService)},
+			Deployments:    []*appsv1.Deployment{makeDeployment()},
+			Services:       []*corev1.Service{makeService()},
+		},
+		wantContainerConcurrency: 10,
+		wantMinScale:             "0",
+		check: func(t *testing.T, el *v1beta1.EventListener) {
+			if c := el.Status.GetCondition(v1beta1.KnativeServiceReady); c == nil || c.Status != corev1.ConditionUnknown {
+				t.Errorf("KnativeServiceReady condition = %v, want Unknown", c)
+			}
+			if c := el.Status.GetCondition(apis.ConditionType(appsv1.DeploymentAvailable)); c != nil {
+				t.Errorf("DeploymentAvailable condition = %v, want none", c)
+			}
+			if c := el.Status.GetCondition(apis.ConditionReady); c == nil || c.Status != corev1.ConditionFalse {
+				t.Errorf("Ready condition = %v, want False", c)
+			}
+		},
+	}, {
+		name: "update drifted Knative Service",
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withKnativeService, withKnativeServiceCondition)},
+		},
+		knativeService:           makeKnativeService(readyKnativeService),
+		wantContainerConcurrency: 10,
+		wantMinScale:             "0",
+		check: func(t *testing.T, el *v1beta1.EventListener) {
+			for _, ct := range []apis.ConditionType{v1beta1.KnativeServiceReady, apis.ConditionReady} {
+				if c := el.Status.GetCondition(ct); c == nil || c.Status != corev1.ConditionTrue {
+					t.Errorf("%s condition = %v, want True", ct, c)
+				}
+			}
+			if el.Status.Address == nil || el.Status.Address.URL == nil || el.Status.Address.URL.Host != clusterLocal {
+				t.Errorf("Address = %v, want %s", el.Status.Address, clusterLocal)
+			}
+			if len(el.Status.Addresses) != 2 || el.Status.Addresses[1].URL.String() != external.String() {
+				t.Errorf("Addresses = %v, want the external URL %s", el.Status.Addresses, external)
+			}
+		},
+	}, {
+		name: "keep fields added outside of the EventListener",
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withKnativeService, withKnativeServiceCondition)},
+		},
+		knativeService: makeKnativeService(readyKnativeService, func(ksvc *servingv1.Service) {
+			ksvc.Spec.Template.Annotations = map[string]string{"example.com/team": "a"}
+		}),
+		wantContainerConcurrency: 10,
+		wantMinScale:             "0",
+		wantAnnotations:          map[string]string{"example.com/team": "a"},
+		check:                    func(*testing.T, *v1beta1.EventListener) {},
+	}, {
+		name: "keep a replica for scheduled Triggers",
+		startResources: test.Resources{
+			Namespaces: []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withKnativeService, withKnativeServiceCondition, func(el *v1beta1.EventListener) {
+				el.Spec.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nightly"}}
+			})},
+			Triggers: []*v1beta1.Trigger{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "nightly",
+					Namespace: namespace,
+					Labels:    map[string]string{"app": "nightly"},
+				},
+				Spec: v1beta1.TriggerSpec{
+					Schedule: &v1beta1.TriggerSchedule{Cron: "@daily"},
+					Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+				},
+			}},
+		},
+		knativeService:           makeKnativeService(readyKnativeService),
+		wantContainerConcurrency: 10,
+		wantMinScale:             "1",
+		check:                    func(*testing.T, *v1beta1.EventListener) {},
+	}, {
+		name: "switch back to a Deployment",
+		startResources: test.Resources{
+			Namespaces:     []*corev1.Namespace{namespaceResource},
+			EventListeners: []*v1beta1.EventListener{makeEL(withStatus, withKnativeServiceCondition)},
+		},
+		knativeService: makeKnativeService(readyKnativeService),
+		wantDeleted:    true,
+		check: func(t *testing.T, el *v1beta1.EventListener) {
+			if c := el.Status.GetCondition(v1beta1.KnativeServiceReady); c != nil {
+				t.Errorf("KnativeServiceReady condition = %v, want none", c)
+			}
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testAssets, cancel := getEventListenerTestAssets(t, tt.startResources, nil)
+			defer cancel()
+			client := testAssets.Clients.DynamicClient.Resource(resources.KnativeServiceGVR).Namespace(namespace)
+			if tt.knativeService != nil {
+				data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tt.knativeService)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := client.Create(context.Background(), &unstructured.Unstructured{Object: data}, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := testAssets.Controller.Reconciler.Reconcile(context.Background(), reconcileKey); err != nil {
+				t.Fatalf("eventlistener.Reconcile() returned error: %s", err)
+			}
+
+			data, err := client.Get(context.Background(), generatedResourceName, metav1.GetOptions{})
+			if tt.wantDeleted {
+				if !errors.IsNotFound(err) {
+					t.Errorf("Knative Service wasn't deleted: %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				ksvc := &servingv1.Service{}
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(data.Object, ksvc); err != nil {
+					t.Fatal(err)
+				}
+				spec := ksvc.Spec.Template.Spec
+				if got := ptr.Int64Value(spec.ContainerConcurrency); got != tt.wantContainerConcurrency {
+					t.Errorf("containerConcurrency = %d, want %d", got, tt.wantContainerConcurrency)
+				}
+				if got := ptr.Int64Value(spec.TimeoutSeconds); got != resources.DefaultTimeOutHandler {
+					t.Errorf("timeoutSeconds = %d, want %d", got, resources.DefaultTimeOutHandler)
+				}
+				if got := ksvc.Spec.Template.Annotations[autoscaling.MinScaleAnnotationKey]; got != tt.wantMinScale {
+					t.Errorf("%s = %q, want %q", autoscaling.MinScaleAnnotationKey, got, tt.wantMinScale)
+				}
+				if got := ksvc.Spec.Template.Annotations[autoscaling.MaxScaleAnnotationKey]; got != "5" {
+					t.Errorf("%s = %q, want %q", autoscaling.MaxScaleAnnotationKey, got, "5")
+				}
+				for k, v := range tt.wantAnnotations {
+					if got := ksvc.Spec.Template.Annotations[k]; got != v {
+						t.Errorf("%s = %q, want %q", k, got, v)
+					}
+				}
+
+				deployments, err := testAssets.Clients.Kube.AppsV1().Deployments(namespace).List(context.Background(), metav1.ListOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				services, err := testAssets.Clients.Kube.CoreV1().Services(namespace).List(context.Background(), metav1.ListOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(deployments.Items) != 0 || len(services.Items) != 0 {
+					t.Errorf("got %d Deployments and %d Services, want none", len(deployments.Items), len(services.Items))
+				}
+			}
+
+			el, err := testAssets.Clients.Triggers.TriggersV1beta1().EventListeners(namespace).Get(context.Background(), eventListenerName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, el)
+		})
+	}
+}
+
+func TestReconcile_UpgradeManagedFields(t *testing.T) {
+	t.Setenv("METRICS_PROMETHEUS_PORT", "9000")
+	t.Setenv("SYSTEM_NAMESPACE", "tekton-pipelines")
+	t.Setenv("KUBERNETES_MIN_VERSION", "v1.28.0")
+
+	// The fields owned by the updates of previous releases, which didn't set
+	// a field manager.
+	updated := []metav1.ManagedFieldsEntry{{
+		Manager:    controllerFieldManager,
+		Operation:  metav1.ManagedFieldsOperationUpdate,
+		APIVersion: servingv1.SchemeGroupVersion.String(),
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:template":{"f:spec":{"f:containerConcurrency":{}}}}}`)},
+	}}
+
+	tests := []struct {
+		name           string
+		el             *v1beta1.EventListener
+		withPod        *duckv1.WithPod
+		knativeService *servingv1.Service
+	}{{
+		name: "Knative Service",
+		el: makeEL(withStatus, withKnativeService, func(el *v1beta1.EventListener) {
+			el.Status.SetKnativeServiceCondition(nil)
+		}),
+		knativeService: makeKnativeService(func(ksvc *servingv1.Service) {
+			ksvc.ManagedFields = updated
+		}),
+	}, {
+		name: "custom object",
+		el: makeEL(withStatus, withKnativeStatus, func(el *v1beta1.EventListener) {
+			el.Spec.Resources.CustomResource = &v1beta1.CustomResource{
+				RawExtension: test.RawExtension(t, duckv1.WithPod{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "Service",
+						APIVersion: "serving.knative.dev/v1",
+					},
+					ObjectMeta: metav1.ObjectMeta{Name: generatedResourceName},
+				}),
+			}
+		}),
+		withPod: makeWithPod(func(d *duckv1.WithPod) {
+			d.ManagedFields = updated
+		}),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startResources := test.Resources{
+				Namespaces:     []*corev1.Namespace{namespaceResource},
+				EventListeners: []*v1beta1.EventListener{tt.el},
+			}
+			if tt.withPod != nil {
+				startResources.WithPod = []*duckv1.WithPod{tt.withPod}
+			}
+			testAssets, cancel := getEventListenerTestAssets(t, startResources, nil)
+			defer cancel()
+			client := testAssets.Clients.DynamicClient.Resource(resources.KnativeServiceGVR).Namespace(namespace)
+			if tt.knativeService != nil {
+				data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tt.knativeService)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := client.Create(context.Background(), &unstructured.Unstructured{Object: data}, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := testAssets.Controller.Reconciler.Reconcile(context.Background(), reconcileKey); err != nil {
+				t.Fatalf("eventlistener.Reconcile() returned error: %s", err)
+			}
+
+			data, err := client.Get(context.Background(), generatedResourceName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := []metav1.ManagedFieldsEntry{{
+				Manager:    resources.EventListenerFieldManager,
+				Operation:  metav1.ManagedFieldsOperationApply,
+				APIVersion: servingv1.SchemeGroupVersion.String(),
+				FieldsType: "FieldsV1",
+				FieldsV1:   updated[0].FieldsV1,
+			}}
+			if diff := cmp.Diff(want, data.GetManagedFields()); diff != "" {
+				t.Errorf("managed fields -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile_HTTPRouteApplied(t *testing.T) {
+	t.Setenv("METRICS_PROMETHEUS_PORT", "9000")
+	t.Setenv("SYSTEM_NAMESPACE", "tekton-pipelines")
+	t.Setenv("KUBERNETES_MIN_VERSION", "v1.28.0")
+
+	el := makeEL(withStatus, withExternalAddress("https://events.example.com/github"), func(el *v1beta1.EventListener) {
+		el.Spec.Exposure = &v1beta1.EventListenerExposure{
+			Type:     v1beta1.HTTPRouteExposure,
+			Hostname: "events.example.com",
+			Path:     "/github",
+			Gateway:  &v1beta1.ExposureGateway{Name: "gateway"},
+		}
+	})
+	// An annotation of another field manager, and a generated field changed
+	// outside of the EventListener
+	route := makeHTTPRoute()
+	route.Annotations = map[string]string{"example.com/owner": "team-a"}
+	route.Spec.Hostnames = []gatewayv1.Hostname{"changed.example.com"}
+	testAssets, cancel := getEventListenerTestAssets(t, test.Resources{
+		Namespaces:     []*corev1.Namespace{namespaceResource},
+		EventListeners: []*v1beta1.EventListener{el},
+		Deployments:    []*appsv1.Deployment{makeDeployment()},
+		Services:       []*corev1.Service{makeService()},
+		HTTPRoutes:     []*gatewayv1.HTTPRoute{route},
+	}, nil)
+	defer cancel()
+
+	if err := testAssets.Controller.Reconciler.Reconcile(context.Background(), reconcileKey); err != nil {
+		t.Fatalf("eventlistener.Reconcile() returned error: %s", err)
+	}
+	applied := false
+	for _, a := range testAssets.Clients.DynamicClient.Actions() {
+		if a.GetResource().Resource != "httproutes" {
+			continue
+		}
+		switch a := a.(type) {
+		case k8stest.UpdateAction:
+			t.Errorf("updated the HTTPRoute instead of applying it")
+		case k8stest.PatchActionImpl:
+			if a.GetPatchType() != types.ApplyPatchType {
+				continue
+			}
+			applied = true
+			if a.PatchOptions.FieldManager != resources.EventListenerFieldManager {
+				t.Errorf("applied the HTTPRoute with field manager %q, want %q", a.PatchOptions.FieldManager, resources.EventListenerFieldManager)
+			}
+		}
+	}
+	if !applied {
+		t.Error("didn't apply the HTTPRoute")
+	}
+
+	data, err := testAssets.Clients.DynamicClient.Resource(httpRouteGVR).Namespace(namespace).Get(context.Background(), generatedResourceName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &gatewayv1.HTTPRoute{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(data.Object, got); err != nil {
+		t.Fatal(err)
+	}
+	want := makeHTTPRoute()
+	want.Annotations = route.Annotations
+	if diff := cmp.Diff(want.Annotations, got.Annotations); diff != "" {
+		t.Errorf("HTTPRoute annotations -want +got: %s", diff)
+	}
+	if diff := cmp.Diff(want.Spec, got.Spec); diff != "" {
+		t.Errorf("HTTPRoute spec -want +got: %s", diff)
+	}
+}
+
 func Test_wrapError(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/ptr"
+)
+
+// MakeHorizontalPodAutoscaler returns the HorizontalPodAutoscaler scaling
+// the EventListener Deployment, or nil if the EventListener doesn't
+// configure autoscaling.
+func MakeHorizontalPodAutoscaler(ctx context.Context, el *v1beta1.EventListener, c Config) *autoscalingv2.HorizontalPodAutoscaler {
+	if el.Spec.Resources.KubernetesResource == nil || el.Spec.Resources.KubernetesResource.Autoscaling == nil {
+		return nil
+	}
+	autoscaling := el.Spec.Resources.KubernetesResource.Autoscaling
+
+	var metrics []autoscalingv2.MetricSpec
+	targetCPU := autoscaling.TargetCPUUtilizationPercentage
+	if targetCPU == nil && autoscaling.Metric == nil {
+		targetCPU = ptr.Int32(80)
+	}
+	if targetCPU != nil {
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: targetCPU,
+				},
+			},
+		})
+	}
+	if m := autoscaling.Metric; m != nil {
+		target := m.TargetAverageValue.DeepCopy()
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: m.MetricName()},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &target,
+				},
+			},
+		})
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: ObjectMeta(el, FilterLabels(ctx, el.Labels), c.StaticResourceLabels),
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       el.Status.Configuration.GeneratedResourceName,
+			},
+			MinReplicas: autoscaling.MinReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+// MakePodDisruptionBudget returns the PodDisruptionBudget keeping the
+// EventListener available while its nodes are drained, or nil if the
+// EventListener runs a single replica.
+func MakePodDisruptionBudget(ctx context.Context, el *v1beta1.EventListener, c Config) *policyv1.PodDisruptionBudget {
+	if minReplicas(el) <= 1 {
+		return nil
+	}
+	maxUnavailable := intstr.FromInt32(1)
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: ObjectMeta(el, FilterLabels(ctx, el.Labels), c.StaticResourceLabels),
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: GenerateLabels(el.Name, c.StaticResourceLabels),
+			},
+		},
+	}
+}
+
+// minReplicas returns the least number of replicas the EventListener
+// Deployment runs.
+func minReplicas(el *v1beta1.EventListener) int32 {
+	kr := el.Spec.Resources.KubernetesResource
+	switch {
+	case kr == nil:
+		return 1
+	case kr.Autoscaling != nil && kr.Autoscaling.MinReplicas != nil:
+		return *kr.Autoscaling.MinReplicas
+	case kr.Autoscaling != nil:
+		return 1
+	case kr.Replicas != nil:
+		return *kr.Replicas
+	default:
+		return 1
+	}
+}
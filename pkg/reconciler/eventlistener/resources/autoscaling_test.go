@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
+)
+
+func withAutoscaling(autoscaling *v1beta1.EventListenerAutoscaling) func(*v1beta1.EventListener) {
+	return func(el *v1beta1.EventListener) {
+		el.Spec.Resources.KubernetesResource = &v1beta1.KubernetesResource{Autoscaling: autoscaling}
+	}
+}
+
+func TestHorizontalPodAutoscaler(t *testing.T) {
+	config := *MakeConfig()
+	objectMeta := metav1.ObjectMeta{
+		Name:      generatedResourceName,
+		Namespace: namespace,
+		Labels: map[string]string{
+			"app.kubernetes.io/managed-by": "EventListener",
+			"app.kubernetes.io/part-of":    "Triggers",
+			"eventlistener":                eventListenerName,
+		},
+		OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+	}
+	scaleTargetRef := autoscalingv2.CrossVersionObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       generatedResourceName,
+	}
+	cpu := func(utilization int32) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptr.Int32(utilization),
+				},
+			},
+		}
+	}
+	pods := func(name, value string) autoscalingv2.MetricSpec {
+		q := resource.MustParse(value)
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: name},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &q,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		el   *v1beta1.EventListener
+		want *autoscalingv2.HorizontalPodAutoscaler
+	}{{
+		name: "no autoscaling",
+		el: makeEL(withStatus, func(el *v1beta1.EventListener) {
+			el.Spec.Resources.KubernetesResource = &v1beta1.KubernetesResource{Replicas: ptr.Int32(3)}
+		}),
+	}, {
+		name: "CPU utilization defaults to 80%",
+		el:   makeEL(withStatus, withAutoscaling(&v1beta1.EventListenerAutoscaling{MaxReplicas: 5})),
+		want: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: objectMeta,
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: scaleTargetRef,
+				MaxReplicas:    5,
+				Metrics:        []autoscalingv2.MetricSpec{cpu(80)},
+			},
+		},
+	}, {
+		name: "in flight events",
+		el: makeEL(withStatus, withAutoscaling(&v1beta1.EventListenerAutoscaling{
+			MinReplicas: ptr.Int32(2),
+			MaxReplicas: 10,
+			Metric: &v1beta1.EventListenerAutoscalingMetric{
+				Type:               v1beta1.InFlightEventsMetric,
+				TargetAverageValue: resource.MustParse("20"),
+			},
+		})),
+		want: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: objectMeta,
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: scaleTargetRef,
+				MinReplicas:    ptr.Int32(2),
+				MaxReplicas:    10,
+				Metrics:        []autoscalingv2.MetricSpec{pods("eventlistener_events_in_flight", "20")},
+			},
+		},
+	}, {
+		name: "CPU utilization and events received rate",
+		el: makeEL(withStatus, withAutoscaling(&v1beta1.EventListenerAutoscaling{
+			MaxReplicas:                    10,
+			TargetCPUUtilizationPercentage: ptr.Int32(60),
+			Metric: &v1beta1.EventListenerAutoscalingMetric{
+				Type:               v1beta1.EventsReceivedRateMetric,
+				TargetAverageValue: resource.MustParse("500m"),
+			},
+		})),
+		want: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: objectMeta,
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: scaleTargetRef,
+				MaxReplicas:    10,
+				Metrics:        []autoscalingv2.MetricSpec{cpu(60), pods("eventlistener_event_received_rate", "500m")},
+			},
+		},
+	}, {
+		name: "custom metric name",
+		el: makeEL(withStatus, withAutoscaling(&v1beta1.EventListenerAutoscaling{
+			MaxReplicas: 10,
+			Metric: &v1beta1.EventListenerAutoscalingMetric{
+				Type:               v1beta1.EventsReceivedRateMetric,
+				Name:               "events_per_second",
+				TargetAverageValue: resource.MustParse("2"),
+			},
+		})),
+		want: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: objectMeta,
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: scaleTargetRef,
+				MaxReplicas:    10,
+				Metrics:        []autoscalingv2.MetricSpec{pods("events_per_second", "2")},
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeHorizontalPodAutoscaler(context.Background(), tt.el, config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MakeHorizontalPodAutoscaler() did not return expected. -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPodDisruptionBudget(t *testing.T) {
+	config := *MakeConfig()
+	maxUnavailable := intstr.FromInt32(1)
+	want := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generatedResourceName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "EventListener",
+				"app.kubernetes.io/part-of":    "Triggers",
+				"eventlistener":                eventListenerName,
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &maxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app.kubernetes.io/managed-by": "EventListener",
+					"app.kubernetes.io/part-of":    "Triggers",
+					"eventlistener":                eventListenerName,
+				},
+			},
+		},
+	}
+	withReplicas := func(replicas int32) func(*v1beta1.EventListener) {
+		return func(el *v1beta1.EventListener) {
+			el.Spec.Resources.KubernetesResource = &v1beta1.KubernetesResource{Replicas: ptr.Int32(replicas)}
+		}
+	}
+
+	tests := []struct {
+		name string
+		el   *v1beta1.EventListener
+		want *policyv1.PodDisruptionBudget
+	}{{
+		name: "default replicas",
+		el:   makeEL(withStatus),
+	}, {
+		name: "single replica",
+		el:   makeEL(withStatus, withReplicas(1)),
+	}, {
+		name: "multiple replicas",
+		el:   makeEL(withStatus, withReplicas(3)),
+		want: want,
+	}, {
+		name: "autoscaling from a single replica",
+		el:   makeEL(withStatus, withAutoscaling(&v1beta1.EventListenerAutoscaling{MaxReplicas: 5})),
+	}, {
+		name: "autoscaling from multiple replicas",
+		el:   makeEL(withStatus, withAutoscaling(&v1beta1.EventListenerAutoscaling{MinReplicas: ptr.Int32(2), MaxReplicas: 5})),
+		want: want,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakePodDisruptionBudget(context.Background(), tt.el, config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MakePodDisruptionBudget() did not return expected. -want, +got: %s", diff)
+			}
+		})
+	}
+}
@@ -39,6 +39,8 @@ var (
 	DefaultPeriodSeconds = 10
 	// DefaultFailureThreshold is the FailureThreshold used by default.
 	DefaultFailureThreshold = 3
+	// DefaultEnableProfiling is the EnableProfiling value used by default.
+	DefaultEnableProfiling = false
 	// DefaultHTTPClientReadTimeOut is the HTTPClient ReadTimeOut used by default.
 	DefaultHTTPClientReadTimeOut = int64(30)
 	// DefaultHTTPClientKeepAlive is the HTTPClient KeepAlive used by default
@@ -91,6 +93,8 @@ type Config struct {
 	PeriodSeconds *int
 	// FailureThreshold defines the Failure Threshold for the EventListener Liveness and Readiness Probes.
 	FailureThreshold *int
+	// EnableProfiling defines whether the EventListener serves pprof profiles on its admin port.
+	EnableProfiling *bool
 	// StaticResourceLabels is a map with all the labels that should be on all resources generated by the EventListener.
 	StaticResourceLabels map[string]string
 	// SystemNamespace is the namespace where the reconciler is deployed.
@@ -119,6 +123,7 @@ func MakeConfig(ops ...ConfigOption) *Config {
 		HTTPClientExpectContinueTimeout: &DefaultHTTPClientExpectContinueTimeout,
 		PeriodSeconds:                   &DefaultPeriodSeconds,
 		FailureThreshold:                &DefaultFailureThreshold,
+		EnableProfiling:                 &DefaultEnableProfiling,
 
 		StaticResourceLabels: DefaultStaticResourceLabels,
 		SystemNamespace:      DefaultSystemNamespace,
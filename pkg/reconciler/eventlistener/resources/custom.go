@@ -20,12 +20,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/tektoncd/triggers/pkg/apis/config"
 	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
@@ -119,105 +123,48 @@ func MakeCustomObject(ctx context.Context, el *v1beta1.EventListener, configAcc
 	return data, nil
 }
 
-func UpdateCustomObject(originalData, updatedCustomObject *unstructured.Unstructured) (bool, *duckv1.WithPod, error) {
-	updated := false
-	originalObject := &duckv1.WithPod{}
-	existingObject := &duckv1.WithPod{}
-	data, e := originalData.MarshalJSON()
-	if e != nil {
-		return false, nil, e
-	}
-	if e := json.Unmarshal(data, &originalObject); e != nil {
-		return false, nil, e
-	}
-	updatedData, e := updatedCustomObject.MarshalJSON()
-	if e != nil {
-		return false, nil, e
-	}
-	if e := json.Unmarshal(updatedData, &existingObject); e != nil {
-		return false, nil, e
-	}
+// CustomObjectDrift returns the paths of the fields of the generated custom
+// object that were changed outside of the EventListener. The fields of the
+// existing object that aren't generated, such as the ones set by other field
+// managers, aren't drift.
+func CustomObjectDrift(desired, existing *unstructured.Unstructured) []string {
+	var drifted []string
+	for _, field := range []string{"labels", "annotations", "ownerReferences"} {
+		desiredField, _, _ := unstructured.NestedFieldNoCopy(desired.Object, "metadata", field)
+		existingField, _, _ := unstructured.NestedFieldNoCopy(existing.Object, "metadata", field)
+		drifted = append(drifted, driftedFields("metadata."+field, desiredField, existingField)...)
+	}
+	return append(drifted, driftedFields("spec", desired.Object["spec"], existing.Object["spec"])...)
+}
 
-	// custom resource except few spec fields from user
-	// added below checks in order to avoid unwanted updates on all spec changes.
-	if !reflect.DeepEqual(existingObject.Spec.Template.Name, originalObject.Spec.Template.Name) {
-		existingObject.Spec.Template.Name = originalObject.Spec.Template.Name
-		updated = true
-	}
-	if !reflect.DeepEqual(existingObject.Spec.Template.Labels, originalObject.Spec.Template.Labels) {
-		existingObject.Spec.Template.Labels = originalObject.Spec.Template.Labels
-		updated = true
-	}
-	if !reflect.DeepEqual(existingObject.Spec.Template.Annotations, originalObject.Spec.Template.Annotations) {
-		existingObject.Spec.Template.Annotations = originalObject.Spec.Template.Annotations
-		updated = true
-	}
-	if existingObject.Spec.Template.Spec.ServiceAccountName != originalObject.Spec.Template.Spec.ServiceAccountName {
-		existingObject.Spec.Template.Spec.ServiceAccountName = originalObject.Spec.Template.Spec.ServiceAccountName
-		updated = true
-	}
-	if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Tolerations, originalObject.Spec.Template.Spec.Tolerations) {
-		existingObject.Spec.Template.Spec.Tolerations = originalObject.Spec.Template.Spec.Tolerations
-		updated = true
-	}
-	if !reflect.DeepEqual(existingObject.Spec.Template.Spec.NodeSelector, originalObject.Spec.Template.Spec.NodeSelector) {
-		existingObject.Spec.Template.Spec.NodeSelector = originalObject.Spec.Template.Spec.NodeSelector
-		updated = true
-	}
-	if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Affinity, originalObject.Spec.Template.Spec.Affinity) {
-		existingObject.Spec.Template.Spec.Affinity = originalObject.Spec.Template.Spec.Affinity
-		updated = true
-	}
-	if !reflect.DeepEqual(existingObject.Spec.Template.Spec.TopologySpreadConstraints, originalObject.Spec.Template.Spec.TopologySpreadConstraints) {
-		existingObject.Spec.Template.Spec.TopologySpreadConstraints = originalObject.Spec.Template.Spec.TopologySpreadConstraints
-		updated = true
+func driftedFields(path string, desired, existing interface{}) []string {
+	if desired == nil {
+		return nil
 	}
-	if len(existingObject.Spec.Template.Spec.Containers) == 0 ||
-		len(existingObject.Spec.Template.Spec.Containers) > 1 {
-		existingObject.Spec.Template.Spec.Containers = originalObject.Spec.Template.Spec.Containers
-		updated = true
-	} else {
-		if existingObject.Spec.Template.Spec.Containers[0].Name != originalObject.Spec.Template.Spec.Containers[0].Name {
-			existingObject.Spec.Template.Spec.Containers[0].Name = originalObject.Spec.Template.Spec.Containers[0].Name
-			updated = true
+	desiredMap, ok := desired.(map[string]interface{})
+	if !ok {
+		if existing == nil {
+			existing = reflect.Zero(reflect.TypeOf(desired)).Interface()
 		}
-		if existingObject.Spec.Template.Spec.Containers[0].Image != originalObject.Spec.Template.Spec.Containers[0].Image {
-			existingObject.Spec.Template.Spec.Containers[0].Image = originalObject.Spec.Template.Spec.Containers[0].Image
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Containers[0].Ports, originalObject.Spec.Template.Spec.Containers[0].Ports) {
-			existingObject.Spec.Template.Spec.Containers[0].Ports = originalObject.Spec.Template.Spec.Containers[0].Ports
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Containers[0].Args, originalObject.Spec.Template.Spec.Containers[0].Args) {
-			existingObject.Spec.Template.Spec.Containers[0].Args = originalObject.Spec.Template.Spec.Containers[0].Args
-			updated = true
-		}
-		if existingObject.Spec.Template.Spec.Containers[0].Command != nil {
-			existingObject.Spec.Template.Spec.Containers[0].Command = nil
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Containers[0].Resources, originalObject.Spec.Template.Spec.Containers[0].Resources) {
-			existingObject.Spec.Template.Spec.Containers[0].Resources = originalObject.Spec.Template.Spec.Containers[0].Resources
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Containers[0].Env, originalObject.Spec.Template.Spec.Containers[0].Env) {
-			existingObject.Spec.Template.Spec.Containers[0].Env = originalObject.Spec.Template.Spec.Containers[0].Env
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Containers[0].ReadinessProbe, originalObject.Spec.Template.Spec.Containers[0].ReadinessProbe) {
-			existingObject.Spec.Template.Spec.Containers[0].ReadinessProbe = originalObject.Spec.Template.Spec.Containers[0].ReadinessProbe
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Containers[0].VolumeMounts, originalObject.Spec.Template.Spec.Containers[0].VolumeMounts) {
-			existingObject.Spec.Template.Spec.Containers[0].VolumeMounts = originalObject.Spec.Template.Spec.Containers[0].VolumeMounts
-			updated = true
-		}
-		if !reflect.DeepEqual(existingObject.Spec.Template.Spec.Volumes, originalObject.Spec.Template.Spec.Volumes) {
-			existingObject.Spec.Template.Spec.Volumes = originalObject.Spec.Template.Spec.Volumes
-			updated = true
+		if equality.Semantic.DeepDerivative(desired, existing) {
+			return nil
 		}
+		return []string{path}
 	}
 
-	return updated, existingObject, nil
+	existingMap, _ := existing.(map[string]interface{})
+	keys := make([]string, 0, len(desiredMap))
+	for k := range desiredMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var drifted []string
+	for _, k := range keys {
+		fieldPath := path + "." + k
+		if strings.Contains(k, ".") {
+			fieldPath = fmt.Sprintf("%s[%q]", path, k)
+		}
+		drifted = append(drifted, driftedFields(fieldPath, desiredMap[k], existingMap[k])...)
+	}
+	return drifted
 }
@@ -477,7 +477,7 @@ func TestCustomObjectError(t *testing.T) {
 	}
 }
 
-func TestUpdateCustomObject(t *testing.T) {
+func TestCustomObjectDrift(t *testing.T) {
 	originalMetadata := map[string]interface{}{
 		"name": eventListenerName,
 		"labels": map[string]interface{}{
@@ -521,7 +521,7 @@ func TestUpdateCustomObject(t *testing.T) {
 		name         string
 		originalData *unstructured.Unstructured
 		updatedData  *unstructured.Unstructured
-		updated      bool
+		drifted      []string
 	}{{
 		name: "entire object update with single container",
 		originalData: &unstructured.Unstructured{
@@ -718,7 +718,20 @@ func TestUpdateCustomObject(t *testing.T) {
 				},
 			},
 		},
-		updated: true,
+		drifted: []string{
+			`metadata.labels["app.kubernetes.io/managed-by"]`,
+			`metadata.annotations.key`,
+			`spec.template.metadata.annotations.key`,
+			`spec.template.metadata.labels["app.kubernetes.io/managed-by"]`,
+			`spec.template.metadata.name`,
+			`spec.template.spec.affinity.nodeAffinity.requiredDuringSchedulingIgnoredDuringExecution.nodeSelectorTerms`,
+			`spec.template.spec.containers`,
+			`spec.template.spec.nodeSelector.app`,
+			`spec.template.spec.serviceAccountName`,
+			`spec.template.spec.tolerations`,
+			`spec.template.spec.topologySpreadConstraints`,
+			`spec.template.spec.volumes`,
+		},
 	}, {
 		name: "entire object update without container",
 		originalData: &unstructured.Unstructured{
@@ -745,17 +758,79 @@ func TestUpdateCustomObject(t *testing.T) {
 				},
 			},
 		},
-		updated: true,
+		drifted: []string{
+			`metadata.labels["app.kubernetes.io/managed-by"]`,
+			`metadata.annotations.key`,
+			`spec.template.metadata.annotations.key`,
+			`spec.template.metadata.labels["app.kubernetes.io/managed-by"]`,
+			`spec.template.metadata.name`,
+		},
+	}, {
+		name: "fields set by other managers",
+		originalData: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "serving.knative.dev/v1",
+				"kind":       "Service",
+				"metadata":   originalMetadata,
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"serviceAccountName": "default",
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name":  "event-listener",
+									"image": DefaultImage,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		updatedData: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "serving.knative.dev/v1",
+				"kind":       "Service",
+				"metadata": map[string]interface{}{
+					"name": eventListenerName,
+					"labels": map[string]interface{}{
+						"app.kubernetes.io/managed-by": "EventListener",
+						"serving.knative.dev/service":  eventListenerName,
+					},
+					"annotations": map[string]interface{}{
+						"key":                         "value",
+						"serving.knative.dev/creator": "admin",
+					},
+					"ownerReferences": originalMetadata["ownerReferences"],
+				},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"serviceAccountName": "default",
+							"enableServiceLinks": false,
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name":           "event-listener",
+									"image":          DefaultImage,
+									"readinessProbe": map[string]interface{}{"successThreshold": int64(1)},
+								},
+							},
+						},
+					},
+					"traffic": []interface{}{
+						map[string]interface{}{"latestRevision": true, "percent": int64(100)},
+					},
+				},
+				"status": map[string]interface{}{"observedGeneration": int64(1)},
+			},
+		},
 	}}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _, err := UpdateCustomObject(tt.originalData, tt.updatedData)
-			if err != nil {
-				t.Fatalf("UpdateCustomObject() = %v", err)
-			}
-			if diff := cmp.Diff(tt.updated, got); diff != "" {
-				t.Errorf("UpdateCustomObject() did not return expected. -want, +got: %s", diff)
+			got := CustomObjectDrift(tt.originalData, tt.updatedData)
+			if diff := cmp.Diff(tt.drifted, got); diff != "" {
+				t.Errorf("CustomObjectDrift() did not return expected. -want, +got: %s", diff)
 			}
 		})
 	}
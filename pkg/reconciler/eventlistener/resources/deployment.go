@@ -67,7 +67,7 @@ func MakeDeployment(ctx context.Context, el *v1beta1.EventListener, configAcc re
 	if err != nil {
 		return nil, err
 	}
-	container := MakeContainer(el, configAcc, c, cfg, opt, addCertsForSecureConnection(c))
+	container := MakeContainer(el, configAcc, c, cfg, opt, addCertsForSecureConnection(el), addAdminProbes(c))
 
 	filteredLabels := FilterLabels(ctx, el.Labels)
 
@@ -87,7 +87,7 @@ func MakeDeployment(ctx context.Context, el *v1beta1.EventListener, configAcc re
 		// If TLS related env are set then mount secret volume which will be used while starting the eventlistener.
 		if v.Name == "TLS_CERT" {
 			vol = append(vol, corev1.Volume{
-				Name: "https-connection",
+				Name: tlsVolumeName,
 				VolumeSource: corev1.VolumeSource{
 					Secret: &corev1.SecretVolumeSource{
 						SecretName: v.ValueFrom.SecretKeyRef.Name,
@@ -96,6 +96,18 @@ func MakeDeployment(ctx context.Context, el *v1beta1.EventListener, configAcc re
 			})
 		}
 	}
+	// The Secret is mounted as a whole rather than with subPaths, so that
+	// the kubelet updates the files when the certificate is rotated.
+	if secretName := TLSSecretName(el); secretName != "" {
+		vol = append(vol, corev1.Volume{
+			Name: tlsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretName,
+				},
+			},
+		})
+	}
 
 	var securityContext *corev1.PodSecurityContext
 	if el.Spec.Resources.KubernetesResource != nil {
@@ -182,7 +194,25 @@ func addDeploymentBits(el *v1beta1.EventListener, c Config) (ContainerOption, er
 		container.Ports = append(container.Ports, corev1.ContainerPort{
 			ContainerPort: int32(metricsPort), //nolint: gosec
 			Protocol:      corev1.ProtocolTCP,
+		}, corev1.ContainerPort{
+			ContainerPort: int32(eventListenerAdminPort),
+			Protocol:      corev1.ProtocolTCP,
 		})
+		container.Args = append(container.Args,
+			"--admin-port="+strconv.Itoa(eventListenerAdminPort),
+			"--admin-profiling="+strconv.FormatBool(*c.EnableProfiling))
+		// The preStop hook waits 5 seconds for the endpoints of the
+		// EventListener to be removed before it is sent SIGTERM, upon which
+		// it stops being ready and drains the events being processed. Its
+		// image has no sleep binary, so the EventListener binary sleeps
+		// itself.
+		container.Lifecycle = &corev1.Lifecycle{
+			PreStop: &corev1.LifecycleHandler{
+				Exec: &corev1.ExecAction{
+					Command: []string{"/ko-app/eventlistenersink", "sleep", "5s"},
+				},
+			},
+		}
 
 		container.Env = append(container.Env, corev1.EnvVar{
 			Name: "SYSTEM_NAMESPACE",
@@ -204,42 +234,51 @@ func addDeploymentBits(el *v1beta1.EventListener, c Config) (ContainerOption, er
 	}, nil
 }
 
-func addCertsForSecureConnection(c Config) ContainerOption {
+func addCertsForSecureConnection(el *v1beta1.EventListener) ContainerOption {
 	return func(container *corev1.Container) {
 		var elCert, elKey string
 		certEnv := map[string]*corev1.EnvVarSource{}
 		for i := range container.Env {
 			certEnv[container.Env[i].Name] = container.Env[i].ValueFrom
 		}
-		var scheme corev1.URIScheme
 		if v, ok := certEnv["TLS_CERT"]; ok {
-			elCert = "/etc/triggers/tls/" + v.SecretKeyRef.Key
+			elCert = tlsMountPath + "/" + v.SecretKeyRef.Key
 		} else {
 			elCert = ""
 		}
 		if v, ok := certEnv["TLS_KEY"]; ok {
-			elKey = "/etc/triggers/tls/" + v.SecretKeyRef.Key
+			elKey = tlsMountPath + "/" + v.SecretKeyRef.Key
 		} else {
 			elKey = ""
 		}
+		if TLSSecretName(el) != "" {
+			elCert = tlsMountPath + "/" + corev1.TLSCertKey
+			elKey = tlsMountPath + "/" + corev1.TLSPrivateKeyKey
+		}
 
 		if elCert != "" && elKey != "" {
-			scheme = corev1.URISchemeHTTPS
 			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
-				Name:      "https-connection",
+				Name:      tlsVolumeName,
 				ReadOnly:  true,
-				MountPath: "/etc/triggers/tls",
+				MountPath: tlsMountPath,
 			})
-		} else {
-			scheme = corev1.URISchemeHTTP
 		}
+		container.Args = append(container.Args, "--tls-cert="+elCert, "--tls-key="+elKey)
+	}
+}
+
+// addAdminProbes points the probes the user didn't set at the admin port,
+// which serves plain HTTP whether or not events are received with TLS. The
+// readiness probe only succeeds once the EventListener can process events.
+func addAdminProbes(c Config) ContainerOption {
+	return func(container *corev1.Container) {
 		if container.LivenessProbe == nil {
 			container.LivenessProbe = &corev1.Probe{
 				ProbeHandler: corev1.ProbeHandler{
 					HTTPGet: &corev1.HTTPGetAction{
 						Path:   "/live",
-						Scheme: scheme,
-						Port:   intstr.FromInt(eventListenerContainerPort),
+						Scheme: corev1.URISchemeHTTP,
+						Port:   intstr.FromInt(eventListenerAdminPort),
 					},
 				},
 				PeriodSeconds:    int32(*c.PeriodSeconds),    //nolint: gosec
@@ -250,15 +289,14 @@ func addCertsForSecureConnection(c Config) ContainerOption {
 			container.ReadinessProbe = &corev1.Probe{
 				ProbeHandler: corev1.ProbeHandler{
 					HTTPGet: &corev1.HTTPGetAction{
-						Path:   "/live",
-						Scheme: scheme,
-						Port:   intstr.FromInt(eventListenerContainerPort),
+						Path:   "/ready",
+						Scheme: corev1.URISchemeHTTP,
+						Port:   intstr.FromInt(eventListenerAdminPort),
 					},
 				},
 				PeriodSeconds:    int32(*c.PeriodSeconds),    //nolint: gosec
 				FailureThreshold: int32(*c.FailureThreshold), //nolint: gosec
 			}
 		}
-		container.Args = append(container.Args, "--tls-cert="+elCert, "--tls-key="+elKey)
 	}
 }
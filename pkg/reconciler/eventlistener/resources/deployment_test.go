@@ -72,7 +72,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 					},
@@ -107,7 +107,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 					},
@@ -150,7 +150,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 						Tolerations: []corev1.Toleration{{
@@ -196,7 +196,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 						NodeSelector: map[string]string{
@@ -239,7 +239,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 					},
@@ -269,7 +269,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(withTLSEnvFrom("Bill")), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(withTLSEnvFrom("Bill")), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						Volumes: []corev1.Volume{{
 							Name: "https-connection",
@@ -284,6 +284,44 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "with TLS secret",
+		el:   makeEL(withTLSSecret("el-tls")),
+		want: &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "",
+				Namespace:       namespace,
+				Labels:          labels,
+				OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: labels,
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: labels,
+					},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: "sa",
+						Containers: []corev1.Container{
+							MakeContainer(makeEL(withTLSSecret("el-tls")), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
+								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(withTLSSecret("el-tls")), resourcesConfig),
+								addCertsForSecureConnection(makeEL(withTLSSecret("el-tls"))), addAdminProbes(resourcesConfig)),
+						},
+						Volumes: []corev1.Volume{{
+							Name: "https-connection",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "el-tls",
+								},
+							},
+						}},
+						SecurityContext: expectedSecurityContext,
+					},
+				},
+			},
+		},
 	}, {
 		name: "with Affinity and TopologySpreadConstraints",
 		el:   makeEL(withAffinityAndTopologySpreadConstraints()),
@@ -323,7 +361,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 					},
@@ -353,7 +391,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setProbes()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(setProbes()), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: expectedSecurityContext,
 					},
@@ -384,7 +422,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setProbes()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								getConfigWithoverriddenRunAsGroupAndRunAsUserAndFsGroup("0"), mustAddDeployBits(t, makeEL(setProbes()), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: getSecurityContextWithoverriddenRunAsGroupAndRunAsUser(*expectedSecurityContext, ptr.Int64(0)),
 					},
@@ -415,7 +453,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setProbes()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								getConfigWithoverriddenRunAsGroupAndRunAsUserAndFsGroup(""), mustAddDeployBits(t, makeEL(setProbes()), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: getSecurityContextWithoverriddenRunAsGroupAndRunAsUser(*expectedSecurityContext, ptr.Int64(0)),
 					},
@@ -445,7 +483,7 @@ func TestDeployment(t *testing.T) {
 						Containers: []corev1.Container{
 							MakeContainer(makeEL(setSecurityContext()), &reconcilersource.EmptyVarsGenerator{}, resourcesConfig,
 								cfg.FromContextOrDefaults(context.Background()), mustAddDeployBits(t, makeEL(setSecurityContext()), resourcesConfig),
-								addCertsForSecureConnection(resourcesConfig)),
+								addCertsForSecureConnection(makeEL()), addAdminProbes(resourcesConfig)),
 						},
 						SecurityContext: &corev1.PodSecurityContext{
 							RunAsNonRoot: ptr.Bool(true),
@@ -504,6 +542,12 @@ func TestDeploymentError(t *testing.T) {
 	}
 }
 
+func withTLSSecret(name string) func(*v1beta1.EventListener) {
+	return func(el *v1beta1.EventListener) {
+		el.Spec.TLS = &v1beta1.EventListenerTLS{SecretName: name}
+	}
+}
+
 func withTLSEnvFrom(name string) func(*v1beta1.EventListener) {
 	return func(el *v1beta1.EventListener) {
 		el.Spec.Resources.KubernetesResource = &v1beta1.KubernetesResource{
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// EventListenerFieldManager is the field manager the objects generated for
+// EventListeners are applied with.
+const EventListenerFieldManager = "tekton-triggers-eventlistener"
+
+// MakeIngress returns the Ingress exposing the EventListener Service, or nil
+// if the EventListener isn't exposed through an Ingress.
+func MakeIngress(ctx context.Context, el *v1beta1.EventListener, c Config) *networkingv1.Ingress {
+	e := el.Spec.Exposure
+	if e == nil || e.GetType() != v1beta1.IngressExposure {
+		return nil
+	}
+	pathType := networkingv1.PathTypePrefix
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: ObjectMeta(el, FilterLabels(ctx, el.Labels), c.StaticResourceLabels),
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: e.Hostname,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     e.GetPath(),
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: el.Status.Configuration.GeneratedResourceName,
+									Port: networkingv1.ServiceBackendPort{Number: ServicePort(el, c).Port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if e.ClassName != "" {
+		ingress.Spec.IngressClassName = ptr.String(e.ClassName)
+	}
+	if e.TLSSecretName != "" {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{e.Hostname},
+			SecretName: e.TLSSecretName,
+		}}
+	}
+	return ingress
+}
+
+// MakeHTTPRoute returns the Gateway API HTTPRoute exposing the EventListener
+// Service, or nil if the EventListener isn't exposed through an HTTPRoute.
+func MakeHTTPRoute(ctx context.Context, el *v1beta1.EventListener, c Config) *gatewayv1.HTTPRoute {
+	e := el.Spec.Exposure
+	if e == nil || e.GetType() != v1beta1.HTTPRouteExposure || e.Gateway == nil {
+		return nil
+	}
+	parentRef := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(e.Gateway.Name)}
+	if e.Gateway.Namespace != "" {
+		namespace := gatewayv1.Namespace(e.Gateway.Namespace)
+		parentRef.Namespace = &namespace
+	}
+	if e.Gateway.SectionName != "" {
+		sectionName := gatewayv1.SectionName(e.Gateway.SectionName)
+		parentRef.SectionName = &sectionName
+	}
+	pathType := gatewayv1.PathMatchPathPrefix
+	port := gatewayv1.PortNumber(ServicePort(el, c).Port)
+
+	return &gatewayv1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayv1.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: ObjectMeta(el, FilterLabels(ctx, el.Labels), c.StaticResourceLabels),
+		Spec: gatewayv1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1.CommonRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{parentRef},
+			},
+			Hostnames: []gatewayv1.Hostname{gatewayv1.Hostname(e.Hostname)},
+			Rules: []gatewayv1.HTTPRouteRule{{
+				Matches: []gatewayv1.HTTPRouteMatch{{
+					Path: &gatewayv1.HTTPPathMatch{
+						Type:  &pathType,
+						Value: ptr.String(e.GetPath()),
+					},
+				}},
+				BackendRefs: []gatewayv1.HTTPBackendRef{{
+					BackendRef: gatewayv1.BackendRef{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: gatewayv1.ObjectName(el.Status.Configuration.GeneratedResourceName),
+							Port: &port,
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+// ExternalURL returns the URL an exposed EventListener is reachable at from
+// outside of the cluster, or nil if it isn't exposed. Requests are received
+// with TLS when tls is true.
+func ExternalURL(el *v1beta1.EventListener, tls bool) *apis.URL {
+	e := el.Spec.Exposure
+	if e == nil {
+		return nil
+	}
+	u := &apis.URL{
+		Scheme: "http",
+		Host:   e.Hostname,
+	}
+	if tls {
+		u.Scheme = "https"
+	}
+	if p := e.GetPath(); p != "/" {
+		u.Path = p
+	}
+	return u
+}
+
+// GatewayTerminatesTLS reports whether the listeners of the Gateway an
+// HTTPRoute is attached to receive requests with TLS.
+func GatewayTerminatesTLS(gateway *gatewayv1.Gateway, sectionName string) bool {
+	for _, l := range gateway.Spec.Listeners {
+		if sectionName != "" && string(l.Name) != sectionName {
+			continue
+		}
+		if l.Protocol == gatewayv1.HTTPSProtocolType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func withExposure(exposure *v1beta1.EventListenerExposure) func(*v1beta1.EventListener) {
+	return func(el *v1beta1.EventListener) {
+		el.Spec.Exposure = exposure
+	}
+}
+
+func exposureObjectMeta() metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      generatedResourceName,
+		Namespace: namespace,
+		Labels: map[string]string{
+			"app.kubernetes.io/managed-by": "EventListener",
+			"app.kubernetes.io/part-of":    "Triggers",
+			"eventlistener":                eventListenerName,
+		},
+		OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+	}
+}
+
+func TestIngress(t *testing.T) {
+	config := *MakeConfig()
+	pathType := networkingv1.PathTypePrefix
+	ingress := func(path string, port int32) *networkingv1.Ingress {
+		return &networkingv1.Ingress{
+			ObjectMeta: exposureObjectMeta(),
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					Host: "events.example.com",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{{
+								Path:     path,
+								PathType: &pathType,
+								Backend: networkingv1.IngressBackend{
+									Service: &networkingv1.IngressServiceBackend{
+										Name: generatedResourceName,
+										Port: networkingv1.ServiceBackendPort{Number: port},
+									},
+								},
+							}},
+						},
+					},
+				}},
+			},
+		}
+	}
+	withTLS := ingress("/github", 8080)
+	withTLS.Spec.IngressClassName = ptr.String("nginx")
+	withTLS.Spec.TLS = []networkingv1.IngressTLS{{
+		Hosts:      []string{"events.example.com"},
+		SecretName: "events-tls",
+	}}
+
+	tests := []struct {
+		name string
+		el   *v1beta1.EventListener
+		want *networkingv1.Ingress
+	}{{
+		name: "not exposed",
+		el:   makeEL(withStatus),
+	}, {
+		name: "exposed through an HTTPRoute",
+		el: makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{
+			Type:     v1beta1.HTTPRouteExposure,
+			Hostname: "events.example.com",
+			Gateway:  &v1beta1.ExposureGateway{Name: "gateway"},
+		})),
+	}, {
+		name: "defaults",
+		el:   makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{Hostname: "events.example.com"})),
+		want: ingress("/", 8080),
+	}, {
+		name: "service port",
+		el: makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{Hostname: "events.example.com"}), func(el *v1beta1.EventListener) {
+			el.Spec.Resources.KubernetesResource = &v1beta1.KubernetesResource{ServicePort: ptr.Int32(9090)}
+		}),
+		want: ingress("/", 9090),
+	}, {
+		name: "path, class and TLS",
+		el: makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{
+			Type:          v1beta1.IngressExposure,
+			Hostname:      "events.example.com",
+			Path:          "/github",
+			ClassName:     "nginx",
+			TLSSecretName: "events-tls",
+		})),
+		want: withTLS,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeIngress(context.Background(), tt.el, config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MakeIngress() did not return expected. -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHTTPRoute(t *testing.T) {
+	config := *MakeConfig()
+	pathType := gatewayv1.PathMatchPathPrefix
+	port := gatewayv1.PortNumber(8080)
+	route := func(path string, parentRef gatewayv1.ParentReference) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "gateway.networking.k8s.io/v1",
+				Kind:       "HTTPRoute",
+			},
+			ObjectMeta: exposureObjectMeta(),
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{parentRef},
+				},
+				Hostnames: []gatewayv1.Hostname{"events.example.com"},
+				Rules: []gatewayv1.HTTPRouteRule{{
+					Matches: []gatewayv1.HTTPRouteMatch{{
+						Path: &gatewayv1.HTTPPathMatch{
+							Type:  &pathType,
+							Value: ptr.String(path),
+						},
+					}},
+					BackendRefs: []gatewayv1.HTTPBackendRef{{
+						BackendRef: gatewayv1.BackendRef{
+							BackendObjectReference: gatewayv1.BackendObjectReference{
+								Name: gatewayv1.ObjectName(generatedResourceName),
+								Port: &port,
+							},
+						},
+					}},
+				}},
+			},
+		}
+	}
+	gatewayNamespace := gatewayv1.Namespace("infra")
+	sectionName := gatewayv1.SectionName("https")
+
+	tests := []struct {
+		name string
+		el   *v1beta1.EventListener
+		want *gatewayv1.HTTPRoute
+	}{{
+		name: "not exposed",
+		el:   makeEL(withStatus),
+	}, {
+		name: "exposed through an Ingress",
+		el:   makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{Hostname: "events.example.com"})),
+	}, {
+		name: "gateway in the namespace of the EventListener",
+		el: makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{
+			Type:     v1beta1.HTTPRouteExposure,
+			Hostname: "events.example.com",
+			Gateway:  &v1beta1.ExposureGateway{Name: "gateway"},
+		})),
+		want: route("/", gatewayv1.ParentReference{Name: "gateway"}),
+	}, {
+		name: "listener of a gateway in another namespace",
+		el: makeEL(withStatus, withExposure(&v1beta1.EventListenerExposure{
+			Type:     v1beta1.HTTPRouteExposure,
+			Hostname: "events.example.com",
+			Path:     "/github",
+			Gateway:  &v1beta1.ExposureGateway{Name: "gateway", Namespace: "infra", SectionName: "https"},
+		})),
+		want: route("/github", gatewayv1.ParentReference{Name: "gateway", Namespace: &gatewayNamespace, SectionName: &sectionName}),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeHTTPRoute(context.Background(), tt.el, config)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MakeHTTPRoute() did not return expected. -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExternalURL(t *testing.T) {
+	tests := []struct {
+		name string
+		el   *v1beta1.EventListener
+		tls  bool
+		want *apis.URL
+	}{{
+		name: "not exposed",
+		el:   makeEL(),
+	}, {
+		name: "root path",
+		el:   makeEL(withExposure(&v1beta1.EventListenerExposure{Hostname: "events.example.com"})),
+		want: &apis.URL{Scheme: "http", Host: "events.example.com"},
+	}, {
+		name: "path with TLS",
+		el:   makeEL(withExposure(&v1beta1.EventListenerExposure{Hostname: "events.example.com", Path: "/github"})),
+		tls:  true,
+		want: &apis.URL{Scheme: "https", Host: "events.example.com", Path: "/github"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, ExternalURL(tt.el, tt.tls)); diff != "" {
+				t.Errorf("ExternalURL() did not return expected. -want, +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGatewayTerminatesTLS(t *testing.T) {
+	gateway := &gatewayv1.Gateway{
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{{
+				Name:     "http",
+				Protocol: gatewayv1.HTTPProtocolType,
+			}, {
+				Name:     "https",
+				Protocol: gatewayv1.HTTPSProtocolType,
+			}},
+		},
+	}
+	for sectionName, want := range map[string]bool{
+		"":        true,
+		"http":    false,
+		"https":   true,
+		"missing": false,
+	} {
+		if got := GatewayTerminatesTLS(gateway, sectionName); got != want {
+			t.Errorf("GatewayTerminatesTLS(%q) = %t, want %t", sectionName, got, want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/tektoncd/triggers/pkg/apis/config"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+// KnativeServiceGVR is the resource of the Knative Services generated for
+// EventListeners.
+var KnativeServiceGVR = servingv1.SchemeGroupVersion.WithResource("services")
+
+// MakeKnativeService returns the Knative Service running the EventListener,
+// or nil if it isn't configured with the knativeService resource.
+func MakeKnativeService(ctx context.Context, el *v1beta1.EventListener, configAcc reconcilersource.ConfigAccessor, c Config, cfg *config.Config) *servingv1.Service {
+	ks := el.Spec.Resources.KnativeService
+	if ks == nil {
+		return nil
+	}
+
+	container := MakeContainer(el, configAcc, c, cfg, func(container *corev1.Container) {
+		if len(ks.Template.Spec.Containers) == 1 {
+			container.Env = append(container.Env, ks.Template.Spec.Containers[0].Env...)
+			container.Resources = ks.Template.Spec.Containers[0].Resources
+			if *c.SetSecurityContext && ks.Template.Spec.Containers[0].SecurityContext != nil {
+				container.SecurityContext = ks.Template.Spec.Containers[0].SecurityContext
+			}
+		}
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name: "SYSTEM_NAMESPACE",
+			// Cannot use FieldRef here because Knative Serving mask that field under feature gate
+			Value: el.Namespace,
+		}, corev1.EnvVar{
+			// METRICS_PROMETHEUS_PORT defines the port exposed by the EventListener metrics endpoint
+			// env METRICS_PROMETHEUS_PORT set by controller
+			Name:  "METRICS_PROMETHEUS_PORT",
+			Value: os.Getenv("METRICS_PROMETHEUS_PORT"),
+		}, corev1.EnvVar{
+			// KUBERNETES_MIN_VERSION overrides the Min version of k8s required
+			Name:  "KUBERNETES_MIN_VERSION",
+			Value: os.Getenv("KUBERNETES_MIN_VERSION"),
+		})
+		// Knative Serving only probes the port events are received on.
+		container.ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   "/live",
+					Scheme: corev1.URISchemeHTTP,
+				},
+			},
+			SuccessThreshold: 1,
+		}
+	})
+
+	serviceAccountName := el.Spec.ServiceAccountName
+	if ks.Template.Spec.ServiceAccountName != "" {
+		serviceAccountName = ks.Template.Spec.ServiceAccountName
+	}
+
+	// The scale annotations are set on the revision template, where they
+	// configure the Knative Pod Autoscaler.
+	annotations := kmeta.CopyMap(ks.Template.Annotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[autoscaling.MinScaleAnnotationKey] = strconv.FormatInt(int64(ptr.Int32Value(ks.MinScale)), 10)
+	annotations[autoscaling.MaxScaleAnnotationKey] = strconv.FormatInt(int64(ptr.Int32Value(ks.MaxScale)), 10)
+
+	filteredLabels := FilterLabels(ctx, el.Labels)
+	return &servingv1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: servingv1.SchemeGroupVersion.String(),
+			Kind:       "Service",
+		},
+		ObjectMeta: ObjectMeta(el, filteredLabels, c.StaticResourceLabels),
+		Spec: servingv1.ServiceSpec{
+			ConfigurationSpec: servingv1.ConfigurationSpec{
+				Template: servingv1.RevisionTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: kmeta.UnionMaps(kmeta.UnionMaps(filteredLabels, GenerateLabels(el.Name, c.StaticResourceLabels)),
+							ks.Template.Labels),
+						Annotations: annotations,
+					},
+					Spec: servingv1.RevisionSpec{
+						PodSpec: corev1.PodSpec{
+							ServiceAccountName:        serviceAccountName,
+							Containers:                []corev1.Container{container},
+							ImagePullSecrets:          ks.Template.Spec.ImagePullSecrets,
+							Tolerations:               ks.Template.Spec.Tolerations,
+							NodeSelector:              ks.Template.Spec.NodeSelector,
+							Affinity:                  ks.Template.Spec.Affinity,
+							TopologySpreadConstraints: ks.Template.Spec.TopologySpreadConstraints,
+							SecurityContext:           ks.Template.Spec.SecurityContext,
+						},
+						ContainerConcurrency: ptr.Int64(ptr.Int64Value(ks.ContainerConcurrency)),
+						// Knative Serving times requests out after the
+						// EventListener's timeout handler would.
+						TimeoutSeconds: ptr.Int64(*c.TimeOutHandler),
+					},
+				},
+			},
+		},
+	}
+}
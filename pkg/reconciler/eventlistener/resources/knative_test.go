@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	cfg "github.com/tektoncd/triggers/pkg/apis/config"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+)
+
+func TestMakeKnativeService(t *testing.T) {
+	t.Setenv("METRICS_PROMETHEUS_PORT", "9000")
+	t.Setenv("KUBERNETES_MIN_VERSION", "v1.28.0")
+
+	c := *MakeConfig()
+	c.TimeOutHandler = ptr.Int64(30)
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "EventListener",
+		"app.kubernetes.io/part-of":    "Triggers",
+		"eventlistener":                eventListenerName,
+	}
+	tolerations := []corev1.Toleration{{Key: "key", Operator: corev1.TolerationOpExists}}
+	limits := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+	secretEnv := corev1.EnvVar{
+		Name: "TOKEN",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+				Key:                  "token",
+			},
+		},
+	}
+
+	type want struct {
+		serviceAccountName   string
+		annotations          map[string]string
+		labels               map[string]string
+		containerConcurrency int64
+		tolerations          []corev1.Toleration
+		resources            corev1.ResourceRequirements
+		env                  *corev1.EnvVar
+	}
+	tests := []struct {
+		name string
+		el   *v1beta1.EventListener
+		want *want
+	}{{
+		name: "no Knative Service",
+		el:   makeEL(withStatus),
+	}, {
+		name: "scales to zero by default",
+		el: makeEL(withStatus, func(el *v1beta1.EventListener) {
+			el.Spec.Resources.KnativeService = &v1beta1.KnativeServiceResource{}
+		}),
+		want: &want{
+			serviceAccountName: "sa",
+			annotations: map[string]string{
+				autoscaling.MinScaleAnnotationKey: "0",
+				autoscaling.MaxScaleAnnotationKey: "0",
+			},
+			labels: labels,
+		},
+	}, {
+		name: "scale, concurrency and pod settings",
+		el: makeEL(withStatus, func(el *v1beta1.EventListener) {
+			el.Spec.Resources.KnativeService = &v1beta1.KnativeServiceResource{
+				MinScale:             ptr.Int32(1),
+				MaxScale:             ptr.Int32(5),
+				ContainerConcurrency: ptr.Int64(10),
+				WithPodSpec: duckv1.WithPodSpec{
+					Template: duckv1.PodSpecable{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels:      map[string]string{"team": "ci"},
+							Annotations: map[string]string{"autoscaling.knative.dev/target": "5"},
+						},
+						Spec: corev1.PodSpec{
+							ServiceAccountName: "knative-sa",
+							Tolerations:        tolerations,
+							Containers: []corev1.Container{{
+								Env:       []corev1.EnvVar{secretEnv},
+								Resources: limits,
+							}},
+						},
+					},
+				},
+			}
+		}),
+		want: &want{
+			serviceAccountName: "knative-sa",
+			annotations: map[string]string{
+				"autoscaling.knative.dev/target":  "5",
+				autoscaling.MinScaleAnnotationKey: "1",
+				autoscaling.MaxScaleAnnotationKey: "5",
+			},
+			labels:               kmeta.UnionMaps(labels, map[string]string{"team": "ci"}),
+			containerConcurrency: 10,
+			tolerations:          tolerations,
+			resources:            limits,
+			env:                  &secretEnv,
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeKnativeService(context.Background(), tt.el, &reconcilersource.EmptyVarsGenerator{}, c,
+				cfg.FromContextOrDefaults(context.Background()))
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("MakeKnativeService() = %v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("MakeKnativeService() = nil")
+			}
+			if got.APIVersion != servingv1.SchemeGroupVersion.String() || got.Kind != "Service" {
+				t.Errorf("MakeKnativeService() type = %s %s", got.APIVersion, got.Kind)
+			}
+			if diff := cmp.Diff(ObjectMeta(tt.el, nil, c.StaticResourceLabels), got.ObjectMeta); diff != "" {
+				t.Errorf("MakeKnativeService() metadata mismatch -want +got: %s", diff)
+			}
+
+			template := got.Spec.Template
+			if diff := cmp.Diff(tt.want.annotations, template.Annotations); diff != "" {
+				t.Errorf("MakeKnativeService() template annotations -want +got: %s", diff)
+			}
+			if diff := cmp.Diff(tt.want.labels, template.Labels); diff != "" {
+				t.Errorf("MakeKnativeService() template labels -want +got: %s", diff)
+			}
+			if got := template.Spec.ServiceAccountName; got != tt.want.serviceAccountName {
+				t.Errorf("MakeKnativeService() serviceAccountName = %q, want %q", got, tt.want.serviceAccountName)
+			}
+			if got := ptr.Int64Value(template.Spec.ContainerConcurrency); got != tt.want.containerConcurrency {
+				t.Errorf("MakeKnativeService() containerConcurrency = %d, want %d", got, tt.want.containerConcurrency)
+			}
+			if got := ptr.Int64Value(template.Spec.TimeoutSeconds); got != *c.TimeOutHandler {
+				t.Errorf("MakeKnativeService() timeoutSeconds = %d, want %d", got, *c.TimeOutHandler)
+			}
+			if diff := cmp.Diff(tt.want.tolerations, template.Spec.Tolerations); diff != "" {
+				t.Errorf("MakeKnativeService() tolerations -want +got: %s", diff)
+			}
+
+			if len(template.Spec.Containers) != 1 {
+				t.Fatalf("MakeKnativeService() has %d containers, want 1", len(template.Spec.Containers))
+			}
+			container := template.Spec.Containers[0]
+			if diff := cmp.Diff(tt.want.resources, container.Resources); diff != "" {
+				t.Errorf("MakeKnativeService() container resources -want +got: %s", diff)
+			}
+			if container.ReadinessProbe == nil || container.ReadinessProbe.HTTPGet == nil || container.ReadinessProbe.HTTPGet.Path != "/live" {
+				t.Errorf("MakeKnativeService() readinessProbe = %v, want an HTTP GET of /live", container.ReadinessProbe)
+			}
+			env := map[string]corev1.EnvVar{}
+			for _, e := range container.Env {
+				env[e.Name] = e
+			}
+			if got := env["SYSTEM_NAMESPACE"].Value; got != namespace {
+				t.Errorf("MakeKnativeService() SYSTEM_NAMESPACE = %q, want %q", got, namespace)
+			}
+			if tt.want.env != nil {
+				if diff := cmp.Diff(*tt.want.env, env[tt.want.env.Name]); diff != "" {
+					t.Errorf("MakeKnativeService() env %s -want +got: %s", tt.want.env.Name, diff)
+				}
+			}
+		})
+	}
+}
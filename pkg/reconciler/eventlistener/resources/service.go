@@ -37,6 +37,8 @@ const (
 	eventListenerContainerPort = 8080
 	// eventListenerMetricsPort defines metrics port for EventListener Service
 	eventListenerMetricsPort = 9000
+	// eventListenerAdminPort defines the port the EventListener Container serves its probes, metrics and profiles on
+	eventListenerAdminPort = 8081
 )
 
 var metricsPort = corev1.ServicePort{
@@ -113,7 +115,7 @@ func ServicePort(el *v1beta1.EventListener, c Config) corev1.ServicePort {
 		elKey = ""
 	}
 
-	if elCert != "" && elKey != "" {
+	if (elCert != "" && elKey != "") || el.Spec.TLS != nil {
 		servicePortName = eventListenerServiceTLSPortName
 		if *c.Port == DefaultPort {
 			// We return port 8443 if TLS is enabled and the default HTTP port is set.
@@ -268,6 +268,20 @@ func TestServicePort(t *testing.T) {
 				IntVal: int32(eventListenerContainerPort),
 			},
 		},
+	}, {
+		name: "EventListener with generated TLS certificate",
+		el: makeEL(withStatus, func(el *v1beta1.EventListener) {
+			el.Spec.TLS = &v1beta1.EventListenerTLS{Generate: true}
+		}),
+		config: *MakeConfig(),
+		expectedServicePort: corev1.ServicePort{
+			Name:     eventListenerServiceTLSPortName,
+			Protocol: corev1.ProtocolTCP,
+			Port:     int32(8443),
+			TargetPort: intstr.IntOrString{
+				IntVal: int32(eventListenerContainerPort),
+			},
+		},
 	}, {
 		name: "EventListener with ServicePort 80 in KubernetesResource",
 		el: makeEL(withStatus, withTLSPort, func(el *v1beta1.EventListener) {
@@ -0,0 +1,243 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/network"
+)
+
+const (
+	// TLSCACertKey is the key of the generated TLS Secret holding the
+	// certificate of the CA that signed the certificate of the EventListener.
+	TLSCACertKey = "ca.crt"
+	// TLSCAKeyKey is the key of the generated CA Secret holding the private
+	// key of the CA.
+	TLSCAKeyKey = "ca.key"
+	// TLSCertificateValidity is how long generated certificates are valid.
+	TLSCertificateValidity = 365 * 24 * time.Hour
+	// TLSCAValidity is how long generated CAs are valid. The certificates
+	// they sign are rotated without changing the CA clients trust.
+	TLSCAValidity = 10 * TLSCertificateValidity
+	// TLSCertificateRenewBefore is how long before they expire generated
+	// certificates are rotated.
+	TLSCertificateRenewBefore = 30 * 24 * time.Hour
+
+	tlsMountPath  = "/etc/triggers/tls"
+	tlsVolumeName = "https-connection"
+)
+
+// TLSSecretName returns the name of the Secret holding the certificate the
+// EventListener serves, or "" if it isn't configured with the tls field.
+func TLSSecretName(el *v1beta1.EventListener) string {
+	switch {
+	case el.Spec.TLS == nil:
+		return ""
+	case el.Spec.TLS.Generate:
+		return GeneratedTLSSecretName(el)
+	default:
+		return el.Spec.TLS.SecretName
+	}
+}
+
+// GeneratedTLSSecretName returns the name of the Secret storing the
+// certificate generated for the EventListener.
+func GeneratedTLSSecretName(el *v1beta1.EventListener) string {
+	return el.Status.Configuration.GeneratedResourceName + "-tls"
+}
+
+// GeneratedTLSCASecretName returns the name of the Secret storing the CA
+// generated for the EventListener, which isn't mounted by the EventListener.
+func GeneratedTLSCASecretName(el *v1beta1.EventListener) string {
+	return el.Status.Configuration.GeneratedResourceName + "-tls-ca"
+}
+
+// MakeTLSSecret returns the Secret storing a certificate generated for the
+// EventListener, or nil if the EventListener doesn't generate one.
+func MakeTLSSecret(ctx context.Context, el *v1beta1.EventListener, c Config, serverKey, serverCert, caCert []byte) *corev1.Secret {
+	if el.Spec.TLS == nil || !el.Spec.TLS.Generate {
+		return nil
+	}
+	meta := ObjectMeta(el, FilterLabels(ctx, el.Labels), c.StaticResourceLabels)
+	meta.Name = GeneratedTLSSecretName(el)
+	return &corev1.Secret{
+		ObjectMeta: meta,
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: serverKey,
+			corev1.TLSCertKey:       serverCert,
+			TLSCACertKey:            caCert,
+		},
+	}
+}
+
+// MakeTLSCASecret returns the Secret storing the CA generated for the
+// EventListener, or nil if the EventListener doesn't generate a certificate.
+func MakeTLSCASecret(ctx context.Context, el *v1beta1.EventListener, c Config, caKey, caCert []byte) *corev1.Secret {
+	if el.Spec.TLS == nil || !el.Spec.TLS.Generate {
+		return nil
+	}
+	meta := ObjectMeta(el, FilterLabels(ctx, el.Labels), c.StaticResourceLabels)
+	meta.Name = GeneratedTLSCASecretName(el)
+	return &corev1.Secret{
+		ObjectMeta: meta,
+		Type:       corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			TLSCAKeyKey:  caKey,
+			TLSCACertKey: caCert,
+		},
+	}
+}
+
+// TLSSecretRotationTime returns when the certificate stored in a generated
+// TLS Secret needs to be rotated.
+func TLSSecretRotationTime(secret *corev1.Secret) (time.Time, error) {
+	cert, err := parseCertificate(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter.Add(-TLSCertificateRenewBefore), nil
+}
+
+// TLSCASecretRotationTime returns when the CA stored in a generated CA Secret
+// needs to be rotated.
+func TLSCASecretRotationTime(secret *corev1.Secret) (time.Time, error) {
+	cert, err := parseCertificate(secret.Data[TLSCACertKey])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter.Add(-TLSCertificateRenewBefore), nil
+}
+
+// CreateTLSCA generates a CA for the certificates of the Service name in
+// namespace, and returns its PEM encoded private key and certificate.
+func CreateTLSCA(name, namespace string, notAfter time.Time) (caKey, caCert []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate the CA key: %w", err)
+	}
+	tmpl, err := certificateTemplate(name, namespace, notAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl.IsCA = true
+	tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign the CA certificate: %w", err)
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keyPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// CreateTLSCertificate generates a certificate for the Service name in
+// namespace signed by the CA, and returns its PEM encoded private key and
+// certificate. The certificate doesn't outlive the CA.
+func CreateTLSCertificate(name, namespace string, caKey, caCert []byte, notAfter time.Time) (serverKey, serverCert []byte, err error) {
+	ca, err := parseCertificate(caCert)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the CA certificate: %w", err)
+	}
+	block, _ := pem.Decode(caKey)
+	if block == nil {
+		return nil, nil, errors.New("failed to parse the CA key PEM")
+	}
+	signer, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse the CA key: %w", err)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate the certificate key: %w", err)
+	}
+	if notAfter.After(ca.NotAfter) {
+		notAfter = ca.NotAfter
+	}
+	tmpl, err := certificateTemplate(name, namespace, notAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, key.Public(), signer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to sign the certificate: %w", err)
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return keyPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+// certificateTemplate returns the parts shared by the CA and the certificate
+// of the Service name in namespace.
+func certificateTemplate(name, namespace string, notAfter time.Time) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"tekton.dev"},
+			CommonName:   name + "." + namespace + ".svc",
+		},
+		SignatureAlgorithm:    x509.ECDSAWithSHA256,
+		NotBefore:             time.Now(),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		DNSNames: []string{
+			name,
+			name + "." + namespace,
+			name + "." + namespace + ".svc",
+			network.GetServiceHostname(name, namespace),
+		},
+	}, nil
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), nil
+}
+
+func parseCertificate(b []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("failed to parse certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
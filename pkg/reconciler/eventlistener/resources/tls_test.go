@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+func withTLS(tls *v1beta1.EventListenerTLS) func(*v1beta1.EventListener) {
+	return func(el *v1beta1.EventListener) {
+		el.Spec.TLS = tls
+	}
+}
+
+func TestTLSSecretName(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		el   *v1beta1.EventListener
+		want string
+	}{{
+		name: "no TLS",
+		el:   makeEL(withStatus),
+	}, {
+		name: "secret",
+		el:   makeEL(withStatus, withTLS(&v1beta1.EventListenerTLS{SecretName: "events-tls"})),
+		want: "events-tls",
+	}, {
+		name: "generated",
+		el:   makeEL(withStatus, withTLS(&v1beta1.EventListenerTLS{Generate: true})),
+		want: generatedResourceName + "-tls",
+	}} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TLSSecretName(tt.el); got != tt.want {
+				t.Errorf("TLSSecretName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeTLSSecret(t *testing.T) {
+	config := *MakeConfig()
+	key, cert, ca := []byte("key"), []byte("cert"), []byte("ca")
+
+	if got := MakeTLSSecret(context.Background(), makeEL(withStatus, withTLS(&v1beta1.EventListenerTLS{SecretName: "events-tls"})),
+		config, key, cert, ca); got != nil {
+		t.Errorf("MakeTLSSecret() = %v, want nil for a user provided Secret", got)
+	}
+
+	want := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generatedResourceName + "-tls",
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "EventListener",
+				"app.kubernetes.io/part-of":    "Triggers",
+				"eventlistener":                eventListenerName,
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			"tls.key": key,
+			"tls.crt": cert,
+			"ca.crt":  ca,
+		},
+	}
+	got := MakeTLSSecret(context.Background(), makeEL(withStatus, withTLS(&v1beta1.EventListenerTLS{Generate: true})), config, key, cert, ca)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeTLSSecret() did not return expected. -want, +got: %s", diff)
+	}
+}
+
+func TestMakeTLSCASecret(t *testing.T) {
+	config := *MakeConfig()
+	key, ca := []byte("key"), []byte("ca")
+
+	if got := MakeTLSCASecret(context.Background(), makeEL(withStatus, withTLS(&v1beta1.EventListenerTLS{SecretName: "events-tls"})),
+		config, key, ca); got != nil {
+		t.Errorf("MakeTLSCASecret() = %v, want nil for a user provided Secret", got)
+	}
+
+	want := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generatedResourceName + "-tls-ca",
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/managed-by": "EventListener",
+				"app.kubernetes.io/part-of":    "Triggers",
+				"eventlistener":                eventListenerName,
+			},
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(makeEL())},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"ca.key": key,
+			"ca.crt": ca,
+		},
+	}
+	got := MakeTLSCASecret(context.Background(), makeEL(withStatus, withTLS(&v1beta1.EventListenerTLS{Generate: true})), config, key, ca)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MakeTLSCASecret() did not return expected. -want, +got: %s", diff)
+	}
+}
+
+func TestCreateTLSCertificate(t *testing.T) {
+	caNotAfter := time.Now().Add(TLSCertificateValidity).Truncate(time.Second)
+	caKey, caCert, err := CreateTLSCA(generatedResourceName, namespace, caNotAfter)
+	if err != nil {
+		t.Fatalf("CreateTLSCA() = %v", err)
+	}
+	// The certificate doesn't outlive the CA
+	_, cert, err := CreateTLSCertificate(generatedResourceName, namespace, caKey, caCert, caNotAfter.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateTLSCertificate() = %v", err)
+	}
+
+	ca, err := parseCertificate(caCert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	c, err := parseCertificate(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Verify(x509.VerifyOptions{DNSName: generatedResourceName + "." + namespace + ".svc.cluster.local", Roots: roots}); err != nil {
+		t.Errorf("certificate isn't valid for the Service: %v", err)
+	}
+	if !c.NotAfter.Equal(caNotAfter) {
+		t.Errorf("certificate expires at %s, want %s", c.NotAfter, caNotAfter)
+	}
+
+	if _, _, err := CreateTLSCertificate(generatedResourceName, namespace, []byte("key"), caCert, caNotAfter); err == nil {
+		t.Error("CreateTLSCertificate() expected an error for an invalid CA key")
+	}
+}
+
+func TestTLSSecretRotationTime(t *testing.T) {
+	notAfter := time.Now().Add(TLSCertificateValidity).Truncate(time.Second)
+	caKey, caCert, err := CreateTLSCA(generatedResourceName, namespace, notAfter.Add(TLSCertificateValidity))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, cert, err := CreateTLSCertificate(generatedResourceName, namespace, caKey, caCert, notAfter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := TLSSecretRotationTime(&corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: cert}})
+	if err != nil {
+		t.Fatalf("TLSSecretRotationTime() = %v", err)
+	}
+	if want := notAfter.Add(-TLSCertificateRenewBefore); !got.Equal(want) {
+		t.Errorf("TLSSecretRotationTime() = %s, want %s", got, want)
+	}
+	got, err = TLSCASecretRotationTime(&corev1.Secret{Data: map[string][]byte{TLSCACertKey: caCert}})
+	if err != nil {
+		t.Fatalf("TLSCASecretRotationTime() = %v", err)
+	}
+	if want := notAfter.Add(TLSCertificateValidity - TLSCertificateRenewBefore); !got.Equal(want) {
+		t.Errorf("TLSCASecretRotationTime() = %s, want %s", got, want)
+	}
+
+	if _, err := TLSSecretRotationTime(&corev1.Secret{}); err == nil {
+		t.Error("TLSSecretRotationTime() expected an error for a Secret without certificate")
+	}
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventlistener
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/template"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// resolveTriggers resolves every Trigger the EventListener processes events
+// for, the same way the sink selects them: inline triggers and triggerRefs,
+// Triggers selected by namespace and label, and Triggers selected by each
+// trigger group.
+func (r *Reconciler) resolveTriggers(el *v1beta1.EventListener) ([]v1beta1.EventListenerTriggerStatus, error) {
+	var statuses []v1beta1.EventListenerTriggerStatus
+	for _, t := range el.Spec.Triggers {
+		if t.Template == nil && t.TriggerRef != "" {
+			trigger, err := r.triggerLister.Triggers(el.Namespace).Get(t.TriggerRef)
+			if err != nil {
+				statuses = append(statuses, unresolved(t.TriggerRef, el.Namespace, "", v1beta1.TriggerNotFoundReason,
+					fmt.Sprintf("Trigger %s: %s", t.TriggerRef, err)))
+				continue
+			}
+			statuses = append(statuses, r.resolveTrigger(el, trigger, ""))
+			continue
+		}
+		statuses = append(statuses, r.resolveTrigger(el, &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: t.Name, Namespace: el.Namespace},
+			Spec: v1beta1.TriggerSpec{
+				Bindings:     t.Bindings,
+				Template:     derefTemplate(t.Template),
+				Interceptors: t.Interceptors,
+			},
+		}, ""))
+	}
+
+	selected, err := r.selectTriggers(el.Namespace, el.Spec.NamespaceSelector, el.Spec.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range selected {
+		statuses = append(statuses, r.resolveTrigger(el, t, ""))
+	}
+
+	for _, g := range el.Spec.TriggerGroups {
+		selected, err := r.selectTriggers(el.Namespace, g.TriggerSelector.NamespaceSelector, g.TriggerSelector.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		reason, message := r.resolveInterceptors(el.Namespace, g.Interceptors)
+		for _, t := range selected {
+			if reason != "" {
+				statuses = append(statuses, unresolved(t.Name, t.Namespace, g.Name, reason, fmt.Sprintf("trigger group %s: %s", g.Name, message)))
+				continue
+			}
+			statuses = append(statuses, r.resolveTrigger(el, t, g.Name))
+		}
+	}
+	return statuses, nil
+}
+
+// resolveTrigger checks that the bindings, template and interceptors
+// referenced by the Trigger exist.
+func (r *Reconciler) resolveTrigger(el *v1beta1.EventListener, t *v1beta1.Trigger, group string) v1beta1.EventListenerTriggerStatus {
+	for _, b := range t.Spec.Bindings {
+		switch {
+		case b.Ref == "":
+			continue
+		case b.Kind == v1beta1.ClusterTriggerBindingKind:
+			if _, err := r.clusterTriggerBindingLister.Get(b.Ref); err != nil {
+				return unresolved(t.Name, t.Namespace, group, v1beta1.ClusterTriggerBindingNotFoundReason,
+					fmt.Sprintf("ClusterTriggerBinding %s: %s", b.Ref, err))
+			}
+		default:
+			if _, err := r.triggerBindingLister.TriggerBindings(t.Namespace).Get(b.Ref); err != nil {
+				return unresolved(t.Name, t.Namespace, group, v1beta1.TriggerBindingNotFoundReason,
+					fmt.Sprintf("TriggerBinding %s: %s", b.Ref, err))
+			}
+		}
+	}
+
+	if t.Spec.Template.Spec == nil {
+		var name string
+		if t.Spec.Template.Ref != nil {
+			name = *t.Spec.Template.Ref
+		}
+		if _, err := r.triggerTemplateLister.TriggerTemplates(t.Namespace).Get(name); err != nil {
+			return unresolved(t.Name, t.Namespace, group, v1beta1.TriggerTemplateNotFoundReason,
+				fmt.Sprintf("TriggerTemplate %s: %s", name, err))
+		}
+	}
+
+	// Namespaced Interceptors are looked up in the EventListener's namespace
+	// by the sink, regardless of where the Trigger lives.
+	if reason, message := r.resolveInterceptors(el.Namespace, t.Spec.Interceptors); reason != "" {
+		return unresolved(t.Name, t.Namespace, group, reason, message)
+	}
+
+	// Everything exists; let the sink's resolution catch anything else such
+	// as bindings declaring the same param twice.
+	if _, err := template.ResolveTrigger(*t,
+		r.triggerBindingLister.TriggerBindings(t.Namespace).Get,
+		r.clusterTriggerBindingLister.Get,
+		r.triggerTemplateLister.TriggerTemplates(t.Namespace).Get); err != nil {
+		return unresolved(t.Name, t.Namespace, group, v1beta1.InvalidTriggerReason, err.Error())
+	}
+	return v1beta1.EventListenerTriggerStatus{
+		Name:         t.Name,
+		Namespace:    t.Namespace,
+		TriggerGroup: group,
+		Resolved:     true,
+	}
+}
+
+// resolveInterceptors returns the reason and message for the first
+// referenced Interceptor or ClusterInterceptor that does not exist.
+func (r *Reconciler) resolveInterceptors(namespace string, interceptors []*v1beta1.TriggerInterceptor) (string, string) {
+	for _, i := range interceptors {
+		if i.Ref.Name == "" {
+			// Webhook interceptors are plain URLs and have nothing to resolve.
+			continue
+		}
+		if i.Ref.Kind == v1beta1.NamespacedInterceptorKind {
+			if _, err := r.interceptorLister.Interceptors(namespace).Get(i.Ref.Name); err != nil {
+				return v1beta1.InterceptorNotFoundReason, fmt.Sprintf("Interceptor %s: %s", i.Ref.Name, err)
+			}
+			continue
+		}
+		if _, err := r.clusterInterceptorLister.Get(i.Ref.Name); err != nil {
+			return v1beta1.ClusterInterceptorNotFoundReason, fmt.Sprintf("ClusterInterceptor %s: %s", i.Ref.Name, err)
+		}
+	}
+	return "", ""
+}
+
+// selectTriggers lists the Triggers matched by the selectors, mirroring how
+// the sink selects them. Triggers are sorted so the status is stable.
+func (r *Reconciler) selectTriggers(namespace string, namespaceSelector v1beta1.NamespaceSelector, labelSelector *metav1.LabelSelector) ([]*v1beta1.Trigger, error) {
+	targetLabels := labels.Everything()
+	if labelSelector != nil {
+		var err error
+		if targetLabels, err = metav1.LabelSelectorAsSelector(labelSelector); err != nil {
+			return nil, fmt.Errorf("failed to create label selector: %w", err)
+		}
+	}
+
+	var triggers []*v1beta1.Trigger
+	switch {
+	case len(namespaceSelector.MatchNames) == 1 && namespaceSelector.MatchNames[0] == "*":
+		list, err := r.triggerLister.List(targetLabels)
+		if err != nil {
+			return nil, err
+		}
+		triggers = list
+	case len(namespaceSelector.MatchNames) != 0:
+		for _, ns := range namespaceSelector.MatchNames {
+			list, err := r.triggerLister.Triggers(ns).List(targetLabels)
+			if err != nil {
+				return nil, err
+			}
+			triggers = append(triggers, list...)
+		}
+	case labelSelector != nil:
+		list, err := r.triggerLister.Triggers(namespace).List(targetLabels)
+		if err != nil {
+			return nil, err
+		}
+		triggers = list
+	}
+	sort.Slice(triggers, func(i, j int) bool {
+		if triggers[i].Namespace != triggers[j].Namespace {
+			return triggers[i].Namespace < triggers[j].Namespace
+		}
+		return triggers[i].Name < triggers[j].Name
+	})
+	return triggers, nil
+}
+
+// firesTriggers reports whether the EventListener fires any of its Triggers
+// itself, on a schedule or by polling a Git repository, which needs a
+// replica to keep running.
+func (r *Reconciler) firesTriggers(el *v1beta1.EventListener) (bool, error) {
+	fires := func(t *v1beta1.Trigger) bool {
+		return t.Spec.Schedule != nil || t.Spec.Poll != nil
+	}
+	for _, t := range el.Spec.Triggers {
+		if t.Template != nil || t.TriggerRef == "" {
+			continue
+		}
+		if trigger, err := r.triggerLister.Triggers(el.Namespace).Get(t.TriggerRef); err == nil && fires(trigger) {
+			return true, nil
+		}
+	}
+
+	selectors := []v1beta1.EventListenerTriggerSelector{{
+		NamespaceSelector: el.Spec.NamespaceSelector,
+		LabelSelector:     el.Spec.LabelSelector,
+	}}
+	for _, g := range el.Spec.TriggerGroups {
+		selectors = append(selectors, g.TriggerSelector)
+	}
+	for _, s := range selectors {
+		selected, err := r.selectTriggers(el.Namespace, s.NamespaceSelector, s.LabelSelector)
+		if err != nil {
+			return false, err
+		}
+		if slices.ContainsFunc(selected, fires) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// selectsAcrossNamespaces reports whether the EventListener may process
+// Triggers outside of its own namespace.
+func selectsAcrossNamespaces(el *v1beta1.EventListener) bool {
+	if len(el.Spec.NamespaceSelector.MatchNames) != 0 {
+		return true
+	}
+	for _, g := range el.Spec.TriggerGroups {
+		if len(g.TriggerSelector.NamespaceSelector.MatchNames) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func unresolved(name, namespace, group, reason, message string) v1beta1.EventListenerTriggerStatus {
+	return v1beta1.EventListenerTriggerStatus{
+		Name:         name,
+		Namespace:    namespace,
+		TriggerGroup: group,
+		Reason:       reason,
+		Message:      message,
+	}
+}
+
+func derefTemplate(t *v1beta1.EventListenerTemplate) v1beta1.TriggerSpecTemplate {
+	if t == nil {
+		return v1beta1.TriggerSpecTemplate{}
+	}
+	return *t
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventlistener
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestReconcile_TriggersResolved(t *testing.T) {
+	t.Setenv("METRICS_PROMETHEUS_PORT", "9000")
+	t.Setenv("SYSTEM_NAMESPACE", "tekton-pipelines")
+
+	makeTrigger := func(name string, labels map[string]string, spec v1beta1.TriggerSpec) *v1beta1.Trigger {
+		return &v1beta1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			Spec:       spec,
+		}
+	}
+	withTemplate := func(spec v1beta1.TriggerSpec) v1beta1.TriggerSpec {
+		spec.Template = v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")}
+		return spec
+	}
+	grouped := map[string]string{"group": "a"}
+
+	resources := test.Resources{
+		Namespaces: []*corev1.Namespace{namespaceResource},
+		EventListeners: []*v1beta1.EventListener{makeEL(func(el *v1beta1.EventListener) {
+			el.Spec.Triggers = []v1beta1.EventListenerTrigger{{
+				Name:     "inline",
+				Bindings: []*v1beta1.TriggerSpecBinding{{Ref: "missing-tb", Kind: v1beta1.NamespacedTriggerBindingKind}},
+				Template: &v1beta1.EventListenerTemplate{Ref: ptr.String("tt")},
+			}, {
+				TriggerRef: "referenced",
+			}, {
+				TriggerRef: "missing-trigger",
+			}}
+			el.Spec.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"selected": "true"}}
+			el.Spec.TriggerGroups = []v1beta1.EventListenerTriggerGroup{{
+				Name: "a",
+				Interceptors: []*v1beta1.TriggerInterceptor{{
+					Ref: v1beta1.InterceptorRef{Name: "missing-interceptor", Kind: v1beta1.NamespacedInterceptorKind},
+				}},
+				TriggerSelector: v1beta1.EventListenerTriggerSelector{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: grouped},
+				},
+			}}
+		})},
+		ClusterTriggerBindings: []*v1beta1.ClusterTriggerBinding{{
+			ObjectMeta: metav1.ObjectMeta{Name: "ctb"},
+		}},
+		ClusterInterceptors: []*v1alpha1.ClusterInterceptor{{
+			ObjectMeta: metav1.ObjectMeta{Name: "github"},
+		}},
+		TriggerTemplates: []*v1beta1.TriggerTemplate{{
+			ObjectMeta: metav1.ObjectMeta{Name: "tt", Namespace: namespace},
+		}},
+		Triggers: []*v1beta1.Trigger{
+			makeTrigger("referenced", nil, withTemplate(v1beta1.TriggerSpec{
+				Bindings:     []*v1beta1.TriggerSpecBinding{{Ref: "ctb", Kind: v1beta1.ClusterTriggerBindingKind}},
+				Interceptors: []*v1beta1.TriggerInterceptor{{Ref: v1beta1.InterceptorRef{Name: "github", Kind: v1beta1.ClusterInterceptorKind}}},
+			})),
+			makeTrigger("selected-missing-interceptor", map[string]string{"selected": "true"}, withTemplate(v1beta1.TriggerSpec{
+				Interceptors: []*v1beta1.TriggerInterceptor{{Ref: v1beta1.InterceptorRef{Name: "gitlab", Kind: v1beta1.ClusterInterceptorKind}}},
+			})),
+			makeTrigger("selected-duplicate-params", map[string]string{"selected": "true"}, withTemplate(v1beta1.TriggerSpec{
+				Bindings: []*v1beta1.TriggerSpecBinding{
+					{Name: "p", Value: ptr.String("1")},
+					{Name: "p", Value: ptr.String("2")},
+				},
+			})),
+			makeTrigger("selected-missing-template", map[string]string{"selected": "true"}, v1beta1.TriggerSpec{
+				Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("missing-tt")},
+			}),
+			makeTrigger("grouped", grouped, withTemplate(v1beta1.TriggerSpec{})),
+		},
+	}
+
+	testAssets, cancel := getEventListenerTestAssets(t, resources, nil)
+	defer cancel()
+	if err := testAssets.Controller.Reconciler.Reconcile(context.Background(), reconcileKey); err != nil {
+		t.Fatalf("eventlistener.Reconcile() returned error: %s", err)
+	}
+	el, err := testAssets.Clients.Triggers.TriggersV1beta1().EventListeners(namespace).Get(context.Background(), eventListenerName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []v1beta1.EventListenerTriggerStatus{{
+		Name:      "inline",
+		Namespace: namespace,
+		Reason:    v1beta1.TriggerBindingNotFoundReason,
+		Message:   `TriggerBinding missing-tb: triggerbinding.triggers.tekton.dev "missing-tb" not found`,
+	}, {
+		Name:      "referenced",
+		Namespace: namespace,
+		Resolved:  true,
+	}, {
+		Name:      "missing-trigger",
+		Namespace: namespace,
+		Reason:    v1beta1.TriggerNotFoundReason,
+		Message:   `Trigger missing-trigger: trigger.triggers.tekton.dev "missing-trigger" not found`,
+	}, {
+		Name:      "selected-duplicate-params",
+		Namespace: namespace,
+		Reason:    v1beta1.InvalidTriggerReason,
+		Message:   "failed to resolve bindings: duplicate param name: p",
+	}, {
+		Name:      "selected-missing-interceptor",
+		Namespace: namespace,
+		Reason:    v1beta1.ClusterInterceptorNotFoundReason,
+		Message:   `ClusterInterceptor gitlab: clusterinterceptor.triggers.tekton.dev "gitlab" not found`,
+	}, {
+		Name:      "selected-missing-template",
+		Namespace: namespace,
+		Reason:    v1beta1.TriggerTemplateNotFoundReason,
+		Message:   `TriggerTemplate missing-tt: triggertemplate.triggers.tekton.dev "missing-tt" not found`,
+	}, {
+		Name:         "grouped",
+		Namespace:    namespace,
+		TriggerGroup: "a",
+		Reason:       v1beta1.InterceptorNotFoundReason,
+		Message:      `trigger group a: Interceptor missing-interceptor: interceptor.triggers.tekton.dev "missing-interceptor" not found`,
+	}}
+	if diff := cmp.Diff(want, el.Status.Triggers); diff != "" {
+		t.Errorf("EventListener trigger statuses mismatch. -want/+got: %s", diff)
+	}
+
+	cond := el.Status.GetCondition(v1beta1.TriggersResolved)
+	if cond == nil || cond.Status != corev1.ConditionFalse || cond.Reason != v1beta1.TriggersUnresolvedReason {
+		t.Errorf("expected a false %s condition, got %+v", v1beta1.TriggersResolved, cond)
+	}
+	if ready := el.Status.GetCondition("Ready"); ready == nil || ready.Status != corev1.ConditionTrue {
+		t.Errorf("expected unresolved Triggers to leave the EventListener ready, got %+v", ready)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	clusterinterceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/clusterinterceptor"
+	clustertriggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding"
+	triggerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/trigger"
+	triggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggerbinding"
+	triggertemplateinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate"
+	triggerreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/trigger"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+)
+
+// NewController creates a new instance of a Trigger controller.
+func NewController() func(context.Context, configmap.Watcher) *controller.Impl {
+	return func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+		triggerInformer := triggerinformer.Get(ctx)
+		triggerBindingInformer := triggerbindinginformer.Get(ctx)
+		clusterTriggerBindingInformer := clustertriggerbindinginformer.Get(ctx)
+		triggerTemplateInformer := triggertemplateinformer.Get(ctx)
+		clusterInterceptorInformer := clusterinterceptorinformer.Get(ctx)
+
+		reconciler := &Reconciler{
+			triggerBindingLister:        triggerBindingInformer.Lister(),
+			clusterTriggerBindingLister: clusterTriggerBindingInformer.Lister(),
+			triggerTemplateLister:       triggerTemplateInformer.Lister(),
+			clusterInterceptorLister:    clusterInterceptorInformer.Lister(),
+		}
+
+		impl := triggerreconciler.NewImpl(ctx, reconciler, func(_ *controller.Impl) controller.Options {
+			return controller.Options{
+				AgentName: ControllerName,
+			}
+		})
+
+		if _, err := triggerInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+			logging.FromContext(ctx).Panicf("Couldn't register Trigger informer event handler: %w", err)
+		}
+
+		// Requeue the Triggers referencing an object when it changes, so that
+		// a deleted TriggerTemplate shows up before an event needs it.
+		for kind, informer := range map[string]cache.SharedIndexInformer{
+			"TriggerBinding":        triggerBindingInformer.Informer(),
+			"ClusterTriggerBinding": clusterTriggerBindingInformer.Informer(),
+			"TriggerTemplate":       triggerTemplateInformer.Informer(),
+			"ClusterInterceptor":    clusterInterceptorInformer.Informer(),
+		} {
+			if _, err := informer.AddEventHandler(controller.HandleAll(enqueueReferencingTriggers(impl, triggerInformer.Lister(), kind))); err != nil {
+				logging.FromContext(ctx).Panicf("Couldn't register %s informer event handler: %w", kind, err)
+			}
+		}
+
+		return impl
+	}
+}
+
+// enqueueReferencingTriggers returns a handler that enqueues the Triggers
+// referencing the changed object of the given kind. Namespaced objects can
+// only be referenced by Triggers in the same namespace.
+func enqueueReferencingTriggers(impl *controller.Impl, lister listers.TriggerLister, kind string) func(interface{}) {
+	return func(obj interface{}) {
+		object, err := kmeta.DeletionHandlingAccessor(obj)
+		if err != nil {
+			return
+		}
+		var triggers []*v1beta1.Trigger
+		if object.GetNamespace() == "" {
+			triggers, err = lister.List(labels.Everything())
+		} else {
+			triggers, err = lister.Triggers(object.GetNamespace()).List(labels.Everything())
+		}
+		if err != nil {
+			return
+		}
+		for _, t := range triggers {
+			if references(t, kind, object.GetName()) {
+				impl.Enqueue(t)
+			}
+		}
+	}
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	triggerreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/trigger"
+	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/template"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+// ControllerName defines the name for the Trigger Controller
+const ControllerName = "Trigger"
+
+// Reconciler implements controller.Reconciler for Trigger resources.
+type Reconciler struct {
+	// listers for the objects referenced by Triggers
+	triggerBindingLister        listers.TriggerBindingLister
+	clusterTriggerBindingLister listers.ClusterTriggerBindingLister
+	triggerTemplateLister       listers.TriggerTemplateLister
+	clusterInterceptorLister    listersv1alpha1.ClusterInterceptorLister
+}
+
+var (
+	// Check that our Reconciler implements triggerreconciler.Interface
+	_ triggerreconciler.Interface = (*Reconciler)(nil)
+)
+
+// ReconcileKind resolves the bindings, template and ClusterInterceptors the
+// Trigger references and checks that the params the bindings provide match
+// the params the template declares and references.
+//
+// Namespaced Interceptors are resolved in the namespace of the EventListener
+// processing the Trigger, so they are reported on the EventListener instead.
+func (r *Reconciler) ReconcileKind(ctx context.Context, t *v1beta1.Trigger) pkgreconciler.Event {
+	if err := t.Validate(ctx); err != nil {
+		t.Status.MarkNotReady(v1beta1.InvalidSpecReason, "%s", err.Error())
+		return nil
+	}
+
+	for _, b := range t.Spec.Bindings {
+		switch {
+		case b.Ref == "":
+			continue
+		case b.Kind == v1beta1.ClusterTriggerBindingKind:
+			if _, err := r.clusterTriggerBindingLister.Get(b.Ref); err != nil {
+				t.Status.MarkNotReady(v1beta1.ClusterTriggerBindingNotFoundReason, "ClusterTriggerBinding %s: %s", b.Ref, err)
+				return nil
+			}
+		default:
+			if _, err := r.triggerBindingLister.TriggerBindings(t.Namespace).Get(b.Ref); err != nil {
+				t.Status.MarkNotReady(v1beta1.TriggerBindingNotFoundReason, "TriggerBinding %s: %s", b.Ref, err)
+				return nil
+			}
+		}
+	}
+
+	templateName := "the embedded TriggerTemplate"
+	if t.Spec.Template.Spec == nil {
+		var name string
+		if t.Spec.Template.Ref != nil {
+			name = *t.Spec.Template.Ref
+		}
+		if _, err := r.triggerTemplateLister.TriggerTemplates(t.Namespace).Get(name); err != nil {
+			t.Status.MarkNotReady(v1beta1.TriggerTemplateNotFoundReason, "TriggerTemplate %s: %s", name, err)
+			return nil
+		}
+		templateName = "TriggerTemplate " + name
+	}
+
+	for _, i := range t.Spec.Interceptors {
+		if i.Ref.Name == "" || i.Ref.Kind == v1beta1.NamespacedInterceptorKind {
+			continue
+		}
+		if _, err := r.clusterInterceptorLister.Get(i.Ref.Name); err != nil {
+			t.Status.MarkNotReady(v1beta1.ClusterInterceptorNotFoundReason, "ClusterInterceptor %s: %s", i.Ref.Name, err)
+			return nil
+		}
+	}
+
+	rt, err := template.ResolveTrigger(*t,
+		r.triggerBindingLister.TriggerBindings(t.Namespace).Get,
+		r.clusterTriggerBindingLister.Get,
+		r.triggerTemplateLister.TriggerTemplates(t.Namespace).Get)
+	if err != nil {
+		t.Status.MarkNotReady(v1beta1.InvalidTriggerReason, "%s", err)
+		return nil
+	}
+
+	params := map[template.FindingCode][]string{}
+	for _, f := range template.AnalyzeParams(rt) {
+		params[f.Code] = append(params[f.Code], f.Param)
+	}
+	switch {
+	case len(params[template.MissingParam]) > 0:
+		t.Status.MarkNotReady(v1beta1.MissingParamsReason, "%s declares params without a default that no binding provides: %s",
+			templateName, strings.Join(params[template.MissingParam], ", "))
+	case len(params[template.UndeclaredReference]) > 0:
+		t.Status.MarkNotReady(v1beta1.UndeclaredReferencesReason, "%s references params it doesn't declare: %s",
+			templateName, strings.Join(params[template.UndeclaredReference], ", "))
+	case len(params[template.UndeclaredParam]) > 0:
+		t.Status.MarkReadyWithWarning(v1beta1.UndeclaredParamsReason, "%s doesn't declare params %s, so their values are ignored",
+			templateName, strings.Join(params[template.UndeclaredParam], ", "))
+	case len(params[template.DeprecatedReference]) > 0:
+		t.Status.MarkReadyWithWarning(v1beta1.DeprecatedReferencesReason, "%s references params %s as $(params.NAME), which isn't substituted anymore, use $(tt.params.NAME) instead",
+			templateName, strings.Join(params[template.DeprecatedReference], ", "))
+	default:
+		t.Status.MarkReady()
+	}
+	return nil
+}
+
+// references reports whether the Trigger references the object of the given
+// kind and name.
+func references(t *v1beta1.Trigger, kind, name string) bool {
+	switch kind {
+	case "TriggerBinding", "ClusterTriggerBinding":
+		for _, b := range t.Spec.Bindings {
+			bindingKind := string(b.Kind)
+			if bindingKind == "" {
+				bindingKind = string(v1beta1.NamespacedTriggerBindingKind)
+			}
+			if b.Ref == name && bindingKind == kind {
+				return true
+			}
+		}
+	case "TriggerTemplate":
+		return t.Spec.Template.Ref != nil && *t.Spec.Template.Ref == name
+	case "ClusterInterceptor":
+		for _, i := range t.Spec.Interceptors {
+			if i.Ref.Name == name && i.Ref.Kind != v1beta1.NamespacedInterceptorKind {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+const namespace = "ns"
+
+func indexer(t *testing.T, objs ...runtime.Object) cache.Indexer {
+	t.Helper()
+	i := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, o := range objs {
+		if err := i.Add(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return i
+}
+
+func TestReconcileKind(t *testing.T) {
+	resourceTemplate := v1beta1.TriggerResourceTemplate{RawExtension: runtime.RawExtension{
+		Raw: []byte(`{"apiVersion":"tekton.dev/v1","kind":"TaskRun","metadata":{"generateName":"run-"}}`),
+	}}
+	tt := &v1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tt", Namespace: namespace},
+		Spec: v1beta1.TriggerTemplateSpec{
+			Params: []v1beta1.ParamSpec{
+				{Name: "url"},
+				{Name: "revision", Default: ptr.String("main")},
+			},
+			ResourceTemplates: []v1beta1.TriggerResourceTemplate{resourceTemplate},
+		},
+	}
+	tb := &v1beta1.TriggerBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "tb", Namespace: namespace},
+		Spec: v1beta1.TriggerBindingSpec{
+			Params: []v1beta1.Param{{Name: "url", Value: "$(body.repository.url)"}},
+		},
+	}
+	ctb := &v1beta1.ClusterTriggerBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "ctb"},
+		Spec: v1beta1.TriggerBindingSpec{
+			Params: []v1beta1.Param{{Name: "url", Value: "$(body.repo.url)"}},
+		},
+	}
+	ci := &v1alpha1.ClusterInterceptor{ObjectMeta: metav1.ObjectMeta{Name: "github"}}
+	r := &Reconciler{
+		triggerBindingLister:        listers.NewTriggerBindingLister(indexer(t, tb)),
+		clusterTriggerBindingLister: listers.NewClusterTriggerBindingLister(indexer(t, ctb)),
+		triggerTemplateLister:       listers.NewTriggerTemplateLister(indexer(t, tt)),
+		clusterInterceptorLister:    listersv1alpha1.NewClusterInterceptorLister(indexer(t, ci)),
+	}
+
+	trigger := func(spec v1beta1.TriggerSpec) *v1beta1.Trigger {
+		if spec.Template.Ref == nil && spec.Template.Spec == nil {
+			spec.Template.Ref = ptr.String("tt")
+		}
+		return &v1beta1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "trigger", Namespace: namespace}, Spec: spec}
+	}
+	binding := func(ref string, kind v1beta1.TriggerBindingKind) *v1beta1.TriggerSpecBinding {
+		return &v1beta1.TriggerSpecBinding{Ref: ref, Kind: kind}
+	}
+	value := func(name, value string) *v1beta1.TriggerSpecBinding {
+		return &v1beta1.TriggerSpecBinding{Name: name, Value: ptr.String(value)}
+	}
+
+	tests := []struct {
+		name    string
+		trigger *v1beta1.Trigger
+		want    *apis.Condition
+	}{{
+		name: "ready",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings:     []*v1beta1.TriggerSpecBinding{binding("tb", v1beta1.NamespacedTriggerBindingKind)},
+			Interceptors: []*v1beta1.TriggerInterceptor{{Ref: v1beta1.InterceptorRef{Name: "github", Kind: v1beta1.ClusterInterceptorKind}}},
+		}),
+		want: &apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+	}, {
+		name: "ready with embedded template",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings: []*v1beta1.TriggerSpecBinding{binding("ctb", v1beta1.ClusterTriggerBindingKind)},
+			Template: v1beta1.TriggerSpecTemplate{Spec: &tt.Spec},
+		}),
+		want: &apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+	}, {
+		name:    "invalid spec",
+		trigger: &v1beta1.Trigger{ObjectMeta: metav1.ObjectMeta{Name: "trigger", Namespace: namespace}},
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.InvalidSpecReason,
+			Message: "expected exactly one, got neither: spec.template.ref, spec.template.spec",
+		},
+	}, {
+		name: "missing TriggerBinding",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings: []*v1beta1.TriggerSpecBinding{binding("missing", v1beta1.NamespacedTriggerBindingKind)},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.TriggerBindingNotFoundReason,
+			Message: `TriggerBinding missing: triggerbinding.triggers.tekton.dev "missing" not found`,
+		},
+	}, {
+		name: "missing ClusterTriggerBinding",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings: []*v1beta1.TriggerSpecBinding{binding("missing", v1beta1.ClusterTriggerBindingKind)},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.ClusterTriggerBindingNotFoundReason,
+			Message: `ClusterTriggerBinding missing: clustertriggerbinding.triggers.tekton.dev "missing" not found`,
+		},
+	}, {
+		name: "missing TriggerTemplate",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings: []*v1beta1.TriggerSpecBinding{binding("tb", v1beta1.NamespacedTriggerBindingKind)},
+			Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("missing")},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.TriggerTemplateNotFoundReason,
+			Message: `TriggerTemplate missing: triggertemplate.triggers.tekton.dev "missing" not found`,
+		},
+	}, {
+		name: "missing ClusterInterceptor",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings:     []*v1beta1.TriggerSpecBinding{binding("tb", v1beta1.NamespacedTriggerBindingKind)},
+			Interceptors: []*v1beta1.TriggerInterceptor{{Ref: v1beta1.InterceptorRef{Name: "gitlab", Kind: v1beta1.ClusterInterceptorKind}}},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.ClusterInterceptorNotFoundReason,
+			Message: `ClusterInterceptor gitlab: clusterinterceptor.triggers.tekton.dev "gitlab" not found`,
+		},
+	}, {
+		name: "namespaced Interceptors are left to the EventListener",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings:     []*v1beta1.TriggerSpecBinding{binding("tb", v1beta1.NamespacedTriggerBindingKind)},
+			Interceptors: []*v1beta1.TriggerInterceptor{{Ref: v1beta1.InterceptorRef{Name: "missing", Kind: v1beta1.NamespacedInterceptorKind}}},
+		}),
+		want: &apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue},
+	}, {
+		name: "duplicate params",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings: []*v1beta1.TriggerSpecBinding{
+				binding("tb", v1beta1.NamespacedTriggerBindingKind),
+				binding("ctb", v1beta1.ClusterTriggerBindingKind),
+			},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.InvalidTriggerReason,
+			Message: "failed to resolve bindings: duplicate param name: url",
+		},
+	}, {
+		name:    "missing params",
+		trigger: trigger(v1beta1.TriggerSpec{}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.MissingParamsReason,
+			Message: "TriggerTemplate tt declares params without a default that no binding provides: url",
+		},
+	}, {
+		name: "undeclared params",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Bindings: []*v1beta1.TriggerSpecBinding{
+				binding("tb", v1beta1.NamespacedTriggerBindingKind),
+				value("branch", "$(body.ref)"),
+				value("sha", "$(body.after)"),
+			},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionTrue,
+			Reason:  v1beta1.UndeclaredParamsReason,
+			Message: "TriggerTemplate tt doesn't declare params branch, sha, so their values are ignored",
+		},
+	}, {
+		name: "deprecated references",
+		trigger: trigger(v1beta1.TriggerSpec{
+			Template: v1beta1.TriggerSpecTemplate{Spec: &v1beta1.TriggerTemplateSpec{
+				Params: []v1beta1.ParamSpec{{Name: "revision", Default: ptr.String("main")}},
+				ResourceTemplates: []v1beta1.TriggerResourceTemplate{{RawExtension: runtime.RawExtension{
+					Raw: []byte(`{"apiVersion":"tekton.dev/v1","kind":"TaskRun","metadata":{"generateName":"run-$(params.revision)-"}}`),
+				}}},
+			}},
+		}),
+		want: &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionTrue,
+			Reason:  v1beta1.DeprecatedReferencesReason,
+			Message: "the embedded TriggerTemplate references params revision as $(params.NAME), which isn't substituted anymore, use $(tt.params.NAME) instead",
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := r.ReconcileKind(context.Background(), tc.trigger); err != nil {
+				t.Fatalf("ReconcileKind() returned error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.trigger.Status.GetCondition(apis.ConditionReady),
+				cmpopts.IgnoreFields(apis.Condition{}, "LastTransitionTime", "Severity")); diff != "" {
+				t.Errorf("ReconcileKind() Ready condition mismatch. -want/+got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReferences(t *testing.T) {
+	tr := &v1beta1.Trigger{Spec: v1beta1.TriggerSpec{
+		Bindings: []*v1beta1.TriggerSpecBinding{
+			{Ref: "tb"},
+			{Ref: "ctb", Kind: v1beta1.ClusterTriggerBindingKind},
+		},
+		Template: v1beta1.TriggerSpecTemplate{Ref: ptr.String("tt")},
+		Interceptors: []*v1beta1.TriggerInterceptor{
+			{Ref: v1beta1.InterceptorRef{Name: "github"}},
+			{Ref: v1beta1.InterceptorRef{Name: "local", Kind: v1beta1.NamespacedInterceptorKind}},
+		},
+	}}
+	for _, tc := range []struct {
+		kind, name string
+		want       bool
+	}{
+		{"TriggerBinding", "tb", true},
+		{"TriggerBinding", "ctb", false},
+		{"ClusterTriggerBinding", "ctb", true},
+		{"ClusterTriggerBinding", "tb", false},
+		{"TriggerTemplate", "tt", true},
+		{"TriggerTemplate", "other", false},
+		{"ClusterInterceptor", "github", true},
+		{"ClusterInterceptor", "local", false},
+	} {
+		if got := references(tr, tc.kind, tc.name); got != tc.want {
+			t.Errorf("references(%s, %s) = %t, want %t", tc.kind, tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggerinvocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
+	triggerinvocationreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1alpha1/triggerinvocation"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+const ControllerName = "TriggerInvocation"
+
+// Reconciler deletes TriggerInvocations once their TTL expires.
+type Reconciler struct {
+	TriggersClientSet triggersclientset.Interface
+	// Now returns the current time.
+	Now func() time.Time
+}
+
+var (
+	// Check that our Reconciler implements triggerinvocationreconciler.Interface
+	_ triggerinvocationreconciler.Interface = (*Reconciler)(nil)
+)
+
+func (r *Reconciler) ReconcileKind(ctx context.Context, ti *v1alpha1.TriggerInvocation) pkgreconciler.Event {
+	expiration, ok := ti.ExpirationTime()
+	if !ok {
+		return nil
+	}
+	if remaining := expiration.Sub(r.Now()); remaining > 0 {
+		return controller.NewRequeueAfter(remaining)
+	}
+	logging.FromContext(ctx).Debugf("Deleting TriggerInvocation %s/%s since its TTL expired", ti.Namespace, ti.Name)
+	err := r.TriggersClientSet.TriggersV1alpha1().TriggerInvocations(ti.Namespace).Delete(ctx, ti.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &ti.UID},
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package triggerinvocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	faketriggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestReconcileKind(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		ttl         *metav1.Duration
+		now         time.Time
+		wantDeleted bool
+		wantRequeue time.Duration
+	}{{
+		name: "no ttl",
+		now:  created.Add(1000 * time.Hour),
+	}, {
+		name:        "ttl not expired",
+		ttl:         &metav1.Duration{Duration: time.Hour},
+		now:         created.Add(20 * time.Minute),
+		wantRequeue: 40 * time.Minute,
+	}, {
+		name:        "ttl expired",
+		ttl:         &metav1.Duration{Duration: time.Hour},
+		now:         created.Add(time.Hour),
+		wantDeleted: true,
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ti := &v1alpha1.TriggerInvocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "event-id",
+					Namespace:         "foo",
+					CreationTimestamp: metav1.NewTime(created),
+				},
+				Spec: v1alpha1.TriggerInvocationSpec{EventListener: "el", EventID: "event-id", TTL: tc.ttl},
+			}
+			client := faketriggersclientset.NewSimpleClientset(ti)
+			r := &Reconciler{TriggersClientSet: client, Now: func() time.Time { return tc.now }}
+			ctx := logtesting.TestContextWithLogger(t)
+
+			err := r.ReconcileKind(ctx, ti)
+			if ok, delay := controller.IsRequeueKey(err); ok {
+				if delay != tc.wantRequeue {
+					t.Errorf("ReconcileKind() requeued after %s, want %s", delay, tc.wantRequeue)
+				}
+			} else if err != nil {
+				t.Fatalf("ReconcileKind() returned unexpected error: %v", err)
+			} else if tc.wantRequeue != 0 {
+				t.Errorf("ReconcileKind() did not requeue, want requeue after %s", tc.wantRequeue)
+			}
+
+			_, err = client.TriggersV1alpha1().TriggerInvocations("foo").Get(context.Background(), "event-id", metav1.GetOptions{})
+			if deleted := err != nil; deleted != tc.wantDeleted {
+				t.Errorf("TriggerInvocation deleted = %t, want %t (err: %v)", deleted, tc.wantDeleted, err)
+			}
+		})
+	}
+}
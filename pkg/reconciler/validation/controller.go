@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	clustertriggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding"
+	triggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggerbinding"
+	triggertemplateinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate"
+	clustertriggerbindingreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/clustertriggerbinding"
+	triggerbindingreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/triggerbinding"
+	triggertemplatereconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/triggertemplate"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+)
+
+// NewTriggerBindingController creates a new instance of a TriggerBinding
+// controller.
+func NewTriggerBindingController() func(context.Context, configmap.Watcher) *controller.Impl {
+	return func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+		reconciler := &Reconciler[*v1beta1.TriggerBinding]{status: triggerBindingStatus}
+		impl := triggerbindingreconciler.NewImpl(ctx, reconciler, options("TriggerBinding"))
+		watch(ctx, "TriggerBinding", triggerbindinginformer.Get(ctx).Informer(), impl)
+		return impl
+	}
+}
+
+// NewClusterTriggerBindingController creates a new instance of a
+// ClusterTriggerBinding controller.
+func NewClusterTriggerBindingController() func(context.Context, configmap.Watcher) *controller.Impl {
+	return func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+		reconciler := &Reconciler[*v1beta1.ClusterTriggerBinding]{status: clusterTriggerBindingStatus}
+		impl := clustertriggerbindingreconciler.NewImpl(ctx, reconciler, options("ClusterTriggerBinding"))
+		watch(ctx, "ClusterTriggerBinding", clustertriggerbindinginformer.Get(ctx).Informer(), impl)
+		return impl
+	}
+}
+
+// NewTriggerTemplateController creates a new instance of a TriggerTemplate
+// controller.
+func NewTriggerTemplateController() func(context.Context, configmap.Watcher) *controller.Impl {
+	return func(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+		reconciler := &Reconciler[*v1beta1.TriggerTemplate]{status: triggerTemplateStatus}
+		impl := triggertemplatereconciler.NewImpl(ctx, reconciler, options("TriggerTemplate"))
+		watch(ctx, "TriggerTemplate", triggertemplateinformer.Get(ctx).Informer(), impl)
+		return impl
+	}
+}
+
+// options names the controller of a kind after it.
+func options(kind string) controller.OptionsFn {
+	return func(_ *controller.Impl) controller.Options {
+		return controller.Options{
+			AgentName: kind,
+		}
+	}
+}
+
+// watch enqueues the resources of a kind when they change.
+func watch(ctx context.Context, kind string, informer cache.SharedIndexInformer, impl *controller.Impl) {
+	if _, err := informer.AddEventHandler(controller.HandleAll(impl.Enqueue)); err != nil {
+		logging.FromContext(ctx).Panicf("Couldn't register %s informer event handler: %w", kind, err)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation reconciles the resources whose Ready condition only
+// reports whether they pass validation: TriggerBindings,
+// ClusterTriggerBindings and TriggerTemplates.
+package validation
+
+import (
+	"context"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	clustertriggerbindingreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/clustertriggerbinding"
+	triggerbindingreconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/triggerbinding"
+	triggertemplatereconciler "github.com/tektoncd/triggers/pkg/client/injection/reconciler/triggers/v1beta1/triggertemplate"
+	"knative.dev/pkg/apis"
+	pkgreconciler "knative.dev/pkg/reconciler"
+)
+
+// readyStatus is the status of a resource, which reports whether it is
+// Ready.
+type readyStatus interface {
+	MarkReady()
+	MarkNotReady(reason, messageFormat string, messageA ...interface{})
+}
+
+func triggerBindingStatus(tb *v1beta1.TriggerBinding) readyStatus { return &tb.Status }
+
+func clusterTriggerBindingStatus(ctb *v1beta1.ClusterTriggerBinding) readyStatus { return &ctb.Status }
+
+func triggerTemplateStatus(tt *v1beta1.TriggerTemplate) readyStatus { return &tt.Status }
+
+// Reconciler implements controller.Reconciler for the resources of type T.
+type Reconciler[T apis.Validatable] struct {
+	// status returns the status of a resource
+	status func(T) readyStatus
+}
+
+var (
+	// Check that our Reconciler implements the reconciler interfaces
+	_ triggerbindingreconciler.Interface        = (*Reconciler[*v1beta1.TriggerBinding])(nil)
+	_ clustertriggerbindingreconciler.Interface = (*Reconciler[*v1beta1.ClusterTriggerBinding])(nil)
+	_ triggertemplatereconciler.Interface       = (*Reconciler[*v1beta1.TriggerTemplate])(nil)
+)
+
+// ReconcileKind reports whether the resource passes validation. Objects
+// created before a validation rule was added are otherwise only found to be
+// broken when an event uses them.
+func (r *Reconciler[T]) ReconcileKind(ctx context.Context, obj T) pkgreconciler.Event {
+	status := r.status(obj)
+	if err := obj.Validate(ctx); err != nil {
+		status.MarkNotReady(v1beta1.InvalidSpecReason, "%s", err.Error())
+		return nil
+	}
+	status.MarkReady()
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+)
+
+// reconcileKind reconciles the resource and returns its Ready condition.
+func reconcileKind[T apis.Validatable](t *testing.T, status func(T) readyStatus, obj T) *apis.Condition {
+	t.Helper()
+	r := &Reconciler[T]{status: status}
+	if err := r.ReconcileKind(context.Background(), obj); err != nil {
+		t.Fatalf("ReconcileKind() returned error: %v", err)
+	}
+	return status(obj).(interface {
+		GetCondition(apis.ConditionType) *apis.Condition
+	}).GetCondition(apis.ConditionReady)
+}
+
+func TestReconcileKind(t *testing.T) {
+	taskRun := runtime.RawExtension{
+		Raw: []byte(`{"apiVersion":"tekton.dev/v1","kind":"TaskRun","spec":{"params":[{"name":"url","value":"$(tt.params.url)"}]}}`),
+	}
+	ready := &apis.Condition{Type: apis.ConditionReady, Status: corev1.ConditionTrue}
+	notReady := func(message string) *apis.Condition {
+		return &apis.Condition{
+			Type:    apis.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  v1beta1.InvalidSpecReason,
+			Message: message,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		reconcile func(*testing.T) *apis.Condition
+		want      *apis.Condition
+	}{{
+		name: "valid TriggerBinding",
+		reconcile: func(t *testing.T) *apis.Condition {
+			return reconcileKind(t, triggerBindingStatus, &v1beta1.TriggerBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "tb", Namespace: "ns"},
+				Spec: v1beta1.TriggerBindingSpec{
+					Params: []v1beta1.Param{{Name: "url", Value: "$(body.repository.url)"}},
+				},
+			})
+		},
+		want: ready,
+	}, {
+		name: "invalid TriggerBinding",
+		reconcile: func(t *testing.T) *apis.Condition {
+			return reconcileKind(t, triggerBindingStatus, &v1beta1.TriggerBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "tb", Namespace: "ns"},
+				Spec: v1beta1.TriggerBindingSpec{
+					Params: []v1beta1.Param{{Name: "url", Value: "$(body.repository.url)"}, {Name: "url", Value: "$(body.repo.url)"}},
+				},
+			})
+		},
+		want: notReady("expected exactly one, got both: spec.params[1].name"),
+	}, {
+		name: "valid ClusterTriggerBinding",
+		reconcile: func(t *testing.T) *apis.Condition {
+			return reconcileKind(t, clusterTriggerBindingStatus, &v1beta1.ClusterTriggerBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "ctb"},
+				Spec: v1beta1.TriggerBindingSpec{
+					Params: []v1beta1.Param{{Name: "url", Value: "$(body.repository.url)"}},
+				},
+			})
+		},
+		want: ready,
+	}, {
+		name: "invalid ClusterTriggerBinding",
+		reconcile: func(t *testing.T) *apis.Condition {
+			return reconcileKind(t, clusterTriggerBindingStatus, &v1beta1.ClusterTriggerBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "ctb"},
+				Spec: v1beta1.TriggerBindingSpec{
+					Params: []v1beta1.Param{{Name: "url", Value: "$(body.repository.url)"}, {Name: "url", Value: "$(body.repo.url)"}},
+				},
+			})
+		},
+		want: notReady("expected exactly one, got both: params[1].name"),
+	}, {
+		name: "valid TriggerTemplate",
+		reconcile: func(t *testing.T) *apis.Condition {
+			return reconcileKind(t, triggerTemplateStatus, &v1beta1.TriggerTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "tt", Namespace: "ns"},
+				Spec: v1beta1.TriggerTemplateSpec{
+					Params:            []v1beta1.ParamSpec{{Name: "url"}},
+					ResourceTemplates: []v1beta1.TriggerResourceTemplate{{RawExtension: taskRun}},
+				},
+			})
+		},
+		want: ready,
+	}, {
+		name: "invalid TriggerTemplate",
+		reconcile: func(t *testing.T) *apis.Condition {
+			return reconcileKind(t, triggerTemplateStatus, &v1beta1.TriggerTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "tt", Namespace: "ns"},
+				Spec: v1beta1.TriggerTemplateSpec{
+					ResourceTemplates: []v1beta1.TriggerResourceTemplate{{RawExtension: taskRun}},
+				},
+			})
+		},
+		want: notReady("invalid value: undeclared param '$(tt.params.url)': spec.resourcetemplates[0]\n'$(tt.params.url)' must be declared in spec.params"),
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.reconcile(t),
+				cmpopts.IgnoreFields(apis.Condition{}, "LastTransitionTime", "Severity")); diff != "" {
+				t.Errorf("ReconcileKind() Ready condition mismatch. -want/+got: %s", diff)
+			}
+		})
+	}
+}
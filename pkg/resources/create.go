@@ -19,6 +19,7 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -32,11 +33,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	discoveryclient "k8s.io/client-go/discovery"
 )
 
-// findAPIResource returns the APIResource definition using the discovery client c.
-func findAPIResource(apiVersion, kind string, c discoveryclient.ServerResourcesInterface) (*metav1.APIResource, error) {
+// jsonPatchField is the field of a resource with the JSON patch operations.
+const jsonPatchField = "jsonPatch"
+
+// FindAPIResource returns the APIResource definition using the discovery client c.
+func FindAPIResource(apiVersion, kind string, c discoveryclient.ServerResourcesInterface) (*metav1.APIResource, error) {
 	resourceList, err := c.ServerResourcesForGroupVersion(apiVersion)
 	if err != nil {
 		return nil, fmt.Errorf("error getting kubernetes server resources for apiVersion %s: %w", apiVersion, err)
@@ -58,25 +63,106 @@ func findAPIResource(apiVersion, kind string, c discoveryclient.ServerResourcesI
 		}
 		return r, nil
 	}
-	return nil, fmt.Errorf("error could not find resource with apiVersion %s and kind %s", apiVersion, kind)
+	return nil, fmt.Errorf("error could not find resource with apiVersion %s and kind %s: %w", apiVersion, kind, errAPIResourceNotFound)
 }
 
+// ErrorReason classifies the errors returned when creating resources.
+type ErrorReason string
+
+const (
+	// ErrorReasonSchema is used when the resource is rejected as invalid.
+	ErrorReasonSchema ErrorReason = "Schema"
+	// ErrorReasonRBAC is used when the ServiceAccount is not allowed to create
+	// the resource.
+	ErrorReasonRBAC ErrorReason = "RBAC"
+	// ErrorReasonConflict is used when the resource already exists or was
+	// modified concurrently.
+	ErrorReasonConflict ErrorReason = "Conflict"
+	// ErrorReasonNotFound is used when the kind of the resource or its
+	// namespace can't be found.
+	ErrorReasonNotFound ErrorReason = "NotFound"
+	// ErrorReasonTransient is used when the API server timed out, throttled
+	// the request or was unavailable, so that creating the resource again may
+	// succeed.
+	ErrorReasonTransient ErrorReason = "Transient"
+	// ErrorReasonUnknown is used for all other errors.
+	ErrorReasonUnknown ErrorReason = "Unknown"
+)
+
+// Retryable returns true if the error may not occur again when the resource is
+// created again.
+func (r ErrorReason) Retryable() bool {
+	return r == ErrorReasonTransient
+}
+
+// errAPIResourceNotFound is returned when the discovery client has no
+// APIResource for the kind of a resource.
+var errAPIResourceNotFound = errors.New("API resource not found")
+
+// ClassifyError returns the ErrorReason for an error returned by Create or
+// Validate.
+func ClassifyError(err error) ErrorReason {
+	switch {
+	case err == nil:
+		return ""
+	case kerrors.IsInvalid(err), kerrors.IsBadRequest(err):
+		return ErrorReasonSchema
+	case kerrors.IsUnauthorized(err), kerrors.IsForbidden(err):
+		return ErrorReasonRBAC
+	case kerrors.IsAlreadyExists(err), kerrors.IsConflict(err):
+		return ErrorReasonConflict
+	case kerrors.IsNotFound(err), errors.Is(err, errAPIResourceNotFound):
+		return ErrorReasonNotFound
+	case kerrors.IsServerTimeout(err), kerrors.IsTimeout(err), kerrors.IsTooManyRequests(err),
+		kerrors.IsServiceUnavailable(err), kerrors.IsInternalError(err):
+		return ErrorReasonTransient
+	default:
+		return ErrorReasonUnknown
+	}
+}
+
+// FieldManager is the field manager used for the resources applied and patched
+// by Triggers.
+const FieldManager = "tekton-triggers"
+
 // Create uses the kubeClient to create the resource defined in the
-// TriggerResourceTemplate and returns any errors with this process
-func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) error {
+// TriggerResourceTemplate and returns the resource returned by the API server,
+// or any errors with this process.
+//
+// The action annotation of the resource selects whether the resource is
+// created, applied, patched or deleted instead. The resource of the template
+// is returned for deleted resources.
+func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) (*unstructured.Unstructured, error) {
+	return execute(logger, rt, triggerName, eventID, elName, elNamespace, c, dc, false)
+}
+
+// Validate uses a server-side dry run to check that the action for the
+// resource defined in the TriggerResourceTemplate would succeed in Create,
+// without persisting it. This validates the resource against the schema of
+// its kind, admission webhooks and the RBAC rules of the client.
+func Validate(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface) error {
+	_, err := execute(logger, rt, triggerName, eventID, elName, elNamespace, c, dc, true)
+	return err
+}
+
+func execute(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID, elName, elNamespace string, c discoveryclient.ServerResourcesInterface, dc dynamic.Interface, dryRun bool) (*unstructured.Unstructured, error) {
 	// Assume the TriggerResourceTemplate is valid (it has an apiVersion and Kind)
 	data := new(unstructured.Unstructured)
 	if err := data.UnmarshalJSON(rt); err != nil {
-		return fmt.Errorf("couldn't unmarshal json from the TriggerTemplate: %w", err)
+		return nil, fmt.Errorf("couldn't unmarshal json from the TriggerTemplate: %w", err)
 	}
 
-	data, err := addLabels(data, map[string]string{
-		triggers.EventListenerLabelKey: elName,
-		triggers.EventIDLabelKey:       eventID,
-		triggers.TriggerLabelKey:       triggerName,
-	})
-	if err != nil {
-		return err
+	action, patchType := extractActionAnnotations(data)
+	if action == triggers.CreateAction || action == triggers.ApplyAction {
+		var err error
+		data, err = addLabels(data, map[string]string{
+			triggers.EventListenerLabelKey: elName,
+			triggers.EventIDLabelKey:       eventID,
+			triggers.TriggerLabelKey:       triggerName,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	namespace := data.GetNamespace()
@@ -86,16 +172,18 @@ func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID,
 	}
 
 	// Resolve resource kind to the underlying API Resource type.
-	apiResource, err := findAPIResource(data.GetAPIVersion(), data.GetKind(), c)
+	apiResource, err := FindAPIResource(data.GetAPIVersion(), data.GetKind(), c)
 	if err != nil {
-		return fmt.Errorf("couldn't find API resource for json: %w", err)
+		return nil, fmt.Errorf("couldn't find API resource for json: %w", err)
 	}
 
 	name := data.GetName()
 	if name == "" {
+		if action != triggers.CreateAction {
+			return nil, fmt.Errorf("resource of kind %s must have a name to %s it", data.GetKind(), action)
+		}
 		name = data.GetGenerateName()
 	}
-	logger.Infof("Generating resource: kind: %s, name: %s", apiResource, name)
 
 	gvr := schema.GroupVersionResource{
 		Group:    apiResource.Group,
@@ -103,15 +191,82 @@ func Create(logger *zap.SugaredLogger, rt json.RawMessage, triggerName, eventID,
 		Resource: apiResource.Name,
 	}
 
-	logger.Infof("For event ID %q creating resource %v", eventID, gvr)
+	var dryRunOpt []string
+	if dryRun {
+		dryRunOpt = []string{metav1.DryRunAll}
+		logger.Debugf("For event ID %q validating %s of resource %v with name %s", eventID, action, gvr, name)
+	} else {
+		logger.Infof("Generating resource: kind: %s, name: %s", apiResource, name)
+		logger.Infof("For event ID %q performing %s of resource %v", eventID, action, gvr)
+	}
 
-	if _, err := dc.Resource(gvr).Namespace(namespace).Create(context.Background(), data, metav1.CreateOptions{}); err != nil {
+	ri := dc.Resource(gvr).Namespace(namespace)
+	var obj *unstructured.Unstructured
+	switch action {
+	case triggers.ApplyAction:
+		obj, err = ri.Apply(context.Background(), name, data, metav1.ApplyOptions{FieldManager: FieldManager, Force: true, DryRun: dryRunOpt})
+	case triggers.PatchAction:
+		var body []byte
+		var pt types.PatchType
+		if body, pt, err = patchBody(data, patchType); err != nil {
+			return nil, err
+		}
+		obj, err = ri.Patch(context.Background(), name, pt, body, metav1.PatchOptions{FieldManager: FieldManager, DryRun: dryRunOpt})
+	case triggers.DeleteAction:
+		err = ri.Delete(context.Background(), name, metav1.DeleteOptions{DryRun: dryRunOpt})
+		data.SetNamespace(namespace)
+		obj = data
+	default:
+		obj, err = ri.Create(context.Background(), data, metav1.CreateOptions{DryRun: dryRunOpt})
+	}
+	if err != nil {
+		if dryRun {
+			return nil, fmt.Errorf("validation of resource with group version kind %q failed: %w", gvr, err)
+		}
 		if kerrors.IsUnauthorized(err) || kerrors.IsForbidden(err) {
-			return err
+			return nil, err
+		}
+		return nil, fmt.Errorf("couldn't %s resource with group version kind %q: %w", action, gvr, err)
+	}
+	return obj, nil
+}
+
+// extractActionAnnotations removes the action and patch type annotations from
+// the resource and returns their values, defaulting to create and merge.
+func extractActionAnnotations(data *unstructured.Unstructured) (string, string) {
+	annotations := data.GetAnnotations()
+	action, patchType := annotations[triggers.ActionAnnotation], annotations[triggers.PatchTypeAnnotation]
+	if _, ok := annotations[triggers.ActionAnnotation]; ok {
+		delete(annotations, triggers.ActionAnnotation)
+		delete(annotations, triggers.PatchTypeAnnotation)
+		if len(annotations) == 0 {
+			annotations = nil
 		}
-		return fmt.Errorf("couldn't create resource with group version kind %q: %w", gvr, err)
+		data.SetAnnotations(annotations)
+	}
+	if action == "" {
+		action = triggers.CreateAction
+	}
+	if patchType == "" {
+		patchType = triggers.MergePatchType
+	}
+	return action, patchType
+}
+
+// patchBody returns the patch for a resource with the patch action. A merge
+// patch is the resource itself, while a JSON patch is the list of operations
+// in the jsonPatch field of the resource.
+func patchBody(data *unstructured.Unstructured, patchType string) ([]byte, types.PatchType, error) {
+	if patchType != triggers.JSONPatchType {
+		body, err := data.MarshalJSON()
+		return body, types.MergePatchType, err
+	}
+	ops, ok := data.Object[jsonPatchField].([]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("resource of kind %s with %s patch type must have a %s list", data.GetKind(), triggers.JSONPatchType, jsonPatchField)
 	}
-	return nil
+	body, err := json.Marshal(ops)
+	return body, types.JSONPatchType, err
 }
 
 // addLabels adds autogenerated Tekton labels to created resources.
@@ -18,6 +18,7 @@ package resources
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -27,13 +28,16 @@ import (
 	pipelinev1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
 	"github.com/tektoncd/triggers/test"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	fakedynamic "k8s.io/client-go/dynamic/fake"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
 )
 
 const (
@@ -46,8 +50,8 @@ const (
 
 func Test_FindAPIResource_error(t *testing.T) {
 	dc := fakekubeclientset.NewSimpleClientset().Discovery()
-	if _, err := findAPIResource("v1", "Pod", dc); err == nil {
-		t.Error("findAPIResource() did not return error when expected")
+	if _, err := FindAPIResource("v1", "Pod", dc); err == nil {
+		t.Error("FindAPIResource() did not return error when expected")
 	}
 }
 
@@ -115,11 +119,11 @@ func TestFindAPIResource(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%s_%s", tt.apiVersion, tt.kind), func(t *testing.T) {
-			got, err := findAPIResource(tt.apiVersion, tt.kind, dc)
+			got, err := FindAPIResource(tt.apiVersion, tt.kind, dc)
 			if err != nil {
-				t.Errorf("findAPIResource() returned error: %s", err)
+				t.Errorf("FindAPIResource() returned error: %s", err)
 			} else if diff := cmp.Diff(tt.want, got); diff != "" {
-				t.Errorf("findAPIResource() Diff: -want +got: %s", diff)
+				t.Errorf("FindAPIResource() Diff: -want +got: %s", diff)
 			}
 		})
 	}
@@ -196,8 +200,12 @@ func TestCreateResource(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			dynamicClient.ClearActions()
-			if err := Create(logger.Sugar(), tt.json, triggerName, eventID, elName, elNamespace, kubeClient.Discovery(), dynamicClient); err != nil {
-				t.Errorf("createTaskRun() returned error: %s", err)
+			obj, err := Create(logger.Sugar(), tt.json, triggerName, eventID, elName, elNamespace, kubeClient.Discovery(), dynamicClient)
+			if err != nil {
+				t.Fatalf("createTaskRun() returned error: %s", err)
+			}
+			if obj.GetName() != tt.want.Name {
+				t.Errorf("createTaskRun() returned %q, want %q", obj.GetName(), tt.want.Name)
 			}
 
 			gvr := schema.GroupVersionResource{
@@ -218,6 +226,236 @@ func TestCreateResource(t *testing.T) {
 	}
 }
 
+func TestCreateResource_Actions(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	kubeClient.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Version: "v1"}},
+	}}
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	logger := zaptest.NewLogger(t)
+	labels := `"labels": {"triggers.tekton.dev/eventlistener": "foo-el", "triggers.tekton.dev/trigger": "trigger", "triggers.tekton.dev/triggers-eventid": "12345"}`
+
+	tests := []struct {
+		name          string
+		json          string
+		wantVerb      string
+		wantPatchType types.PatchType
+		wantBody      string
+		wantOpts      metav1.PatchOptions
+	}{{
+		name:          "apply",
+		json:          `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "apply"}}, "data": {"a": "b"}}`,
+		wantVerb:      "patch",
+		wantPatchType: types.ApplyPatchType,
+		wantBody:      `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", ` + labels + `}, "data": {"a": "b"}}`,
+		wantOpts:      metav1.PatchOptions{FieldManager: FieldManager, Force: ptr.Bool(true)},
+	}, {
+		name:          "merge patch",
+		json:          `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"a": "b", "triggers.tekton.dev/action": "patch"}}, "data": {"a": null}}`,
+		wantVerb:      "patch",
+		wantPatchType: types.MergePatchType,
+		wantBody:      `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"a": "b"}}, "data": {"a": null}}`,
+		wantOpts:      metav1.PatchOptions{FieldManager: FieldManager},
+	}, {
+		name:          "json patch",
+		json:          `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "patch", "triggers.tekton.dev/patch-type": "json"}}, "jsonPatch": [{"op": "remove", "path": "/data/a"}]}`,
+		wantVerb:      "patch",
+		wantPatchType: types.JSONPatchType,
+		wantBody:      `[{"op": "remove", "path": "/data/a"}]`,
+		wantOpts:      metav1.PatchOptions{FieldManager: FieldManager},
+	}, {
+		name:     "delete",
+		json:     `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "delete"}}}`,
+		wantVerb: "delete",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+			dynamicClient.PrependReactor("*", "*", func(ktesting.Action) (bool, runtime.Object, error) {
+				return true, nil, nil
+			})
+			if _, err := Create(logger.Sugar(), json.RawMessage(tt.json), triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient); err != nil {
+				t.Fatalf("Create() returned error: %s", err)
+			}
+			actions := dynamicClient.Actions()
+			if len(actions) != 1 {
+				t.Fatalf("Create() performed %d actions, want 1", len(actions))
+			}
+			a := actions[0]
+			if a.GetVerb() != tt.wantVerb || a.GetResource() != gvr || a.GetNamespace() != "foo" {
+				t.Fatalf("Create() performed %s of %s in %s, want %s of %s in foo", a.GetVerb(), a.GetResource(), a.GetNamespace(), tt.wantVerb, gvr)
+			}
+			if tt.wantVerb == "delete" {
+				if name := a.(ktesting.DeleteAction).GetName(); name != "cm" {
+					t.Errorf("Create() deleted %q, want cm", name)
+				}
+				return
+			}
+			patch := a.(ktesting.PatchActionImpl)
+			if patch.GetPatchType() != tt.wantPatchType {
+				t.Errorf("Create() patch type = %s, want %s", patch.GetPatchType(), tt.wantPatchType)
+			}
+			if diff := cmp.Diff(tt.wantOpts, patch.PatchOptions); diff != "" {
+				t.Errorf("Create() patch options -want +got: %s", diff)
+			}
+			var got, want interface{}
+			if err := json.Unmarshal(patch.GetPatch(), &got); err != nil {
+				t.Fatalf("invalid patch %s: %s", patch.GetPatch(), err)
+			}
+			if err := json.Unmarshal([]byte(tt.wantBody), &want); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("Create() patch -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateResource_ActionError(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	kubeClient.Resources = []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Version: "v1"}},
+	}}
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	logger := zaptest.NewLogger(t)
+
+	tests := []struct {
+		name    string
+		json    string
+		wantErr string
+	}{{
+		name:    "apply without name",
+		json:    `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"generateName": "cm-", "annotations": {"triggers.tekton.dev/action": "apply"}}}`,
+		wantErr: "resource of kind ConfigMap must have a name to apply it",
+	}, {
+		name:    "json patch without operations",
+		json:    `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "patch", "triggers.tekton.dev/patch-type": "json"}}}`,
+		wantErr: "resource of kind ConfigMap with json patch type must have a jsonPatch list",
+	}, {
+		name:    "delete missing resource",
+		json:    `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm", "annotations": {"triggers.tekton.dev/action": "delete"}}}`,
+		wantErr: `couldn't delete resource with group version kind "/v1, Resource=configmaps": configmaps "cm" not found`,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Create(logger.Sugar(), json.RawMessage(tt.json), triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Create() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateResource(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	test.AddTektonResources(kubeClient)
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	logger := zaptest.NewLogger(t)
+
+	rt := json.RawMessage(`{"kind":"TaskRun","apiVersion":"tekton.dev/v1beta1","metadata":{"name":"my-taskrun","creationTimestamp":null},"spec":{"serviceAccountName":"","taskRef":{"name":"my-task"}},"status":{"podName":""}}`)
+	if err := Validate(logger.Sugar(), rt, triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient); err != nil {
+		t.Fatalf("Validate() returned error: %s", err)
+	}
+
+	want := pipelinev1.TaskRun{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "tekton.dev/v1beta1",
+			Kind:       "TaskRun",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-taskrun",
+			Labels: map[string]string{
+				resourceLabel: "foo-el",
+				triggerLabel:  triggerName,
+				eventIDLabel:  eventID,
+			},
+		},
+		Spec: pipelinev1.TaskRunSpec{
+			TaskRef: &pipelinev1.TaskRef{Name: "my-task"},
+		},
+	}
+	gvr := schema.GroupVersionResource{Group: "tekton.dev", Version: "v1beta1", Resource: "taskruns"}
+	wantActions := []ktesting.Action{ktesting.NewCreateActionWithOptions(gvr, "foo", test.ToUnstructured(t, want), metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})}
+	if diff := cmp.Diff(wantActions, dynamicClient.Actions()); diff != "" {
+		t.Errorf("Validate() actions: -want +got: %s", diff)
+	}
+}
+
+func TestValidateResource_UnknownKind(t *testing.T) {
+	kubeClient := fakekubeclientset.NewSimpleClientset()
+	dynamicClient := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	rt := json.RawMessage(`{"kind":"TaskRun","apiVersion":"tekton.dev/v1beta1","metadata":{"name":"my-taskrun"}}`)
+	err := Validate(zaptest.NewLogger(t).Sugar(), rt, triggerName, eventID, "foo-el", "foo", kubeClient.Discovery(), dynamicClient)
+	if got := ClassifyError(err); got != ErrorReasonNotFound {
+		t.Errorf("ClassifyError(%v) = %q, want %q", err, got, ErrorReasonNotFound)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	gr := schema.GroupResource{Group: "tekton.dev", Resource: "taskruns"}
+	tests := []struct {
+		name string
+		err  error
+		want ErrorReason
+	}{{
+		name: "no error",
+	}, {
+		name: "invalid",
+		err:  kerrors.NewInvalid(schema.GroupKind{Group: "tekton.dev", Kind: "TaskRun"}, "tr", nil),
+		want: ErrorReasonSchema,
+	}, {
+		name: "bad request",
+		err:  fmt.Errorf("wrapped: %w", kerrors.NewBadRequest("strict decoding error")),
+		want: ErrorReasonSchema,
+	}, {
+		name: "forbidden",
+		err:  kerrors.NewForbidden(gr, "tr", errors.New("no")),
+		want: ErrorReasonRBAC,
+	}, {
+		name: "unauthorized",
+		err:  kerrors.NewUnauthorized("no"),
+		want: ErrorReasonRBAC,
+	}, {
+		name: "already exists",
+		err:  fmt.Errorf("wrapped: %w", kerrors.NewAlreadyExists(gr, "tr")),
+		want: ErrorReasonConflict,
+	}, {
+		name: "conflict",
+		err:  kerrors.NewConflict(gr, "tr", errors.New("modified")),
+		want: ErrorReasonConflict,
+	}, {
+		name: "namespace not found",
+		err:  kerrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "foo"),
+		want: ErrorReasonNotFound,
+	}, {
+		name: "server timeout",
+		err:  fmt.Errorf("wrapped: %w", kerrors.NewServerTimeout(gr, "create", 1)),
+		want: ErrorReasonTransient,
+	}, {
+		name: "too many requests",
+		err:  kerrors.NewTooManyRequests("throttled", 1),
+		want: ErrorReasonTransient,
+	}, {
+		name: "service unavailable",
+		err:  kerrors.NewServiceUnavailable("unavailable"),
+		want: ErrorReasonTransient,
+	}, {
+		name: "unknown",
+		err:  errors.New("connection refused"),
+		want: ErrorReasonUnknown,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyError(tt.err); got != tt.want {
+				t.Errorf("ClassifyError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_AddLabels(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cloudeventsevent "github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+// incomingEvent is an event of a request processed by the Triggers.
+type incomingEvent struct {
+	header http.Header
+	body   []byte
+	// payload is the body decoded by the payload decoders of the
+	// EventListener, which is processed by the Triggers.
+	payload []byte
+	// id is the ID of the CloudEvent, if the request holds CloudEvents.
+	id string
+	// err is why a CloudEvent of a batch is invalid, in which case it isn't
+	// processed.
+	err error
+}
+
+// decodeEvents returns the events of a request, and whether it holds a batch
+// of CloudEvents. Otherwise, it holds a single event. CloudEvents in structured
+// and batch modes are converted to binary mode, where the body is the data of
+// the event and its attributes are ce- headers, so that the Triggers process
+// them the same way.
+func decodeEvents(ctx context.Context, header http.Header, body []byte) ([]incomingEvent, bool, error) {
+	msg := cehttp.NewMessage(header, io.NopCloser(bytes.NewReader(body)))
+	switch msg.ReadEncoding() {
+	case binding.EncodingBinary:
+		return []incomingEvent{{header: header, body: body, id: header.Get("Ce-Id")}}, false, nil
+	case binding.EncodingStructured:
+		e, err := binding.ToEvent(ctx, msg)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid structured CloudEvent: %w", err)
+		}
+		if err := e.Validate(); err != nil {
+			return nil, false, fmt.Errorf("invalid structured CloudEvent: %w", err)
+		}
+		in, err := binaryEvent(ctx, header, *e)
+		if err != nil {
+			return nil, false, err
+		}
+		return []incomingEvent{in}, false, nil
+	case binding.EncodingBatch:
+		batch, err := binding.ToEvents(ctx, msg, msg.BodyReader)
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid batch of CloudEvents: %w", err)
+		}
+		out := make([]incomingEvent, 0, len(batch))
+		for _, e := range batch {
+			if err := e.Validate(); err != nil {
+				out = append(out, incomingEvent{id: e.ID(), err: fmt.Errorf("invalid CloudEvent: %w", err)})
+				continue
+			}
+			in, err := binaryEvent(ctx, header, e)
+			if err != nil {
+				in = incomingEvent{id: e.ID(), err: err}
+			}
+			out = append(out, in)
+		}
+		return out, true, nil
+	default:
+		return []incomingEvent{{header: header, body: body}}, false, nil
+	}
+}
+
+// binaryEvent returns the CloudEvent in binary mode, with the headers of the
+// request that aren't about its body.
+func binaryEvent(ctx context.Context, header http.Header, e cloudeventsevent.Event) (incomingEvent, error) {
+	ctx = binding.WithForceBinary(ctx)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	if err != nil {
+		return incomingEvent{}, err
+	}
+	if err := cehttp.WriteRequest(ctx, (*binding.EventMessage)(&e), req); err != nil {
+		return incomingEvent{}, fmt.Errorf("failed to convert CloudEvent %s to binary mode: %w", e.ID(), err)
+	}
+	var body []byte
+	if req.Body != nil {
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return incomingEvent{}, err
+		}
+	}
+	out := header.Clone()
+	out.Del("Content-Type")
+	out.Del("Content-Length")
+	for k, v := range req.Header {
+		out[k] = v
+	}
+	return incomingEvent{header: out, body: body, id: e.ID()}, nil
+}
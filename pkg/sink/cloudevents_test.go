@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/ptr"
+)
+
+// decodedEvent is an incomingEvent with the headers of its CloudEvent.
+type decodedEvent struct {
+	ID     string
+	Header map[string]string
+	Body   string
+	Err    string
+}
+
+func TestDecodeEvents(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		header    http.Header
+		body      string
+		want      []decodedEvent
+		wantBatch bool
+	}{{
+		name:   "not a CloudEvent",
+		header: http.Header{"Content-Type": {"application/json"}, "X-Github-Event": {"push"}},
+		body:   `{"ref": "main"}`,
+		want: []decodedEvent{{
+			Header: map[string]string{"Content-Type": "application/json", "X-Github-Event": "push"},
+			Body:   `{"ref": "main"}`,
+		}},
+	}, {
+		name: "binary",
+		header: http.Header{
+			"Content-Type":   {"application/json"},
+			"Ce-Specversion": {"1.0"},
+			"Ce-Id":          {"1"},
+			"Ce-Type":        {"push"},
+			"Ce-Source":      {"/repo"},
+		},
+		body: `{"ref": "main"}`,
+		want: []decodedEvent{{
+			ID: "1",
+			Header: map[string]string{
+				"Content-Type":   "application/json",
+				"Ce-Specversion": "1.0",
+				"Ce-Id":          "1",
+				"Ce-Type":        "push",
+				"Ce-Source":      "/repo",
+			},
+			Body: `{"ref": "main"}`,
+		}},
+	}, {
+		name:   "structured",
+		header: http.Header{"Content-Type": {"application/cloudevents+json"}, "X-Request-Id": {"abc"}},
+		body:   `{"specversion": "1.0", "id": "1", "type": "push", "source": "/repo", "subject": "main", "myextension": "value", "datacontenttype": "application/json", "data": {"ref": "main"}}`,
+		want: []decodedEvent{{
+			ID: "1",
+			Header: map[string]string{
+				"Content-Type":   "application/json",
+				"Ce-Specversion": "1.0",
+				"Ce-Id":          "1",
+				"Ce-Type":        "push",
+				"Ce-Source":      "/repo",
+				"Ce-Subject":     "main",
+				"Ce-Myextension": "value",
+				"X-Request-Id":   "abc",
+			},
+			Body: ` {"ref": "main"}`,
+		}},
+	}, {
+		name:   "structured with base64 data",
+		header: http.Header{"Content-Type": {"application/cloudevents+json"}},
+		body:   `{"specversion": "1.0", "id": "1", "type": "push", "source": "/repo", "datacontenttype": "text/plain", "data_base64": "aGVsbG8="}`,
+		want: []decodedEvent{{
+			ID: "1",
+			Header: map[string]string{
+				"Content-Type":   "text/plain",
+				"Ce-Specversion": "1.0",
+				"Ce-Id":          "1",
+				"Ce-Type":        "push",
+				"Ce-Source":      "/repo",
+			},
+			Body: "hello",
+		}},
+	}, {
+		name:   "batch",
+		header: http.Header{"Content-Type": {"application/cloudevents-batch+json"}},
+		body: `[
+			{"specversion": "1.0", "id": "1", "type": "push", "source": "/repo", "datacontenttype": "application/json", "data": {"ref": "main"}},
+			{"specversion": "1.0", "id": "2", "source": "/repo"}
+		]`,
+		want: []decodedEvent{{
+			ID: "1",
+			Header: map[string]string{
+				"Content-Type":   "application/json",
+				"Ce-Specversion": "1.0",
+				"Ce-Id":          "1",
+				"Ce-Type":        "push",
+				"Ce-Source":      "/repo",
+			},
+			Body: ` {"ref": "main"}`,
+		}, {
+			ID:  "2",
+			Err: "invalid CloudEvent: type: MUST be a non-empty string\n",
+		}},
+		wantBatch: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, batch, err := decodeEvents(context.Background(), tc.header, []byte(tc.body))
+			if err != nil {
+				t.Fatalf("decodeEvents() = %v", err)
+			}
+			if batch != tc.wantBatch {
+				t.Errorf("decodeEvents() batch = %t, want %t", batch, tc.wantBatch)
+			}
+			decoded := make([]decodedEvent, 0, len(got))
+			for _, in := range got {
+				d := decodedEvent{ID: in.id, Body: string(in.body)}
+				if in.header != nil {
+					d.Header = map[string]string{}
+					for k := range in.header {
+						d.Header[k] = in.header.Get(k)
+					}
+				}
+				if in.err != nil {
+					d.Err = in.err.Error()
+				}
+				decoded = append(decoded, d)
+			}
+			if diff := cmp.Diff(tc.want, decoded); diff != "" {
+				t.Errorf("decodeEvents() -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeEvents_Error(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header http.Header
+		body   string
+	}{{
+		name:   "structured without an ID",
+		header: http.Header{"Content-Type": {"application/cloudevents+json"}},
+		body:   `{"specversion": "1.0", "type": "push", "source": "/repo"}`,
+	}, {
+		name:   "malformed structured",
+		header: http.Header{"Content-Type": {"application/cloudevents+json"}},
+		body:   `{"specversion": `,
+	}, {
+		name:   "malformed batch",
+		header: http.Header{"Content-Type": {"application/cloudevents-batch+json"}},
+		body:   `{"specversion": "1.0"}`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := decodeEvents(context.Background(), tc.header, []byte(tc.body)); err == nil {
+				t.Error("decodeEvents() expected an error")
+			}
+		})
+	}
+}
+
+func TestHandleEvent_CloudEventBatch(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "push", Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(ce.subject)")},
+				{Name: "name", Value: ptr.String("run-$(ce.id)")},
+				{Name: "app", Value: ptr.String("$(ce.source)")},
+				{Name: "type", Value: ptr.String("$(ce.type)")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+		},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "push"}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners:   []*triggersv1beta1.EventListener{el},
+		Triggers:         []*triggersv1beta1.Trigger{tr},
+		TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+	}, el.Name, nil)
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+
+	batch := `[
+		{"specversion": "1.0", "id": "1", "type": "push", "source": "repo", "subject": "main", "data": {"repository": {"url": "testurl"}}},
+		{"specversion": "1.0", "id": "2", "source": "repo"},
+		{"specversion": "1.0", "id": "3", "type": "push", "source": "repo", "subject": "dev", "data": {"repository": {"url": "testurl"}}}
+	]`
+	resp, err := http.Post(ts.URL, "application/cloudevents-batch+json", bytes.NewReader([]byte(batch)))
+	if err != nil {
+		t.Fatalf("error making request to eventListener: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Response code doesn't match: got %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+	got := Response{}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("failed to decode the response: %v", err)
+	}
+	want := []EventResponse{
+		{ID: "1", EventID: eventID},
+		{ID: "2", ErrorMessage: "invalid CloudEvent: type: MUST be a non-empty string\n"},
+		{ID: "3", EventID: eventID},
+	}
+	if diff := cmp.Diff(want, got.Events); diff != "" {
+		t.Errorf("Response events -want +got: %s", diff)
+	}
+
+	sink.WGProcessTriggers.Wait()
+	var runs []string
+	for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+		runs = append(runs, tr.Name+" "+tr.Labels["app"]+" "+tr.Labels["type"]+" "+tr.Spec.Params[1].Value.StringVal)
+	}
+	sort.Strings(runs)
+	if diff := cmp.Diff([]string{"run-1 repo push main", "run-3 repo push dev"}, runs); diff != "" {
+		t.Errorf("Created TaskRuns -want +got: %s", diff)
+	}
+}
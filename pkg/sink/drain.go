@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+)
+
+// processInBackground processes an event in the background after it was
+// received, tracked so that it is drained when the EventListener shuts down.
+func (r Sink) processInBackground(process func()) {
+	done := r.trackEvent()
+	go func() {
+		defer done()
+		process()
+	}()
+}
+
+// trackEvent tracks an event being processed until the returned function is
+// called, so that it is drained when the EventListener shuts down, or counted
+// as incomplete.
+func (r Sink) trackEvent() func() {
+	r.WGProcessTriggers.Add(1)
+	if r.PendingEvents != nil {
+		r.PendingEvents.Add(1)
+	}
+	return func() {
+		if r.PendingEvents != nil {
+			r.PendingEvents.Add(-1)
+		}
+		r.WGProcessTriggers.Done()
+	}
+}
+
+// Drain waits for the events being processed to be done and for the
+// CloudEvents being sent to be flushed, until ctx is done. It returns the
+// number of events left incomplete, which are logged and recorded in the
+// eventlistener_events_incomplete_total metric. The EventListener must have
+// stopped receiving events, and its event sources must have stopped, so that
+// no event starts being processed while it drains.
+func (r Sink) Drain(ctx context.Context) int64 {
+	done := make(chan struct{})
+	go func() {
+		r.WGProcessTriggers.Wait()
+		if r.WGCloudEvents != nil {
+			r.WGCloudEvents.Wait()
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return 0
+	case <-ctx.Done():
+	}
+	var incomplete int64
+	if r.PendingEvents != nil {
+		incomplete = r.PendingEvents.Load()
+	}
+	r.Logger.Errorf("timed out draining the EventListener, %d events were left incomplete", incomplete)
+	r.recordIncompleteEvents(incomplete)
+	return incomplete
+}
+
+// sendInBackground sends a CloudEvent in the background, tracked so that it
+// is flushed when the EventListener shuts down.
+func (r Sink) sendInBackground(send func()) {
+	if r.WGCloudEvents == nil {
+		go send()
+		return
+	}
+	r.WGCloudEvents.Add(1)
+	go func() {
+		defer r.WGCloudEvents.Done()
+		send()
+	}()
+}
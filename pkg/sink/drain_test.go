@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+)
+
+func TestDrain(t *testing.T) {
+	if _, err := NewRecorder(); err != nil {
+		t.Fatal(err)
+	}
+	r := Sink{
+		Logger:            zaptest.NewLogger(t).Sugar(),
+		WGProcessTriggers: &sync.WaitGroup{},
+		WGCloudEvents:     &sync.WaitGroup{},
+		PendingEvents:     &atomic.Int64{},
+	}
+
+	release := make(chan struct{})
+	r.processInBackground(func() { <-release })
+	r.processInBackground(func() {})
+	r.sendInBackground(func() { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if got := r.Drain(ctx); got != 1 {
+		t.Errorf("Drain() = %d incomplete events, want 1", got)
+	}
+
+	close(release)
+	if got := r.Drain(context.Background()); got != 0 {
+		t.Errorf("Drain() = %d incomplete events, want 0", got)
+	}
+	if got := r.PendingEvents.Load(); got != 0 {
+		t.Errorf("PendingEvents = %d after draining, want 0", got)
+	}
+}
@@ -53,6 +53,8 @@ var (
 		"The idle timeout for EventListener Server.")
 	elTimeOutHandler = flag.Int64("timeouthandler", 5,
 		"The timeout for Timeout Handler of EventListener Server.")
+	elDrainTimeOut = flag.Int64("draintimeout", 20,
+		"The timeout for the EventListener Server to drain the events being processed when shutting down.")
 	elHTTPClientReadTimeOut = flag.Int64("httpclient-readtimeout", 30,
 		"The HTTP Client read timeout for EventListener Server.")
 	elHTTPClientKeepAlive = flag.Int64("httpclient-keep-alive", 30,
@@ -72,6 +74,10 @@ var (
 	payloadValidation = flag.Bool("payload-validation", true,
 		"Whether to disable payload validation or not.")
 	cloudEventURI = flag.String("cloudevent-uri", "", "uri for cloudevent")
+	adminPortFlag = flag.String("admin-port", "",
+		"The port for the EventListener sink to serve its probes, metrics and profiles on.")
+	adminProfilingFlag = flag.Bool("admin-profiling", false,
+		"Whether to serve pprof profiles on the admin port.")
 )
 
 // Args define the arguments for Sink.
@@ -90,6 +96,9 @@ type Args struct {
 	ELIdleTimeOut time.Duration
 	// ELTimeOutHandler defines the timeout for Timeout Handler of EventListener Server
 	ELTimeOutHandler time.Duration
+	// ELDrainTimeOut defines the timeout for the EventListener Server to
+	// drain the events being processed when shutting down
+	ELDrainTimeOut time.Duration
 	// ElHTTPClientReadTimeOut defines the Read timeout for HTTP Client
 	ElHTTPClientReadTimeOut time.Duration
 	// ElHTTPClientKeepAlive defines the Keep Alive for HTTP Client
@@ -110,6 +119,11 @@ type Args struct {
 	PayloadValidation bool
 	// CloudEventURI refers to the location where cloudevent data need to be send
 	CloudEventURI string
+	// AdminPort is the port the Sink serves its probes, metrics and profiles
+	// on. The admin listener is disabled when empty.
+	AdminPort string
+	// AdminProfiling defines whether to serve pprof profiles on the AdminPort.
+	AdminProfiling bool
 }
 
 // Clients define the set of client dependencies Sink requires.
@@ -144,6 +158,7 @@ func GetArgs() (Args, error) {
 		ELWriteTimeOut:                    time.Duration(*elWriteTimeOut),
 		ELIdleTimeOut:                     time.Duration(*elIdleTimeOut),
 		ELTimeOutHandler:                  time.Duration(*elTimeOutHandler),
+		ELDrainTimeOut:                    time.Duration(*elDrainTimeOut),
 		ElHTTPClientReadTimeOut:           time.Duration(*elHTTPClientReadTimeOut),
 		ElHTTPClientKeepAlive:             time.Duration(*elHTTPClientKeepAlive),
 		ElHTTPClientTLSHandshakeTimeout:   time.Duration(*elHTTPClientTLSHandshakeTimeout),
@@ -152,6 +167,8 @@ func GetArgs() (Args, error) {
 		Cert:                              *tlsCertFlag,
 		Key:                               *tlsKeyFlag,
 		CloudEventURI:                     *cloudEventURI,
+		AdminPort:                         *adminPortFlag,
+		AdminProfiling:                    *adminProfilingFlag,
 	}, nil
 }
 
@@ -0,0 +1,338 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// defaultRecordMaxPayloadBytes is the maximum size of a recorded event
+	// body, unless set by the RecordMaxPayloadBytesAnnotation.
+	defaultRecordMaxPayloadBytes = 16 * 1024
+	// defaultRecordTTL is how long a TriggerInvocation is kept, unless set by
+	// the RecordTTLAnnotation.
+	defaultRecordTTL = 24 * time.Hour
+	// RedactedValue replaces the values of redacted headers in a
+	// TriggerInvocation.
+	RedactedValue = "[REDACTED]"
+)
+
+// redactedHeaders are the headers that are always redacted in a
+// TriggerInvocation since they hold credentials or signatures.
+var redactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Tekton-Replay-Token",
+	"X-Gitlab-Token",
+	"X-Hub-Signature",
+	"X-Hub-Signature-256",
+}
+
+type invocationRecorderKey struct{}
+
+// invocationRecorder collects the outcome of each Trigger that processes an
+// event into a TriggerInvocation. Triggers are processed concurrently, so the
+// outcomes are added under a lock. A nil recorder ignores all outcomes.
+type invocationRecorder struct {
+	mu         sync.Mutex
+	invocation *triggersv1alpha1.TriggerInvocation
+	// write is false for dry run replays, whose outcomes are only returned in
+	// the response
+	write bool
+	// redactedValues holds the values of the redacted fields of the body,
+	// which are also redacted from the params of the outcomes
+	redactedValues map[string]bool
+	// retryable holds the namespace/name of the Triggers that failed with an
+	// error that may not occur again
+	retryable map[string]bool
+}
+
+// newInvocationRecorder returns a recorder for the event if the EventListener
+// records invocations or the event is a dry run replay, and nil otherwise.
+func newInvocationRecorder(el *triggersv1.EventListener, eventID string, header http.Header, body []byte, rp *replay) *invocationRecorder {
+	annotations := el.GetAnnotations()
+	dryRun := rp != nil && rp.dryRun
+	if annotations[triggers.RecordInvocationsAnnotation] != "true" && !dryRun {
+		return nil
+	}
+
+	maxBytes := defaultRecordMaxPayloadBytes
+	if v, ok := annotations[triggers.RecordMaxPayloadBytesAnnotation]; ok {
+		if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+			maxBytes = n
+		}
+	}
+	ttl := defaultRecordTTL
+	if v, ok := annotations[triggers.RecordTTLAnnotation]; ok {
+		if d, err := time.ParseDuration(v); err == nil && d >= 0 {
+			ttl = d
+		}
+	}
+
+	event := &triggersv1alpha1.RecordedEvent{
+		Header: redactHeader(header, annotations[triggers.RecordRedactHeadersAnnotation]),
+	}
+	var redactedValues map[string]bool
+	if fields := annotations[triggers.RecordRedactBodyFieldsAnnotation]; fields != "" {
+		body, redactedValues, event.Redacted = redactBody(body, fields)
+	}
+	event.Body = string(body)
+	if len(body) > maxBytes {
+		event.Body = string(body[:maxBytes])
+		event.Truncated = true
+	}
+
+	invocation := &triggersv1alpha1.TriggerInvocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      eventID,
+			Namespace: el.Namespace,
+			Labels: map[string]string{
+				triggers.GroupName + triggers.EventListenerLabelKey: el.Name,
+				triggers.GroupName + triggers.EventIDLabelKey:       eventID,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: triggersv1.SchemeGroupVersion.String(),
+				Kind:       "EventListener",
+				Name:       el.Name,
+				UID:        el.UID,
+			}},
+		},
+		Spec: triggersv1alpha1.TriggerInvocationSpec{
+			EventListener: el.Name,
+			EventID:       eventID,
+			Event:         event,
+		},
+	}
+	if ttl > 0 {
+		invocation.Spec.TTL = &metav1.Duration{Duration: ttl}
+	}
+	if rp != nil {
+		invocation.Labels[triggers.GroupName+triggers.ReplayOfLabelKey] = rp.of
+		invocation.Spec.ReplayOf = rp.of
+	}
+	return &invocationRecorder{invocation: invocation, write: !dryRun, redactedValues: redactedValues}
+}
+
+// redactHeader returns a copy of the header with the values of the sensitive
+// headers, and of the comma separated extra headers, redacted.
+func redactHeader(header http.Header, extra string) map[string][]string {
+	out := header.Clone()
+	names := redactedHeaders
+	if extra != "" {
+		names = append(append([]string{}, names...), strings.Split(extra, ",")...)
+	}
+	for _, name := range names {
+		name = http.CanonicalHeaderKey(strings.TrimSpace(name))
+		if values, ok := out[name]; ok {
+			for i := range values {
+				values[i] = RedactedValue
+			}
+		}
+	}
+	return out
+}
+
+// redactBody returns the JSON body with the values of the comma separated
+// fields redacted, along with the string and number values it redacted, and
+// whether any field was redacted. Each field is a dot separated path, which is
+// followed into each element of the arrays on the path. A body that isn't
+// JSON is redacted as a whole.
+func redactBody(body []byte, fields string) ([]byte, map[string]bool, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return []byte(RedactedValue), nil, true
+	}
+	redacted := map[string]bool{}
+	changed := false
+	for _, field := range strings.Split(fields, ",") {
+		if redactField(v, strings.Split(strings.TrimSpace(field), "."), redacted) {
+			changed = true
+		}
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return []byte(RedactedValue), nil, true
+	}
+	return out, redacted, changed
+}
+
+// redactField redacts the field at the path, and returns whether it was
+// found.
+func redactField(v interface{}, path []string, redacted map[string]bool) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		child, ok := v[path[0]]
+		if !ok {
+			return false
+		}
+		if len(path) > 1 {
+			return redactField(child, path[1:], redacted)
+		}
+		switch child := child.(type) {
+		case string:
+			if child != "" {
+				redacted[child] = true
+			}
+		case json.Number:
+			redacted[child.String()] = true
+		}
+		v[path[0]] = RedactedValue
+		return true
+	case []interface{}:
+		found := false
+		for _, e := range v {
+			if redactField(e, path, redacted) {
+				found = true
+			}
+		}
+		return found
+	}
+	return false
+}
+
+func withInvocationRecorder(ctx context.Context, rec *invocationRecorder) context.Context {
+	if rec == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, invocationRecorderKey{}, rec)
+}
+
+func invocationRecorderFrom(ctx context.Context) *invocationRecorder {
+	rec, _ := ctx.Value(invocationRecorderKey{}).(*invocationRecorder)
+	return rec
+}
+
+// setRequest records the HTTP method and path of the request of the event.
+func (rec *invocationRecorder) setRequest(method, path string) {
+	if rec == nil || rec.invocation.Spec.Event == nil {
+		return
+	}
+	rec.invocation.Spec.Event.Method = method
+	rec.invocation.Spec.Event.Path = path
+}
+
+// addOutcome adds the outcome of a Trigger to the TriggerInvocation, with the
+// params that hold redacted values of the body redacted.
+func (rec *invocationRecorder) addOutcome(outcome *triggersv1alpha1.TriggerOutcome) {
+	if rec == nil {
+		return
+	}
+	recorded := *outcome
+	if len(rec.redactedValues) > 0 && len(outcome.Params) > 0 {
+		recorded.Params = make([]triggersv1alpha1.Param, len(outcome.Params))
+		for i, p := range outcome.Params {
+			if rec.redactedValues[p.Value] {
+				p.Value = RedactedValue
+			}
+			recorded.Params[i] = p
+		}
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.invocation.Spec.Triggers = append(rec.invocation.Spec.Triggers, recorded)
+}
+
+// markRetryable records that the Trigger failed with an error that may not
+// occur again when the event is processed again.
+func (rec *invocationRecorder) markRetryable(namespace, name string) {
+	if rec == nil {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.retryable == nil {
+		rec.retryable = map[string]bool{}
+	}
+	rec.retryable[namespace+"/"+name] = true
+}
+
+// retryableTriggers returns the namespace/name of the Triggers marked as
+// retryable.
+func (rec *invocationRecorder) retryableTriggers() map[string]bool {
+	if rec == nil {
+		return nil
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return rec.retryable
+}
+
+// paramsToOutcome returns the resolved params as recorded in a TriggerOutcome.
+func paramsToOutcome(params []triggersv1.Param) []triggersv1alpha1.Param {
+	out := make([]triggersv1alpha1.Param, 0, len(params))
+	for _, p := range params {
+		out = append(out, triggersv1alpha1.Param{Name: p.Name, Value: p.Value})
+	}
+	return out
+}
+
+// resourcesToOutcome returns references to the resources created by a
+// Trigger, as returned by the API server.
+func resourcesToOutcome(created []*unstructured.Unstructured) []triggersv1alpha1.ResourceReference {
+	out := make([]triggersv1alpha1.ResourceReference, 0, len(created))
+	for _, obj := range created {
+		out = append(out, triggersv1alpha1.ResourceReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+	}
+	return out
+}
+
+// outcomes returns the outcomes added to the recorder.
+func (rec *invocationRecorder) outcomes() []triggersv1alpha1.TriggerOutcome {
+	if rec == nil {
+		return nil
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]triggersv1alpha1.TriggerOutcome{}, rec.invocation.Spec.Triggers...)
+}
+
+// writeInvocation creates the TriggerInvocation once all Triggers have
+// processed the event.
+func (r Sink) writeInvocation(rec *invocationRecorder, log *zap.SugaredLogger) {
+	if rec == nil || !rec.write {
+		return
+	}
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	ti := rec.invocation
+	if _, err := r.TriggersClient.TriggersV1alpha1().TriggerInvocations(ti.Namespace).Create(context.Background(), ti, metav1.CreateOptions{}); err != nil {
+		log.Errorf("failed to create TriggerInvocation %s: %v", ti.Name, err)
+	}
+}
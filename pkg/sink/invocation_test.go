@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/ptr"
+)
+
+func TestNewInvocationRecorder(t *testing.T) {
+	header := http.Header{
+		"Authorization":  {"Bearer token"},
+		"X-Api-Key":      {"secret"},
+		"X-Github-Event": {"push"},
+	}
+	body := []byte(`{"ref": "main"}`)
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		want        *triggersv1alpha1.TriggerInvocationSpec
+	}{{
+		name: "recording disabled",
+	}, {
+		name:        "defaults",
+		annotations: map[string]string{triggers.RecordInvocationsAnnotation: "true"},
+		want: &triggersv1alpha1.TriggerInvocationSpec{
+			EventListener: "el",
+			EventID:       eventID,
+			Event: &triggersv1alpha1.RecordedEvent{
+				Header: map[string][]string{
+					"Authorization":  {RedactedValue},
+					"X-Api-Key":      {"secret"},
+					"X-Github-Event": {"push"},
+				},
+				Body: `{"ref": "main"}`,
+			},
+			TTL: &metav1.Duration{Duration: defaultRecordTTL},
+		},
+	}, {
+		name: "truncated body, extra redacted headers and no ttl",
+		annotations: map[string]string{
+			triggers.RecordInvocationsAnnotation:     "true",
+			triggers.RecordMaxPayloadBytesAnnotation: "5",
+			triggers.RecordRedactHeadersAnnotation:   "x-api-key, X-Other",
+			triggers.RecordTTLAnnotation:             "0s",
+		},
+		want: &triggersv1alpha1.TriggerInvocationSpec{
+			EventListener: "el",
+			EventID:       eventID,
+			Event: &triggersv1alpha1.RecordedEvent{
+				Header: map[string][]string{
+					"Authorization":  {RedactedValue},
+					"X-Api-Key":      {RedactedValue},
+					"X-Github-Event": {"push"},
+				},
+				Body:      `{"ref`,
+				Truncated: true,
+			},
+		},
+	}, {
+		name: "redacted body fields",
+		annotations: map[string]string{
+			triggers.RecordInvocationsAnnotation:      "true",
+			triggers.RecordRedactBodyFieldsAnnotation: "ref",
+		},
+		want: &triggersv1alpha1.TriggerInvocationSpec{
+			EventListener: "el",
+			EventID:       eventID,
+			Event: &triggersv1alpha1.RecordedEvent{
+				Header: map[string][]string{
+					"Authorization":  {RedactedValue},
+					"X-Api-Key":      {"secret"},
+					"X-Github-Event": {"push"},
+				},
+				Body:     `{"ref":"[REDACTED]"}`,
+				Redacted: true,
+			},
+			TTL: &metav1.Duration{Duration: defaultRecordTTL},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1beta1.EventListener{ObjectMeta: metav1.ObjectMeta{Name: "el", Namespace: namespace, Annotations: tc.annotations}}
+			rec := newInvocationRecorder(el, eventID, header, body, nil)
+			if tc.want == nil {
+				if rec != nil {
+					t.Fatalf("newInvocationRecorder() = %v, want nil", rec)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want, &rec.invocation.Spec); diff != "" {
+				t.Errorf("newInvocationRecorder() spec -want +got: %s", diff)
+			}
+			if header.Get("Authorization") != "Bearer token" {
+				t.Error("newInvocationRecorder() modified the request header")
+			}
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		body         string
+		fields       string
+		want         string
+		wantRedacted map[string]bool
+		wantChanged  bool
+	}{{
+		name:         "nested fields and arrays",
+		body:         `{"user": {"name": "jane", "password": "hunter2"}, "keys": [{"id": 1, "value": "k1"}, {"id": 2, "value": 42}]}`,
+		fields:       "user.password, keys.value",
+		want:         `{"keys":[{"id":1,"value":"[REDACTED]"},{"id":2,"value":"[REDACTED]"}],"user":{"name":"jane","password":"[REDACTED]"}}`,
+		wantRedacted: map[string]bool{"hunter2": true, "k1": true, "42": true},
+		wantChanged:  true,
+	}, {
+		name:         "missing fields",
+		body:         `{"ref": "main"}`,
+		fields:       "token,ref.name",
+		want:         `{"ref":"main"}`,
+		wantRedacted: map[string]bool{},
+	}, {
+		name:        "not JSON",
+		body:        `token=hunter2`,
+		fields:      "token",
+		want:        RedactedValue,
+		wantChanged: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, redacted, changed := redactBody([]byte(tc.body), tc.fields)
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Errorf("redactBody() body -want +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRedacted, redacted); diff != "" {
+				t.Errorf("redactBody() redacted values -want +got: %s", diff)
+			}
+			if changed != tc.wantChanged {
+				t.Errorf("redactBody() redacted = %t, want %t", changed, tc.wantChanged)
+			}
+		})
+	}
+}
+
+func TestInvocationRecorder_RedactedParams(t *testing.T) {
+	el := &triggersv1beta1.EventListener{ObjectMeta: metav1.ObjectMeta{
+		Name:      "el",
+		Namespace: namespace,
+		Annotations: map[string]string{
+			triggers.RecordInvocationsAnnotation:      "true",
+			triggers.RecordRedactBodyFieldsAnnotation: "token",
+		},
+	}}
+	rec := newInvocationRecorder(el, eventID, http.Header{}, []byte(`{"token": "hunter2", "ref": "main"}`), nil)
+	rec.addOutcome(&triggersv1alpha1.TriggerOutcome{
+		Name:   "trigger",
+		Status: triggersv1alpha1.TriggerOutcomeSucceeded,
+		Params: []triggersv1alpha1.Param{{Name: "token", Value: "hunter2"}, {Name: "ref", Value: "main"}},
+	})
+	want := []triggersv1alpha1.Param{{Name: "token", Value: RedactedValue}, {Name: "ref", Value: "main"}}
+	if diff := cmp.Diff(want, rec.outcomes()[0].Params); diff != "" {
+		t.Errorf("recorded params -want +got: %s", diff)
+	}
+}
+
+func TestHandleEvent_RecordInvocation(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-el",
+			Namespace:   namespace,
+			UID:         types.UID(elUID),
+			Annotations: map[string]string{triggers.RecordInvocationsAnnotation: "true"},
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Ref: ptr.String("git-clone")},
+			}},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{
+		EventListeners:   []*triggersv1beta1.EventListener{el},
+		TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+	}, el.Name, nil)
+
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+	body := `{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`
+	resp, err := http.Post(ts.URL, "application/json", bytes.NewReader([]byte(body)))
+	if err != nil {
+		t.Fatalf("error making request to eventListener: %s", err)
+	}
+	checkSinkResponse(t, resp, el.Name)
+	sink.WGProcessTriggers.Wait()
+
+	got, err := sink.TriggersClient.TriggersV1alpha1().TriggerInvocations(namespace).Get(context.Background(), eventID, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get TriggerInvocation: %v", err)
+	}
+	want := triggersv1alpha1.TriggerInvocationSpec{
+		EventListener: el.Name,
+		EventID:       eventID,
+		Event:         &triggersv1alpha1.RecordedEvent{Method: http.MethodPost, Path: "/", Body: body},
+		Triggers: []triggersv1alpha1.TriggerOutcome{{
+			Name:      "git-clone-trigger",
+			Namespace: namespace,
+			Status:    triggersv1alpha1.TriggerOutcomeSucceeded,
+			Params: []triggersv1alpha1.Param{
+				{Name: "url", Value: "testurl"},
+				{Name: "revision", Value: "testrevision"},
+				{Name: "name", Value: "git-clone-run"},
+				{Name: "app", Value: "triggers"},
+				{Name: "type", Value: "bar"},
+			},
+			Resources: []triggersv1alpha1.ResourceReference{{
+				APIVersion: "tekton.dev/v1",
+				Kind:       "TaskRun",
+				Namespace:  namespace,
+				Name:       "git-clone-run",
+			}},
+		}},
+		TTL: &metav1.Duration{Duration: 24 * time.Hour},
+	}
+	if diff := cmp.Diff(want, got.Spec,
+		cmpopts.IgnoreFields(triggersv1alpha1.RecordedEvent{}, "Header"),
+		cmpopts.SortSlices(func(a, b triggersv1alpha1.Param) bool { return a.Name < b.Name })); diff != "" {
+		t.Errorf("TriggerInvocation spec -want +got: %s", diff)
+	}
+	if len(got.OwnerReferences) != 1 || got.OwnerReferences[0].UID != el.UID {
+		t.Errorf("TriggerInvocation owner references = %v, want the EventListener", got.OwnerReferences)
+	}
+}
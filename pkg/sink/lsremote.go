@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// gitCredentials authenticate to a Git repository with HTTP basic auth.
+type gitCredentials struct {
+	username string
+	password string
+}
+
+// lsRemote returns the refs of the Git repository at the HTTP(S) URL and the
+// commits they point to, like git ls-remote. Annotated tags point to their
+// commit. It uses the smart HTTP protocol of Git, so it doesn't need a git
+// binary.
+func lsRemote(ctx context.Context, client *http.Client, url string, creds *gitCredentials) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(url, "/")+"/info/refs?service=git-upload-pack", nil)
+	if err != nil {
+		return nil, err
+	}
+	if creds != nil {
+		if req.URL.Scheme != "https" {
+			return nil, fmt.Errorf("refusing to send the credentials of %s over %s", url, req.URL.Scheme)
+		}
+		req.SetBasicAuth(creds.username, creds.password)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the refs of %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to list the refs of %s: %s", url, resp.Status)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-git-upload-pack-advertisement" {
+		return nil, fmt.Errorf("failed to list the refs of %s: unexpected content type %q, the server doesn't support the smart HTTP protocol", url, ct)
+	}
+	return parseRefAdvertisement(resp.Body)
+}
+
+// parseRefAdvertisement parses the pkt-lines of the refs advertised by the
+// git-upload-pack service.
+func parseRefAdvertisement(r io.Reader) (map[string]string, error) {
+	br := bufio.NewReader(r)
+	refs := map[string]string{}
+	// service is true between the service announcement and the flush-pkt
+	// that follows it
+	service := false
+	for first := true; ; first = false {
+		line, flush, err := readPktLine(br)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ref advertisement: %w", err)
+		}
+		if flush {
+			if service {
+				service = false
+				continue
+			}
+			return refs, nil
+		}
+		if first && strings.HasPrefix(line, "# service=") {
+			service = true
+			continue
+		}
+		// The first ref is followed by the capabilities of the server
+		line, _, _ = strings.Cut(line, "\x00")
+		sha, ref, ok := strings.Cut(strings.TrimSuffix(line, "\n"), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid ref advertisement %q", line)
+		}
+		switch {
+		case ref == "capabilities^{}":
+			// An empty repository
+		case strings.HasSuffix(ref, "^{}"):
+			refs[strings.TrimSuffix(ref, "^{}")] = sha
+		default:
+			refs[ref] = sha
+		}
+	}
+}
+
+// readPktLine reads a pkt-line, and returns true if it is a flush-pkt.
+func readPktLine(r *bufio.Reader) (string, bool, error) {
+	size := make([]byte, 4)
+	if _, err := io.ReadFull(r, size); err != nil {
+		return "", false, err
+	}
+	n, err := strconv.ParseUint(string(size), 16, 16)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid pkt-line length %q", size)
+	}
+	if n == 0 {
+		return "", true, nil
+	}
+	if n < 4 {
+		return "", false, fmt.Errorf("invalid pkt-line length %q", size)
+	}
+	data := make([]byte, n-4)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return "", false, err
+	}
+	return string(data), false, nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// pktLines encodes the lines as pkt-lines, with an empty line as a flush-pkt.
+func pktLines(lines ...string) string {
+	var b strings.Builder
+	for _, l := range lines {
+		if l == "" {
+			b.WriteString("0000")
+			continue
+		}
+		fmt.Fprintf(&b, "%04x%s", len(l)+4, l)
+	}
+	return b.String()
+}
+
+// refAdvertisement is the smart HTTP response listing the refs.
+func refAdvertisement(refs ...string) string {
+	lines := []string{"# service=git-upload-pack\n", ""}
+	for i, r := range refs {
+		if i == 0 {
+			r += "\x00multi_ack side-band-64k"
+		}
+		lines = append(lines, r+"\n")
+	}
+	return pktLines(append(lines, "")...)
+}
+
+func TestParseRefAdvertisement(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		want map[string]string
+	}{{
+		name: "refs",
+		in: refAdvertisement(
+			"1111111111111111111111111111111111111111 HEAD",
+			"1111111111111111111111111111111111111111 refs/heads/main",
+			"2222222222222222222222222222222222222222 refs/heads/release",
+			"3333333333333333333333333333333333333333 refs/tags/v1",
+			"4444444444444444444444444444444444444444 refs/tags/v1^{}",
+		),
+		want: map[string]string{
+			"HEAD":               "1111111111111111111111111111111111111111",
+			"refs/heads/main":    "1111111111111111111111111111111111111111",
+			"refs/heads/release": "2222222222222222222222222222222222222222",
+			"refs/tags/v1":       "4444444444444444444444444444444444444444",
+		},
+	}, {
+		name: "empty repository",
+		in:   refAdvertisement("0000000000000000000000000000000000000000 capabilities^{}"),
+		want: map[string]string{},
+	}, {
+		name: "without service announcement",
+		in:   pktLines("1111111111111111111111111111111111111111 refs/heads/main\n", ""),
+		want: map[string]string{"refs/heads/main": "1111111111111111111111111111111111111111"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseRefAdvertisement(strings.NewReader(tc.in))
+			if err != nil {
+				t.Fatalf("parseRefAdvertisement() returned error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("parseRefAdvertisement() -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseRefAdvertisement_Error(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+	}{{
+		name: "truncated",
+		in:   "003f1111",
+	}, {
+		name: "invalid length",
+		in:   "zzzz",
+	}, {
+		name: "missing flush",
+		in:   pktLines("1111111111111111111111111111111111111111 refs/heads/main\n"),
+	}, {
+		name: "invalid ref",
+		in:   pktLines("1111111111111111111111111111111111111111\n", ""),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := parseRefAdvertisement(strings.NewReader(tc.in)); err == nil {
+				t.Error("parseRefAdvertisement() didn't return an error")
+			}
+		})
+	}
+}
+
+func TestLsRemote(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repo.git/info/refs" || r.URL.Query().Get("service") != "git-upload-pack" {
+			http.NotFound(w, r)
+			return
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-git-upload-pack-advertisement")
+		fmt.Fprint(w, refAdvertisement("1111111111111111111111111111111111111111 refs/heads/main"))
+	}))
+	defer ts.Close()
+
+	got, err := lsRemote(context.Background(), ts.Client(), ts.URL+"/repo.git", &gitCredentials{username: "user", password: "token"})
+	if err != nil {
+		t.Fatalf("lsRemote() returned error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"refs/heads/main": "1111111111111111111111111111111111111111"}, got); diff != "" {
+		t.Errorf("lsRemote() -want +got: %s", diff)
+	}
+
+	if _, err := lsRemote(context.Background(), ts.Client(), ts.URL+"/repo.git", nil); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("lsRemote() without credentials = %v, want an unauthorized error", err)
+	}
+	if _, err := lsRemote(context.Background(), ts.Client(), ts.URL+"/other.git", &gitCredentials{username: "user", password: "token"}); err == nil {
+		t.Error("lsRemote() of a missing repository didn't return an error")
+	}
+
+	plain := httptest.NewServer(ts.Config.Handler)
+	defer plain.Close()
+	if _, err := lsRemote(context.Background(), plain.Client(), plain.URL+"/repo.git", &gitCredentials{username: "user", password: "token"}); err == nil || !strings.Contains(err.Error(), "refusing to send the credentials") {
+		t.Errorf("lsRemote() with credentials over http = %v, want an error", err)
+	}
+}
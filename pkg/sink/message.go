@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/messaging"
+	"github.com/tektoncd/triggers/pkg/policy"
+)
+
+type retriedTriggersKey struct{}
+
+// ProcessMessage processes a message consumed from a message source with the
+// Triggers of the EventListener, the same way as an incoming event. It waits
+// for all of the Triggers, and returns an error if one of them failed. If
+// Triggers failed to create resources because of a transient error of the API
+// server, the error is a RetryableError that only processes the message again
+// with those Triggers.
+func (r Sink) ProcessMessage(ctx context.Context, body []byte, header http.Header) error {
+	defer r.trackEvent()()
+	rec, eventWG, log, err := r.startEvent(ctx, body, header)
+	if err != nil {
+		return err
+	}
+	log.Infof("processing message %s from message source %s", header.Get(messaging.IDHeader), header.Get(messaging.SourceHeader))
+	eventWG.Wait()
+	r.writeInvocation(rec, log)
+
+	var errs []error
+	for _, o := range rec.outcomes() {
+		if o.Status == triggersv1alpha1.TriggerOutcomeFailed {
+			errs = append(errs, fmt.Errorf("Trigger %s/%s failed: %s", o.Namespace, o.Name, o.Message))
+		}
+	}
+	err = errors.Join(errs...)
+	if retried := rec.retryableTriggers(); len(retried) > 0 {
+		return &messaging.RetryableError{
+			Err: err,
+			Retry: func(ctx context.Context, body []byte, header http.Header) error {
+				return r.ProcessMessage(context.WithValue(ctx, retriedTriggersKey{}, retried), body, header)
+			},
+		}
+	}
+	return err
+}
+
+// retriedTrigger returns false if the event is processed again, but not with
+// the Trigger, which already processed it.
+func retriedTrigger(ctx context.Context, t triggersv1.Trigger) bool {
+	retried, ok := ctx.Value(retriedTriggersKey{}).(map[string]bool)
+	return !ok || retried[t.Namespace+"/"+t.Name]
+}
+
+// RunMessageSources consumes the messages of the message sources of the
+// EventListener until the context is done. Unlike scheduled Triggers, every
+// replica consumes messages, and the brokers spread them between replicas.
+func (r Sink) RunMessageSources(ctx context.Context) {
+	consumers := &messaging.Consumers{
+		KubeClient: r.KubeClientSet,
+		Namespace:  r.EventListenerNamespace,
+		Handler:    r.ProcessMessage,
+		Logger:     r.Logger,
+	}
+	defer consumers.Stop()
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+	for {
+		if el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName); err == nil {
+			if sources, err := r.allowedMessageSources(el); err == nil {
+				consumers.Sync(ctx, sources)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// allowedMessageSources returns the message sources of the EventListener
+// whose Secrets its EventListenerPolicies allow it to read.
+func (r Sink) allowedMessageSources(el *triggersv1.EventListener) ([]triggersv1.MessageSource, error) {
+	policies, err := policy.For(r.EventListenerPolicyLister, el.Namespace, el.Name)
+	if err != nil {
+		r.Logger.Error(err)
+		return nil, err
+	}
+	var sources []triggersv1.MessageSource
+	for _, source := range el.Spec.MessageSources {
+		if err := policies.CheckMessageSource(source); err != nil {
+			r.Logger.Error(err)
+			continue
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/messaging"
+	"github.com/tektoncd/triggers/test"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestProcessMessage(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployed", Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(header.Tekton-Message-Source)")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+		},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "deployed"}},
+		},
+	}
+	header := http.Header{
+		"Content-Type":         {"application/json"},
+		messaging.SourceHeader: {"deployments"},
+	}
+	body := []byte(`{"repository": {"url": "testurl"}}`)
+
+	t.Run("processed", func(t *testing.T) {
+		sink, dynamicClient := getSinkAssets(t, test.Resources{
+			EventListeners:   []*triggersv1beta1.EventListener{el},
+			Triggers:         []*triggersv1beta1.Trigger{tr},
+			TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+		}, el.Name, nil)
+		if err := sink.ProcessMessage(context.Background(), body, header.Clone()); err != nil {
+			t.Fatalf("ProcessMessage() returned error: %v", err)
+		}
+		if got := len(dynamicClient.Actions()); got != 1 {
+			t.Errorf("got %d actions, want 1 create", got)
+		}
+	})
+
+	t.Run("routed Trigger", func(t *testing.T) {
+		routed := tr.DeepCopy()
+		routed.Spec.Route = &triggersv1beta1.TriggerRoute{Path: "/github", Methods: []string{http.MethodPut}}
+		sink, dynamicClient := getSinkAssets(t, test.Resources{
+			EventListeners:   []*triggersv1beta1.EventListener{el},
+			Triggers:         []*triggersv1beta1.Trigger{routed},
+			TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+		}, el.Name, nil)
+		if err := sink.ProcessMessage(context.Background(), body, header.Clone()); err != nil {
+			t.Fatalf("ProcessMessage() returned error: %v", err)
+		}
+		if got := len(dynamicClient.Actions()); got != 1 {
+			t.Errorf("got %d actions, want 1 create", got)
+		}
+	})
+
+	t.Run("replay headers", func(t *testing.T) {
+		sink, dynamicClient := getSinkAssets(t, test.Resources{
+			EventListeners:   []*triggersv1beta1.EventListener{el},
+			Triggers:         []*triggersv1beta1.Trigger{tr},
+			TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+		}, el.Name, nil)
+		replayHeader := header.Clone()
+		replayHeader.Set(ReplayOfHeader, "original")
+		replayHeader.Set(ReplayDryRunHeader, "true")
+		if err := sink.ProcessMessage(context.Background(), body, replayHeader); err != nil {
+			t.Fatalf("ProcessMessage() returned error: %v", err)
+		}
+		actions := dynamicClient.Actions()
+		if len(actions) != 1 {
+			t.Fatalf("got %d actions, want 1 create", len(actions))
+		}
+		create := actions[0].(ktesting.CreateActionImpl)
+		if len(create.CreateOptions.DryRun) > 0 {
+			t.Error("the message was processed as a dry run replay")
+		}
+		created := create.GetObject().(*unstructured.Unstructured)
+		if _, ok := created.GetLabels()[triggers.GroupName+triggers.ReplayOfLabelKey]; ok {
+			t.Errorf("created %s with the labels of a replay: %v", created.GetName(), created.GetLabels())
+		}
+	})
+
+	t.Run("drained", func(t *testing.T) {
+		sink, dynamicClient := getSinkAssets(t, test.Resources{
+			EventListeners:   []*triggersv1beta1.EventListener{el},
+			Triggers:         []*triggersv1beta1.Trigger{tr},
+			TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+		}, el.Name, nil)
+		sink.PendingEvents = &atomic.Int64{}
+		creating, release := make(chan struct{}), make(chan struct{})
+		dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+			close(creating)
+			<-release
+			return false, nil, nil
+		})
+		processed := make(chan error)
+		go func() {
+			processed <- sink.ProcessMessage(context.Background(), body, header.Clone())
+		}()
+
+		<-creating
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if got := sink.Drain(ctx); got != 1 {
+			t.Errorf("Drain() = %d incomplete events while processing the message, want 1", got)
+		}
+		close(release)
+		if err := <-processed; err != nil {
+			t.Fatalf("ProcessMessage() returned error: %v", err)
+		}
+		if got := sink.Drain(context.Background()); got != 0 {
+			t.Errorf("Drain() = %d incomplete events, want 0", got)
+		}
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		sink, dynamicClient := getSinkAssets(t, test.Resources{
+			EventListeners:   []*triggersv1beta1.EventListener{el},
+			Triggers:         []*triggersv1beta1.Trigger{tr},
+			TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+		}, el.Name, nil)
+		dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+			return true, nil, kerrors.NewForbidden(schema.GroupResource{Group: "tekton.dev", Resource: "taskruns"}, "", errors.New("no"))
+		})
+		err := sink.ProcessMessage(context.Background(), body, header.Clone())
+		if err == nil || !strings.Contains(err.Error(), "Trigger foo/deployed failed") {
+			t.Errorf("ProcessMessage() = %v, want an error for the failed Trigger", err)
+		}
+		var retryable *messaging.RetryableError
+		if errors.As(err, &retryable) {
+			t.Errorf("ProcessMessage() = %v, want an error that isn't retried", err)
+		}
+	})
+
+	t.Run("retried", func(t *testing.T) {
+		releasedTT := &triggersv1beta1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "released", Namespace: namespace},
+			Spec:       *makeGitCloneTTSpec(t, "released-run"),
+		}
+		released := tr.DeepCopy()
+		released.Name = "released"
+		released.Spec.Template.Ref = ptr.String("released")
+		elWithTriggers := el.DeepCopy()
+		elWithTriggers.Spec.Triggers = append(elWithTriggers.Spec.Triggers, triggersv1beta1.EventListenerTrigger{TriggerRef: "released"})
+		sink, dynamicClient := getSinkAssets(t, test.Resources{
+			EventListeners:   []*triggersv1beta1.EventListener{elWithTriggers},
+			Triggers:         []*triggersv1beta1.Trigger{tr, released},
+			TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt, releasedTT},
+		}, el.Name, nil)
+		var failed atomic.Bool
+		dynamicClient.PrependReactor("create", "taskruns", func(ktesting.Action) (bool, runtime.Object, error) {
+			// The first Trigger that creates its TaskRun fails
+			if failed.CompareAndSwap(false, true) {
+				return true, nil, kerrors.NewServiceUnavailable("try again")
+			}
+			return false, nil, nil
+		})
+		err := sink.ProcessMessage(context.Background(), body, header.Clone())
+		var retryable *messaging.RetryableError
+		if !errors.As(err, &retryable) {
+			t.Fatalf("ProcessMessage() = %v, want a RetryableError", err)
+		}
+		if err := retryable.Retry(context.Background(), body, header.Clone()); err != nil {
+			t.Fatalf("Retry() returned error: %v", err)
+		}
+		// Only the Trigger that failed creates its TaskRun again
+		if got := len(dynamicClient.Actions()); got != 3 {
+			t.Errorf("got %d actions, want 3 creates", got)
+		}
+	})
+
+	t.Run("no EventListener", func(t *testing.T) {
+		sink, _ := getSinkAssets(t, test.Resources{}, el.Name, nil)
+		if err := sink.ProcessMessage(context.Background(), body, header.Clone()); err == nil {
+			t.Error("ProcessMessage() without an EventListener didn't return an error")
+		}
+	})
+}
@@ -18,6 +18,9 @@ var (
 	elDuration         metric.Float64Histogram
 	eventRcdCount      metric.Int64Counter
 	triggeredResources metric.Int64Counter
+	resourceErrors     metric.Int64Counter
+	eventsInFlight     metric.Int64UpDownCounter
+	incompleteEvents   metric.Int64Counter
 )
 
 const (
@@ -60,6 +63,30 @@ func initMetrics() error {
 		return fmt.Errorf("failed to create triggeredResources counter: %w", err)
 	}
 
+	resourceErrors, err = meter.Int64Counter(
+		"eventlistener_resource_errors_total",
+		metric.WithDescription("Count of the number of eventlistener resources that failed validation or creation"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create resourceErrors counter: %w", err)
+	}
+
+	eventsInFlight, err = meter.Int64UpDownCounter(
+		"eventlistener_events_in_flight",
+		metric.WithDescription("number of events being processed by the eventlistener"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create eventsInFlight counter: %w", err)
+	}
+
+	incompleteEvents, err = meter.Int64Counter(
+		"eventlistener_events_incomplete_total",
+		metric.WithDescription("number of events left incomplete when the eventlistener shut down"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create incompleteEvents counter: %w", err)
+	}
+
 	return nil
 }
 
@@ -87,7 +114,9 @@ func (s *Sink) NewMetricsRecorderInterceptor() MetricsInterceptor {
 			Status:         200,
 		}
 		startTime := time.Now()
+		eventsInFlight.Add(r.Context(), 1)
 		defer func() {
+			eventsInFlight.Add(context.Background(), -1)
 			endTime := time.Now()
 			elapsed := endTime.Sub(startTime)
 			// Log the consumed time
@@ -123,6 +152,18 @@ func (s *Sink) recordResourceCreation(resources []json.RawMessage) {
 	}
 }
 
+func (s *Sink) recordResourceError(resErr *ResourceError) {
+	resourceErrors.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("kind", resErr.Kind),
+		attribute.String("stage", resErr.Stage),
+		attribute.String("reason", string(resErr.Reason)),
+	))
+}
+
+func (s *Sink) recordIncompleteEvents(count int64) {
+	incompleteEvents.Add(context.Background(), count)
+}
+
 type Recorder struct {
 	initialized bool
 
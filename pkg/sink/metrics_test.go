@@ -3,11 +3,14 @@ package sink
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/triggers/pkg/resources"
 	"go.opentelemetry.io/otel"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -20,6 +23,8 @@ func resetSinkMetrics() {
 	elDuration = nil
 	eventRcdCount = nil
 	triggeredResources = nil
+	resourceErrors = nil
+	eventsInFlight = nil
 }
 
 func setupTestProvider(t *testing.T) *sdkmetric.ManualReader {
@@ -71,6 +76,12 @@ func TestRecorderMetricsRegistered(t *testing.T) {
 	if eventRcdCount == nil {
 		t.Fatal("eventRcdCount metric not initialized")
 	}
+	if resourceErrors == nil {
+		t.Fatal("resourceErrors metric not initialized")
+	}
+	if eventsInFlight == nil {
+		t.Fatal("eventsInFlight metric not initialized")
+	}
 
 	_ = reader
 }
@@ -232,3 +243,76 @@ func TestRecordResourceCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordResourceError(t *testing.T) {
+	reader := setupTestProvider(t)
+
+	if _, err := NewRecorder(); err != nil {
+		t.Fatal(err)
+	}
+	s := &Sink{
+		Recorder: &Recorder{initialized: true},
+		Logger:   zaptest.NewLogger(t).Sugar(),
+	}
+
+	s.recordResourceError(&ResourceError{Kind: "PipelineRun", Stage: validationStage, Reason: resources.ErrorReasonSchema})
+
+	rm := collectMetrics(t, reader)
+	m, found := findMetric(rm, "eventlistener_resource_errors_total")
+	if !found {
+		t.Fatal("eventlistener_resource_errors_total metric not found")
+	}
+	sum, ok := m.Data.(metricdata.Sum[int64])
+	if !ok {
+		t.Fatalf("expected Sum[int64], got %T", m.Data)
+	}
+	if len(sum.DataPoints) != 1 {
+		t.Fatalf("expected 1 data point, got %d", len(sum.DataPoints))
+	}
+	gotAttrs := make(map[string]string)
+	for _, kv := range sum.DataPoints[0].Attributes.ToSlice() {
+		gotAttrs[string(kv.Key)] = kv.Value.AsString()
+	}
+	wantAttrs := map[string]string{"kind": "PipelineRun", "stage": "validation", "reason": "Schema"}
+	if d := cmp.Diff(wantAttrs, gotAttrs); d != "" {
+		t.Errorf("attributes diff (-want, +got): %s", d)
+	}
+}
+
+func TestRecordEventsInFlight(t *testing.T) {
+	reader := setupTestProvider(t)
+
+	if _, err := NewRecorder(); err != nil {
+		t.Fatal(err)
+	}
+	s := &Sink{
+		Recorder: &Recorder{initialized: true},
+		Logger:   zaptest.NewLogger(t).Sugar(),
+	}
+	inFlight := func() int64 {
+		t.Helper()
+		m, found := findMetric(collectMetrics(t, reader), "eventlistener_events_in_flight")
+		if !found {
+			t.Fatal("eventlistener_events_in_flight metric not found")
+		}
+		sum, ok := m.Data.(metricdata.Sum[int64])
+		if !ok {
+			t.Fatalf("expected Sum[int64], got %T", m.Data)
+		}
+		if len(sum.DataPoints) != 1 {
+			t.Fatalf("expected 1 data point, got %d", len(sum.DataPoints))
+		}
+		return sum.DataPoints[0].Value
+	}
+
+	var during int64
+	s.NewMetricsRecorderInterceptor()(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil), func(http.ResponseWriter, *http.Request) {
+		during = inFlight()
+	})
+	if during != 1 {
+		t.Errorf("expected 1 event in flight while handling the event, got %d", during)
+	}
+	if got := inFlight(); got != 0 {
+		t.Errorf("expected no events in flight after handling the event, got %d", got)
+	}
+}
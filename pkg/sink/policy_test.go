@@ -0,0 +1,241 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	discoveryclient "k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"knative.dev/pkg/ptr"
+)
+
+// noAuthOverride creates the resources of Triggers with a ServiceAccount with
+// the clients of the EventListener, since there is no cluster to impersonate
+// the ServiceAccount in.
+type noAuthOverride struct{}
+
+func (noAuthOverride) OverrideAuthentication(_, _ string, _ *zap.SugaredLogger, discoveryClient discoveryclient.ServerResourcesInterface, dynamicClient dynamic.Interface) (discoveryclient.ServerResourcesInterface, dynamic.Interface, error) {
+	return discoveryClient, dynamicClient, nil
+}
+
+// policyTrigger returns a Trigger creating a TaskRun in its own namespace.
+func policyTrigger(t *testing.T, ns, name, sa string) *triggersv1beta1.Trigger {
+	t.Helper()
+	return crossNamespaceTrigger(t, ns, name, sa, ns)
+}
+
+// crossNamespaceTrigger returns a Trigger creating a TaskRun in the target
+// namespace.
+func crossNamespaceTrigger(t *testing.T, ns, name, sa, target string) *triggersv1beta1.Trigger {
+	t.Helper()
+	spec := makeGitCloneTTSpec(t, "git-clone-run")
+	spec.ResourceTemplates[0].Raw = bytes.Replace(spec.ResourceTemplates[0].Raw,
+		[]byte(`"namespace":"`+namespace+`"`), []byte(`"namespace":"`+target+`"`), 1)
+	return &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns},
+		Spec: triggersv1beta1.TriggerSpec{
+			ServiceAccountName: sa,
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("https://github.com/tektoncd/triggers")},
+				{Name: "revision", Value: ptr.String("main")},
+				{Name: "name", Value: ptr.String(ns + "-" + name)},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Spec: spec},
+		},
+	}
+}
+
+func TestHandleEvent_EventListenerPolicies(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			NamespaceSelector: triggersv1beta1.NamespaceSelector{MatchNames: []string{"*"}},
+		},
+	}
+	trs := []*triggersv1beta1.Trigger{
+		policyTrigger(t, namespace, "local", ""),
+		policyTrigger(t, "bar", "allowed", "triggers"),
+		policyTrigger(t, "bar", "no-sa", ""),
+		policyTrigger(t, "bar", "other-sa", "admin"),
+		policyTrigger(t, "baz", "other-ns", "triggers"),
+		crossNamespaceTrigger(t, "bar", "cross-ns", "triggers", namespace),
+	}
+
+	for _, tc := range []struct {
+		name      string
+		resources []triggersv1alpha1.PolicyResource
+		actions   []string
+		wantRuns  []string
+	}{{
+		name:      "allowed resources",
+		resources: []triggersv1alpha1.PolicyResource{{Group: "tekton.dev", Kind: "TaskRun"}},
+		actions:   []string{"create"},
+		wantRuns:  []string{"bar-allowed", "foo-local"},
+	}, {
+		name:      "other resources",
+		resources: []triggersv1alpha1.PolicyResource{{Group: "tekton.dev", Kind: "PipelineRun"}},
+	}, {
+		name:      "other actions",
+		resources: []triggersv1alpha1.PolicyResource{{Group: "tekton.dev", Kind: "TaskRun"}},
+		actions:   []string{"apply", "patch"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, dynamicClient := getSinkAssets(t, test.Resources{
+				EventListeners: []*triggersv1beta1.EventListener{el},
+				EventListenerPolicies: []*triggersv1alpha1.EventListenerPolicy{{
+					ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+					Spec: triggersv1alpha1.EventListenerPolicySpec{
+						EventListeners:      []triggersv1alpha1.EventListenerReference{{Namespace: namespace, Name: el.Name}},
+						Namespaces:          []string{"bar"},
+						ServiceAccountNames: []string{"triggers"},
+						Resources:           tc.resources,
+						Actions:             tc.actions,
+					},
+				}},
+				Triggers: trs,
+			}, el.Name, nil)
+			sink.Auth = noAuthOverride{}
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+
+			resp, err := http.Post(ts.URL, "application/json", bytes.NewReader([]byte(`{}`)))
+			if err != nil {
+				t.Fatalf("error making request to eventListener: %s", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusAccepted {
+				t.Errorf("Response code doesn't match: got %d, want %d", resp.StatusCode, http.StatusAccepted)
+			}
+
+			sink.WGProcessTriggers.Wait()
+			var runs []string
+			for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+				runs = append(runs, tr.Name)
+			}
+			sort.Strings(runs)
+			if diff := cmp.Diff(tc.wantRuns, runs); diff != "" {
+				t.Errorf("Created TaskRuns -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestKubernetesEventHandler_EventListenerPolicies(t *testing.T) {
+	source := triggersv1beta1.KubernetesEventSource{Name: "configmaps", APIVersion: "v1", Kind: "ConfigMap"}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers:               []triggersv1beta1.EventListenerTrigger{{TriggerRef: "local"}},
+			KubernetesEventSources: []triggersv1beta1.KubernetesEventSource{source},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners: []*triggersv1beta1.EventListener{el},
+		EventListenerPolicies: []*triggersv1alpha1.EventListenerPolicy{{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+			Spec: triggersv1alpha1.EventListenerPolicySpec{
+				EventListeners: []triggersv1alpha1.EventListenerReference{{Namespace: namespace, Name: el.Name}},
+				KubernetesEventSources: &triggersv1alpha1.PolicyKubernetesEventSources{
+					Resources: []triggersv1alpha1.PolicyResource{{Group: "tekton.dev", Kind: "*"}},
+				},
+			},
+		}},
+		Triggers: []*triggersv1beta1.Trigger{policyTrigger(t, namespace, "local", "")},
+	}, el.Name, nil)
+
+	handler, err := sink.kubernetesEventHandler(context.Background(), source)
+	if err != nil {
+		t.Fatalf("kubernetesEventHandler() returned error: %v", err)
+	}
+	handler.OnAdd(configMap("1", "draft"), false)
+	sink.WGProcessTriggers.Wait()
+	if runs := toTaskRun(t, dynamicClient.Actions()); len(runs) != 0 {
+		t.Errorf("Created TaskRuns %v, want none", runs)
+	}
+}
+
+func TestPollTrigger_EventListenerPolicies(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+	}
+	tr := policyTrigger(t, namespace, "poll", "")
+	tr.Spec.Poll = &triggersv1beta1.TriggerPoll{URL: "https://github.com/tektoncd/triggers", SecretName: "git-credentials"}
+	sink, _ := getSinkAssets(t, test.Resources{
+		EventListeners: []*triggersv1beta1.EventListener{el},
+		EventListenerPolicies: []*triggersv1alpha1.EventListenerPolicy{{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+			Spec: triggersv1alpha1.EventListenerPolicySpec{
+				EventListeners: []triggersv1alpha1.EventListenerReference{{Namespace: namespace, Name: el.Name}},
+				SecretNames:    []string{"broker-credentials"},
+			},
+		}},
+	}, el.Name, nil)
+
+	err := sink.pollTrigger(context.Background(), *tr, el, map[string]bool{"foo.poll": true})
+	want := "EventListenerPolicy tenants doesn't allow Secret git-credentials"
+	if err == nil || err.Error() != want {
+		t.Errorf("pollTrigger() returned %v, want %s", err, want)
+	}
+}
+
+func TestAllowedMessageSources(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			MessageSources: []triggersv1beta1.MessageSource{
+				{Name: "allowed", AuthSecretName: "broker-credentials"},
+				{Name: "no-secret"},
+				{Name: "other-secret", AuthSecretName: "broker-credentials", TLSSecretName: "broker-tls"},
+			},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{
+		EventListeners: []*triggersv1beta1.EventListener{el},
+		EventListenerPolicies: []*triggersv1alpha1.EventListenerPolicy{{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenants"},
+			Spec: triggersv1alpha1.EventListenerPolicySpec{
+				EventListeners: []triggersv1alpha1.EventListenerReference{{Namespace: namespace, Name: el.Name}},
+				SecretNames:    []string{"broker-credentials"},
+			},
+		}},
+	}, el.Name, nil)
+
+	sources, err := sink.allowedMessageSources(el)
+	if err != nil {
+		t.Fatalf("allowedMessageSources() returned error: %v", err)
+	}
+	var got []string
+	for _, source := range sources {
+		got = append(got, source.Name)
+	}
+	if diff := cmp.Diff([]string{"allowed", "no-secret"}, got); diff != "" {
+		t.Errorf("allowedMessageSources() -want +got: %s", diff)
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/policy"
+	"github.com/tektoncd/triggers/pkg/template"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// defaultPollInterval is the time between two polls of a Git repository,
+	// unless set by the Trigger.
+	defaultPollInterval = time.Minute
+	// defaultPollRef is the pattern of the refs polled, unless set by the
+	// Trigger.
+	defaultPollRef = "refs/heads/*"
+	// zeroSHA is the before commit of the push event for a new ref.
+	zeroSHA = "0000000000000000000000000000000000000000"
+)
+
+// gitHTTPClient lists the refs of the polled Git repositories. Unlike the
+// HTTPClient of the Sink, it trusts the system certificate authorities. It
+// doesn't follow redirects from https to http, which would send the
+// credentials of the repository in clear text.
+var gitHTTPClient = &http.Client{
+	Timeout: time.Minute,
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		if via[0].URL.Scheme == "https" && req.URL.Scheme != "https" {
+			return fmt.Errorf("refusing to follow the redirect to %s", req.URL.Redacted())
+		}
+		return nil
+	},
+}
+
+// pollState is the last seen state of the repository polled by a Trigger.
+type pollState struct {
+	URL  string            `json:"url"`
+	Refs map[string]string `json:"refs"`
+}
+
+// pushEvent is the body of the event fired for a new or updated ref.
+type pushEvent struct {
+	Ref        string         `json:"ref"`
+	Before     string         `json:"before"`
+	After      string         `json:"after"`
+	Created    bool           `json:"created"`
+	Repository pushRepository `json:"repository"`
+}
+
+type pushRepository struct {
+	URL string `json:"url"`
+}
+
+// pollDue polls the repositories of the Triggers that are due at the given
+// time. A repository is polled at most once at a time.
+func (s *scheduler) pollDue(ctx context.Context, el *triggersv1.EventListener, trs []*triggersv1.Trigger, now time.Time) {
+	seen := map[string]bool{}
+	for _, t := range trs {
+		if t.Spec.Poll != nil {
+			seen[pollStateKey(t)] = true
+		}
+	}
+	for _, t := range trs {
+		if t.Spec.Poll == nil {
+			continue
+		}
+		key := pollStateKey(t)
+		if next, ok := s.nextPoll[key]; ok && now.Before(next) {
+			continue
+		}
+		interval := defaultPollInterval
+		if t.Spec.Poll.Interval != nil {
+			interval = t.Spec.Poll.Interval.Duration
+		}
+		s.nextPoll[key] = now.Add(interval)
+
+		s.mu.Lock()
+		if s.polling[key] {
+			s.mu.Unlock()
+			continue
+		}
+		s.polling[key] = true
+		s.mu.Unlock()
+
+		tr := *t
+		s.sink.processInBackground(func() {
+			defer func() {
+				s.mu.Lock()
+				delete(s.polling, key)
+				s.mu.Unlock()
+			}()
+			if err := s.sink.pollTrigger(ctx, tr, el, seen); err != nil {
+				s.sink.Logger.Errorf("failed to poll the repository of Trigger %s/%s: %v", tr.Namespace, tr.Name, err)
+			}
+		})
+	}
+	for key := range s.nextPoll {
+		if !seen[key] {
+			delete(s.nextPoll, key)
+		}
+	}
+}
+
+// pollTrigger lists the refs of the repository polled by the Trigger, and
+// fires a push event for each matching ref that is new or was updated since
+// the last poll. The first poll of a repository only records its refs. A ref
+// whose event fails to be processed keeps its last seen commit, so that it is
+// fired again by the next poll. The state of the Triggers that aren't polled
+// anymore is dropped.
+func (r Sink) pollTrigger(ctx context.Context, t triggersv1.Trigger, el *triggersv1.EventListener, polled map[string]bool) error {
+	poll := t.Spec.Poll
+	policies, err := policy.For(r.EventListenerPolicyLister, el.Namespace, el.Name)
+	if err != nil {
+		return err
+	}
+	if err := policies.CheckPollSecret(el, poll.SecretName); err != nil {
+		return err
+	}
+	var creds *gitCredentials
+	if poll.SecretName != "" {
+		secret, err := r.KubeClientSet.CoreV1().Secrets(t.Namespace).Get(ctx, poll.SecretName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get the credentials of the repository: %w", err)
+		}
+		creds = &gitCredentials{
+			username: string(secret.Data[corev1.BasicAuthUsernameKey]),
+			password: string(secret.Data[corev1.BasicAuthPasswordKey]),
+		}
+	}
+	remote, err := lsRemote(ctx, gitHTTPClient, poll.URL, creds)
+	if err != nil {
+		return err
+	}
+	current := matchingRefs(remote, poll.Refs)
+
+	key := pollStateKey(&t)
+	previous, err := r.loadPollState(ctx, key)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]string, len(current))
+	for ref, sha := range current {
+		seen[ref] = sha
+	}
+	if previous != nil && previous.URL == poll.URL {
+		names := make([]string, 0, len(current))
+		for ref := range current {
+			names = append(names, ref)
+		}
+		sort.Strings(names)
+		for _, ref := range names {
+			before, ok := previous.Refs[ref]
+			if ok && before == current[ref] {
+				continue
+			}
+			event := pushEvent{
+				Ref:        ref,
+				Before:     before,
+				After:      current[ref],
+				Created:    !ok,
+				Repository: pushRepository{URL: poll.URL},
+			}
+			if !ok {
+				event.Before = zeroSHA
+			}
+			body, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			eventID := template.UUID()
+			log := r.eventLogger(el, eventID)
+			log.Infof("firing Trigger %s/%s for %s of %s updated to %s", t.Namespace, t.Name, ref, poll.URL, event.After)
+			status := r.processFiredEvent(ctx, t, el, eventID, body, http.Header{"Content-Type": {"application/json"}}, log)
+			if status != triggersv1alpha1.TriggerOutcomeFailed {
+				continue
+			}
+			if ok {
+				seen[ref] = before
+			} else {
+				delete(seen, ref)
+			}
+		}
+	}
+	return r.savePollState(ctx, el, key, &pollState{URL: poll.URL, Refs: seen}, polled)
+}
+
+// matchingRefs returns the refs that match one of the patterns.
+func matchingRefs(refs map[string]string, patterns []string) map[string]string {
+	if len(patterns) == 0 {
+		patterns = []string{defaultPollRef}
+	}
+	out := map[string]string{}
+	for ref, sha := range refs {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, ref); ok {
+				out[ref] = sha
+				break
+			}
+		}
+	}
+	return out
+}
+
+// pollStateName is the name of the ConfigMap holding the last seen state of
+// the repositories polled by the Triggers of the EventListener.
+func pollStateName(elName string) string {
+	return elName + "-poll-state"
+}
+
+func pollStateKey(t *triggersv1.Trigger) string {
+	return t.Namespace + "." + t.Name
+}
+
+func (r Sink) loadPollState(ctx context.Context, key string) (*pollState, error) {
+	cm, err := r.KubeClientSet.CoreV1().ConfigMaps(r.EventListenerNamespace).Get(ctx, pollStateName(r.EventListenerName), metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the poll state: %w", err)
+	}
+	data, ok := cm.Data[key]
+	if !ok {
+		return nil, nil
+	}
+	state := &pollState{}
+	if err := json.Unmarshal([]byte(data), state); err != nil {
+		// Start over from the current refs
+		return nil, nil
+	}
+	return state, nil
+}
+
+// savePollState records the state of the repository polled by a Trigger,
+// and drops the state of the Triggers that aren't polled anymore.
+func (r Sink) savePollState(ctx context.Context, el *triggersv1.EventListener, key string, state *pollState, polled map[string]bool) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	configMaps := r.KubeClientSet.CoreV1().ConfigMaps(r.EventListenerNamespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, pollStateName(r.EventListenerName), metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pollStateName(r.EventListenerName),
+					Namespace: r.EventListenerNamespace,
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: triggersv1.SchemeGroupVersion.String(),
+						Kind:       "EventListener",
+						Name:       el.Name,
+						UID:        el.UID,
+					}},
+				},
+				Data: map[string]string{key: string(data)},
+			}
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			if kerrors.IsAlreadyExists(err) {
+				// Retry as a conflict
+				return kerrors.NewConflict(corev1.Resource("configmaps"), cm.Name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		for k := range cm.Data {
+			if !polled[k] {
+				delete(cm.Data, k)
+			}
+		}
+		cm.Data[key] = string(data)
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
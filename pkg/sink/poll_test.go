@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestMatchingRefs(t *testing.T) {
+	refs := map[string]string{
+		"HEAD":                 "1",
+		"refs/heads/main":      "1",
+		"refs/heads/feature/x": "2",
+		"refs/tags/v1":         "3",
+	}
+	for _, tc := range []struct {
+		name     string
+		patterns []string
+		want     map[string]string
+	}{{
+		name: "default",
+		want: map[string]string{"refs/heads/main": "1"},
+	}, {
+		name:     "patterns",
+		patterns: []string{"refs/heads/feature/*", "refs/tags/*"},
+		want:     map[string]string{"refs/heads/feature/x": "2", "refs/tags/v1": "3"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, matchingRefs(refs, tc.patterns)); diff != "" {
+				t.Errorf("matchingRefs() -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPollDue(t *testing.T) {
+	var mu sync.Mutex
+	refs := []string{"1111111111111111111111111111111111111111 refs/heads/main"}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/x-git-upload-pack-advertisement")
+		fmt.Fprint(w, refAdvertisement(refs...))
+	}))
+	defer ts.Close()
+	defer func(c *http.Client) { gitHTTPClient = c }(gitHTTPClient)
+	gitHTTPClient = ts.Client()
+
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "poll", Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(body.after)")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+			Poll: &triggersv1beta1.TriggerPoll{
+				URL:        ts.URL,
+				Interval:   &metav1.Duration{Duration: time.Minute},
+				SecretName: "git-credentials",
+			},
+		},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers:        []triggersv1beta1.EventListenerTrigger{{TriggerRef: "poll"}},
+			PollSecretNames: []string{"git-credentials"},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-credentials", Namespace: namespace},
+		Data:       map[string][]byte{"username": []byte("user"), "password": []byte("token")},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners:   []*triggersv1beta1.EventListener{el},
+		Triggers:         []*triggersv1beta1.Trigger{tr},
+		TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+		Secrets:          []*corev1.Secret{secret},
+	}, el.Name, nil)
+	// The state of a Trigger that was deleted since the last poll
+	if _, err := sink.KubeClientSet.CoreV1().ConfigMaps(namespace).Create(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-el-poll-state",
+			Namespace:       namespace,
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "triggers.tekton.dev/v1beta1", Kind: "EventListener", Name: el.Name, UID: el.UID}},
+		},
+		Data: map[string]string{"foo.deleted": `{"url":"https://git.example.com/deleted","refs":{}}`},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("error creating the poll state: %v", err)
+	}
+	// Every event creates a TaskRun with the same name, so the TaskRuns
+	// aren't stored.
+	var failing atomic.Bool
+	dynamicClient.PrependReactor("create", "*", func(action ktesting.Action) (bool, runtime.Object, error) {
+		if failing.Load() {
+			return true, nil, errors.New("failed to create")
+		}
+		return true, action.(ktesting.CreateAction).GetObject(), nil
+	})
+	revisions := func() []string {
+		var out []string
+		for _, a := range dynamicClient.Actions() {
+			if c, ok := a.(ktesting.CreateActionImpl); ok {
+				params, _, _ := unstructured.NestedSlice(c.GetObject().(*unstructured.Unstructured).Object, "spec", "params")
+				out = append(out, params[1].(map[string]interface{})["value"].(string))
+			}
+		}
+		return out
+	}
+
+	s := newScheduler(sink)
+	start := time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)
+	for _, step := range []struct {
+		name          string
+		now           time.Time
+		refs          []string
+		fail          bool
+		wantRevisions []string
+	}{{
+		name: "first poll records the refs",
+		now:  start,
+	}, {
+		name: "updated before the interval",
+		now:  start.Add(30 * time.Second),
+		refs: []string{"2222222222222222222222222222222222222222 refs/heads/main"},
+	}, {
+		name:          "updated ref",
+		now:           start.Add(time.Minute),
+		wantRevisions: []string{"2222222222222222222222222222222222222222"},
+	}, {
+		name:          "unchanged",
+		now:           start.Add(2 * time.Minute),
+		wantRevisions: []string{"2222222222222222222222222222222222222222"},
+	}, {
+		name: "new ref and ref not matching",
+		now:  start.Add(3 * time.Minute),
+		refs: []string{
+			"2222222222222222222222222222222222222222 refs/heads/main",
+			"3333333333333333333333333333333333333333 refs/heads/feature",
+			"4444444444444444444444444444444444444444 refs/tags/v1",
+		},
+		wantRevisions: []string{
+			"2222222222222222222222222222222222222222",
+			"3333333333333333333333333333333333333333",
+		},
+	}, {
+		name: "failed event",
+		now:  start.Add(4 * time.Minute),
+		refs: []string{
+			"5555555555555555555555555555555555555555 refs/heads/main",
+			"3333333333333333333333333333333333333333 refs/heads/feature",
+		},
+		fail: true,
+		wantRevisions: []string{
+			"2222222222222222222222222222222222222222",
+			"3333333333333333333333333333333333333333",
+			"5555555555555555555555555555555555555555",
+		},
+	}, {
+		name: "failed event fired again",
+		now:  start.Add(5 * time.Minute),
+		wantRevisions: []string{
+			"2222222222222222222222222222222222222222",
+			"3333333333333333333333333333333333333333",
+			"5555555555555555555555555555555555555555",
+			"5555555555555555555555555555555555555555",
+		},
+	}, {
+		name: "fired once after success",
+		now:  start.Add(6 * time.Minute),
+		wantRevisions: []string{
+			"2222222222222222222222222222222222222222",
+			"3333333333333333333333333333333333333333",
+			"5555555555555555555555555555555555555555",
+			"5555555555555555555555555555555555555555",
+		},
+	}} {
+		if step.refs != nil {
+			mu.Lock()
+			refs = step.refs
+			mu.Unlock()
+		}
+		failing.Store(step.fail)
+		s.tick(context.Background(), step.now)
+		sink.WGProcessTriggers.Wait()
+		if diff := cmp.Diff(step.wantRevisions, revisions()); diff != "" {
+			t.Errorf("%s: created revisions -want +got: %s", step.name, diff)
+		}
+	}
+
+	cm, err := sink.KubeClientSet.CoreV1().ConfigMaps(namespace).Get(context.Background(), "my-el-poll-state", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("error getting the poll state: %v", err)
+	}
+	var state pollState
+	if err := json.Unmarshal([]byte(cm.Data["foo.poll"]), &state); err != nil {
+		t.Fatalf("invalid poll state: %v", err)
+	}
+	want := pollState{URL: ts.URL, Refs: map[string]string{
+		"refs/heads/main":    "5555555555555555555555555555555555555555",
+		"refs/heads/feature": "3333333333333333333333333333333333333333",
+	}}
+	if diff := cmp.Diff(want, state); diff != "" {
+		t.Errorf("poll state -want +got: %s", diff)
+	}
+	if _, ok := cm.Data["foo.deleted"]; ok {
+		t.Error("poll state of the deleted Trigger wasn't dropped")
+	}
+	if len(cm.OwnerReferences) != 1 || cm.OwnerReferences[0].UID != el.UID {
+		t.Errorf("poll state owner references = %v, want the EventListener", cm.OwnerReferences)
+	}
+}
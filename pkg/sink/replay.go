@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/resources"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// ReplayOfHeader holds the ID of the original event when an event is
+	// replayed against the EventListener.
+	ReplayOfHeader = "Tekton-Replay-Of"
+	// ReplayDryRunHeader is set to true to replay an event without creating
+	// any resources. The resources are validated with a server-side dry run
+	// and the outcome of each Trigger is returned in the response.
+	ReplayDryRunHeader = "Tekton-Replay-Dry-Run"
+	// ReplayTokenHeader holds the token that authenticates a replayed event,
+	// which must match the token key of the Secret named by the
+	// ReplayTokenSecretAnnotation of the EventListener.
+	ReplayTokenHeader = "Tekton-Replay-Token"
+	// replayTokenKey is the key of the token in the replay token Secret.
+	replayTokenKey = "token"
+)
+
+// replay describes how a replayed event is processed.
+type replay struct {
+	// of is the ID of the original event
+	of     string
+	dryRun bool
+}
+
+// replayFrom returns the replay settings of the request, and nil if the
+// request is not a replay.
+func replayFrom(header http.Header) *replay {
+	of := header.Get(ReplayOfHeader)
+	if of == "" {
+		return nil
+	}
+	dryRun, _ := strconv.ParseBool(header.Get(ReplayDryRunHeader))
+	return &replay{of: of, dryRun: dryRun}
+}
+
+// authorizeReplay returns an error, with the status of the response, unless
+// the replayed event is authenticated with the replay token of the
+// EventListener and the ID of its original event is valid.
+func (r Sink) authorizeReplay(ctx context.Context, el *triggersv1.EventListener, header http.Header, rp *replay) (int, error) {
+	if err := validateReplayOf(rp.of); err != nil {
+		return http.StatusBadRequest, err
+	}
+	secretName := el.GetAnnotations()[triggers.ReplayTokenSecretAnnotation]
+	if secretName == "" {
+		return http.StatusForbidden, fmt.Errorf("replays are disabled since the EventListener has no %s annotation", triggers.ReplayTokenSecretAnnotation)
+	}
+	secret, err := r.KubeClientSet.CoreV1().Secrets(el.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to get the replay token: %w", err)
+	}
+	token := secret.Data[replayTokenKey]
+	if len(token) == 0 || subtle.ConstantTimeCompare(token, []byte(header.Get(ReplayTokenHeader))) != 1 {
+		return http.StatusUnauthorized, fmt.Errorf("invalid %s header", ReplayTokenHeader)
+	}
+	return 0, nil
+}
+
+// validateReplayOf returns an error if the ID of the original event of a
+// replay can't be used as a label value.
+func validateReplayOf(of string) error {
+	if msgs := validation.IsValidLabelValue(of); len(msgs) > 0 {
+		return fmt.Errorf("invalid %s header: %s", ReplayOfHeader, strings.Join(msgs, ", "))
+	}
+	return nil
+}
+
+// labelReplayedResources adds the ID of the original event as a label to the
+// resources created for a replayed event.
+func labelReplayedResources(res []json.RawMessage, replayOf string) ([]json.RawMessage, error) {
+	if err := validateReplayOf(replayOf); err != nil {
+		return nil, err
+	}
+	out := make([]json.RawMessage, 0, len(res))
+	for _, rt := range res {
+		data := new(unstructured.Unstructured)
+		if err := data.UnmarshalJSON(rt); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal json from the TriggerTemplate: %w", err)
+		}
+		labels := data.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[triggers.GroupName+triggers.ReplayOfLabelKey] = replayOf
+		data.SetLabels(labels)
+		b, err := data.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+// dryRunResources validates the resources of a Trigger with a server-side dry
+// run, without creating any of them. Errors returned by the API server are
+// returned as a *ResourceError.
+func (r Sink) dryRunResources(triggerNS, sa string, res []json.RawMessage, triggerName, eventID string, log *zap.SugaredLogger) error {
+	discoveryClient, dynamicClient, err := r.clientsFor(sa, triggerNS, log)
+	if err != nil {
+		return err
+	}
+	for _, rr := range res {
+		if err := resources.Validate(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient); err != nil {
+			log.Errorf("problem validating obj: %#v", err)
+			return newResourceError(validationStage, triggerName, rr, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestReplayFrom(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header http.Header
+		want   *replay
+	}{{
+		name:   "not a replay",
+		header: http.Header{ReplayDryRunHeader: {"true"}},
+	}, {
+		name:   "replay",
+		header: http.Header{ReplayOfHeader: {"original"}},
+		want:   &replay{of: "original"},
+	}, {
+		name:   "dry run",
+		header: http.Header{ReplayOfHeader: {"original"}, ReplayDryRunHeader: {"true"}},
+		want:   &replay{of: "original", dryRun: true},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := replayFrom(tc.header)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(replay{})); diff != "" {
+				t.Errorf("replayFrom() -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_Replay(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-el",
+			Namespace:   namespace,
+			UID:         types.UID(elUID),
+			Annotations: map[string]string{triggers.ReplayTokenSecretAnnotation: "replay-token"},
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{
+				Name: "git-clone-trigger",
+				Bindings: []*triggersv1beta1.EventListenerBinding{
+					{Name: "url", Value: ptr.String("$(body.repository.url)")},
+					{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+				},
+				Template: &triggersv1beta1.EventListenerTemplate{Ref: ptr.String("git-clone")},
+			}},
+		},
+	}
+	body := `{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "replay-token", Namespace: namespace},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		dryRun     bool
+		cloudEvent bool
+		noReplays  bool
+		token      string
+		replayOf   string
+		wantStatus int
+	}{{
+		name:       "replay",
+		wantStatus: http.StatusAccepted,
+	}, {
+		name:       "dry run",
+		dryRun:     true,
+		wantStatus: http.StatusOK,
+	}, {
+		name:       "dry run of a CloudEvent",
+		dryRun:     true,
+		cloudEvent: true,
+		wantStatus: http.StatusOK,
+	}, {
+		name:       "replays disabled",
+		noReplays:  true,
+		wantStatus: http.StatusForbidden,
+	}, {
+		name:       "invalid token",
+		token:      "guess",
+		wantStatus: http.StatusUnauthorized,
+	}, {
+		name:       "original event ID isn't a label value",
+		replayOf:   "original/../event",
+		wantStatus: http.StatusBadRequest,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := el.DeepCopy()
+			if tc.noReplays {
+				el.Annotations = nil
+			}
+			sink, dynamicClient := getSinkAssets(t, test.Resources{
+				EventListeners:   []*triggersv1beta1.EventListener{el},
+				TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+				Secrets:          []*corev1.Secret{secret},
+			}, el.Name, nil)
+
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+			req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader([]byte(body)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			replayOf := "original"
+			if tc.replayOf != "" {
+				replayOf = tc.replayOf
+			}
+			token := "s3cr3t"
+			if tc.token != "" {
+				token = tc.token
+			}
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(ReplayOfHeader, replayOf)
+			req.Header.Set(ReplayTokenHeader, token)
+			if tc.dryRun {
+				req.Header.Set(ReplayDryRunHeader, "true")
+			}
+			if tc.cloudEvent {
+				req.Header.Set("Ce-Specversion", "1.0")
+				req.Header.Set("Ce-Id", "original")
+				req.Header.Set("Ce-Type", "push")
+				req.Header.Set("Ce-Source", "github")
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error making request to eventListener: %s", err)
+			}
+			if resp.StatusCode != tc.wantStatus {
+				t.Fatalf("expected response code %d but got: %v", tc.wantStatus, resp.Status)
+			}
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("Error reading response body: %s", err)
+			}
+			sink.WGProcessTriggers.Wait()
+
+			if tc.wantStatus >= http.StatusBadRequest {
+				if got.ErrorMessage == "" {
+					t.Error("got no error message in the response of a rejected replay")
+				}
+				if actions := dynamicClient.Actions(); len(actions) != 0 {
+					t.Errorf("got actions %v for a rejected replay", actions)
+				}
+				return
+			}
+			if got.EventID != eventID || got.ReplayOf != "original" {
+				t.Errorf("response event ID = %q, replay of = %q, want %q and %q", got.EventID, got.ReplayOf, eventID, "original")
+			}
+
+			var dryRuns, creates int
+			for _, a := range dynamicClient.Actions() {
+				ca, ok := a.(ktesting.CreateActionImpl)
+				if !ok {
+					continue
+				}
+				if len(ca.GetCreateOptions().DryRun) > 0 {
+					dryRuns++
+					continue
+				}
+				creates++
+				obj := ca.GetObject().(*unstructured.Unstructured)
+				if l := obj.GetLabels()[triggers.GroupName+triggers.ReplayOfLabelKey]; l != "original" {
+					t.Errorf("replay of label = %q, want %q", l, "original")
+				}
+			}
+
+			if !tc.dryRun {
+				if dryRuns != 0 || creates != 1 {
+					t.Errorf("got %d dry runs and %d creates, want 0 and 1", dryRuns, creates)
+				}
+				if len(got.Triggers) != 0 {
+					t.Errorf("got trigger outcomes %v in the response of a replay", got.Triggers)
+				}
+				return
+			}
+			if dryRuns != 1 || creates != 0 {
+				t.Errorf("got %d dry runs and %d creates, want 1 and 0", dryRuns, creates)
+			}
+			if len(got.Triggers) != 1 || got.Triggers[0].Status != triggersv1alpha1.TriggerOutcomeSucceeded {
+				t.Errorf("got trigger outcomes %v, want a single succeeded outcome", got.Triggers)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_ReplayGitHubEvent(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone-trigger", Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Interceptors: []*triggersv1beta1.EventInterceptor{{
+				Ref: triggersv1beta1.InterceptorRef{Name: "github", Kind: triggersv1beta1.ClusterInterceptorKind},
+				Params: []triggersv1beta1.InterceptorParams{{
+					Name:  "secretRef",
+					Value: test.ToV1JSON(t, &triggersv1beta1.SecretRef{SecretKey: "secretKey", SecretName: "github-secret"}),
+				}},
+			}},
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(body.head_commit.id)")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+		},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-el",
+			Namespace: namespace,
+			UID:       types.UID(elUID),
+			Annotations: map[string]string{
+				triggers.RecordInvocationsAnnotation: "true",
+				triggers.ReplayTokenSecretAnnotation: "replay-token",
+			},
+		},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: tr.Name}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners:      []*triggersv1beta1.EventListener{el},
+		Triggers:            []*triggersv1beta1.Trigger{tr},
+		TriggerTemplates:    []*triggersv1beta1.TriggerTemplate{tt},
+		ClusterInterceptors: []*triggersv1alpha1.ClusterInterceptor{github},
+		Secrets: []*corev1.Secret{{
+			ObjectMeta: metav1.ObjectMeta{Name: "github-secret", Namespace: namespace},
+			Data:       map[string][]byte{"secretKey": []byte("webhook-secret")},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{Name: "replay-token", Namespace: namespace},
+			Data:       map[string][]byte{"token": []byte("s3cr3t")},
+		}},
+	}, el.Name, nil)
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+	taskRuns := dynamicClient.Resource(schema.GroupVersionResource{Group: "tekton.dev", Version: "v1", Resource: "taskruns"}).Namespace(namespace)
+	send := func(header http.Header, body []byte) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, ts.URL, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("error making request to eventListener: %s", err)
+		}
+		defer resp.Body.Close()
+		sink.WGProcessTriggers.Wait()
+		if resp.StatusCode != http.StatusAccepted {
+			t.Fatalf("expected response code 202 but got: %v", resp.Status)
+		}
+	}
+
+	// The original event is signed by GitHub
+	body := []byte(`{"head_commit": {"id": "testrevision"}, "repository": {"url": "testurl"}}`)
+	send(http.Header{
+		"Content-Type":        {"application/json"},
+		"X-Github-Event":      {"push"},
+		"X-Hub-Signature-256": {test.HMACHeader(t, "webhook-secret", body, "sha256")},
+	}, body)
+	if _, err := taskRuns.Get(context.Background(), "git-clone-run", metav1.GetOptions{}); err != nil {
+		t.Fatalf("the original event didn't create its TaskRun: %v", err)
+	}
+	if err := taskRuns.Delete(context.Background(), "git-clone-run", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The recorded event is replayed without its redacted signature, like the
+	// replay command of the triggerrun CLI does
+	ti, err := sink.TriggersClient.TriggersV1alpha1().TriggerInvocations(namespace).Get(context.Background(), eventID, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get TriggerInvocation: %v", err)
+	}
+	header := http.Header{}
+	for k, values := range ti.Spec.Event.Header {
+		if len(values) > 0 && values[0] != RedactedValue {
+			header[k] = values
+		}
+	}
+	if _, ok := header["X-Hub-Signature-256"]; ok {
+		t.Fatalf("the TriggerInvocation recorded the signature of the event: %v", ti.Spec.Event.Header)
+	}
+	header.Set(ReplayOfHeader, ti.Spec.EventID)
+	header.Set(ReplayTokenHeader, "s3cr3t")
+	send(header, []byte(ti.Spec.Event.Body))
+	replayed, err := taskRuns.Get(context.Background(), "git-clone-run", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("the replayed event didn't create its TaskRun: %v", err)
+	}
+	if l := replayed.GetLabels()[triggers.GroupName+triggers.ReplayOfLabelKey]; l != eventID {
+		t.Errorf("replay of label = %q, want %q", l, eventID)
+	}
+	if err := taskRuns.Delete(context.Background(), "git-clone-run", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Events that aren't authenticated replays are still verified
+	header.Del(ReplayOfHeader)
+	header.Del(ReplayTokenHeader)
+	send(header, []byte(ti.Spec.Event.Body))
+	if _, err := taskRuns.Get(context.Background(), "git-clone-run", metav1.GetOptions{}); err == nil {
+		t.Error("an unsigned event created a TaskRun")
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"encoding/json"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/resources"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// validationStage is the stage of a ResourceError returned by the dry
+	// run of a resource.
+	validationStage = "validation"
+	// creationStage is the stage of a ResourceError returned by the creation
+	// of a resource.
+	creationStage = "creation"
+)
+
+// ResourceError is returned when a resource of a Trigger fails validation or
+// creation. It is sent as the data of the failed CloudEvent for the Trigger.
+type ResourceError struct {
+	// Trigger is the name of the Trigger
+	Trigger string `json:"trigger"`
+	// Kind is the kind of the resource
+	Kind string `json:"kind,omitempty"`
+	// Name is the name or generateName of the resource
+	Name string `json:"name,omitempty"`
+	// Stage is either validation or creation
+	Stage string `json:"stage"`
+	// Reason classifies the error returned by the API server
+	Reason resources.ErrorReason `json:"reason"`
+	// ErrorMessage is the error returned by the API server
+	ErrorMessage string `json:"errorMessage"`
+
+	err error
+}
+
+func newResourceError(stage, triggerName string, rt json.RawMessage, err error) *ResourceError {
+	re := &ResourceError{
+		Trigger:      triggerName,
+		Stage:        stage,
+		Reason:       resources.ClassifyError(err),
+		ErrorMessage: err.Error(),
+		err:          err,
+	}
+	data := new(unstructured.Unstructured)
+	if uErr := data.UnmarshalJSON(rt); uErr == nil {
+		re.Kind = data.GetKind()
+		re.Name = data.GetName()
+		if re.Name == "" {
+			re.Name = data.GetGenerateName()
+		}
+	}
+	return re
+}
+
+func (e *ResourceError) Error() string {
+	return e.err.Error()
+}
+
+func (e *ResourceError) Unwrap() error {
+	return e.err
+}
+
+// validateResources returns true if the resources of the EventListener must
+// be validated with a dry run before they are created.
+func validateResources(el *triggersv1.EventListener) bool {
+	return el.GetAnnotations()[triggers.ResourceValidationAnnotation] == triggers.DryRunResourceValidation
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/resources"
+	"github.com/tektoncd/triggers/test"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestValidateResources(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{{
+		name: "no annotation",
+	}, {
+		name:        "dry run",
+		annotations: map[string]string{triggers.ResourceValidationAnnotation: triggers.DryRunResourceValidation},
+		want:        true,
+	}, {
+		name:        "none",
+		annotations: map[string]string{triggers.ResourceValidationAnnotation: triggers.NoResourceValidation},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1beta1.EventListener{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			if got := validateResources(el); got != tc.want {
+				t.Errorf("validateResources() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCreateResources_Validation(t *testing.T) {
+	res := []json.RawMessage{
+		json.RawMessage(`{"apiVersion": "tekton.dev/v1", "kind": "TaskRun", "metadata": {"name": "good"}}`),
+		json.RawMessage(`{"apiVersion": "tekton.dev/v1", "kind": "TaskRun", "metadata": {"name": "bad"}}`),
+	}
+	invalid := kerrors.NewInvalid(schema.GroupKind{Group: "tekton.dev", Kind: "TaskRun"}, "bad", nil)
+
+	for _, tc := range []struct {
+		name        string
+		validate    bool
+		wantDryRuns int
+		wantCreates int
+		wantCreated []string
+		wantErr     *ResourceError
+	}{{
+		name:        "validation fails before any resource is created",
+		validate:    true,
+		wantDryRuns: 2,
+		wantErr: &ResourceError{
+			Trigger:      "my-trigger",
+			Kind:         "TaskRun",
+			Name:         "bad",
+			Stage:        validationStage,
+			Reason:       resources.ErrorReasonSchema,
+			ErrorMessage: `validation of resource with group version kind "tekton.dev/v1, Resource=taskruns" failed: ` + invalid.Error(),
+		},
+	}, {
+		name:        "creation fails without validation",
+		wantCreates: 2,
+		wantCreated: []string{"good"},
+		wantErr: &ResourceError{
+			Trigger:      "my-trigger",
+			Kind:         "TaskRun",
+			Name:         "bad",
+			Stage:        creationStage,
+			Reason:       resources.ErrorReasonSchema,
+			ErrorMessage: `couldn't create resource with group version kind "tekton.dev/v1, Resource=taskruns": ` + invalid.Error(),
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, dynamicClient := getSinkAssets(t, test.Resources{}, "my-el", nil)
+			dynamicClient.PrependReactor("create", "taskruns", func(action ktesting.Action) (bool, runtime.Object, error) {
+				obj := action.(ktesting.CreateAction).GetObject().(*unstructured.Unstructured)
+				if obj.GetName() == "bad" {
+					return true, nil, invalid
+				}
+				return false, nil, nil
+			})
+
+			created, err := sink.createResources(namespace, "", res, "my-trigger", eventID, tc.validate, sink.Logger)
+			var gotErr *ResourceError
+			if !errors.As(err, &gotErr) {
+				t.Fatalf("createResources() returned %v, want a *ResourceError", err)
+			}
+			if diff := cmp.Diff(tc.wantErr, gotErr, cmpopts.IgnoreUnexported(ResourceError{})); diff != "" {
+				t.Errorf("createResources() error -want +got: %s", diff)
+			}
+			var gotCreated []string
+			for _, obj := range created {
+				gotCreated = append(gotCreated, obj.GetName())
+			}
+			if diff := cmp.Diff(tc.wantCreated, gotCreated); diff != "" {
+				t.Errorf("createResources() created -want +got: %s", diff)
+			}
+
+			var dryRuns, creates int
+			for _, a := range dynamicClient.Actions() {
+				if len(a.(ktesting.CreateActionImpl).GetCreateOptions().DryRun) > 0 {
+					dryRuns++
+				} else {
+					creates++
+				}
+			}
+			if dryRuns != tc.wantDryRuns || creates != tc.wantCreates {
+				t.Errorf("got %d dry runs and %d creates, want %d and %d", dryRuns, creates, tc.wantDryRuns, tc.wantCreates)
+			}
+		})
+	}
+}
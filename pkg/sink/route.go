@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"net/http"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+type pathParamsKey struct{}
+
+type firedEventKey struct{}
+
+// withFiredEvent marks the context of an event fired by the EventListener,
+// such as a consumed message or a Kubernetes event, rather than received
+// over HTTP. Routes only restrict the requests received over HTTP, so every
+// Trigger and trigger group processes the fired events.
+func withFiredEvent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, firedEventKey{}, true)
+}
+
+// withRoute returns whether the request matches the route, and its context
+// with the values of the wildcards of the path added to the ones of the
+// routes it already matched, such as the route of a trigger group.
+func withRoute(ctx context.Context, route *triggersv1.TriggerRoute, request *http.Request) (context.Context, bool) {
+	if fired, _ := ctx.Value(firedEventKey{}).(bool); fired {
+		return ctx, true
+	}
+	params, ok := route.Match(request.Method, request.URL.Path)
+	if !ok {
+		return ctx, false
+	}
+	if len(params) == 0 {
+		return ctx, true
+	}
+	merged := map[string]string{}
+	for k, v := range pathParamsFrom(ctx) {
+		merged[k] = v
+	}
+	for k, v := range params {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, pathParamsKey{}, merged), true
+}
+
+func pathParamsFrom(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(pathParamsKey{}).(map[string]string)
+	return params
+}
+
+// matchesRoutes returns true if the request matches the route of one of the
+// Triggers or trigger groups, or if none of them has a route, in which case
+// they process every request.
+func matchesRoutes(el *triggersv1.EventListener, trs []*triggersv1.Trigger, request *http.Request) bool {
+	for _, t := range trs {
+		if _, ok := t.Spec.Route.Match(request.Method, request.URL.Path); ok {
+			return true
+		}
+	}
+	for _, g := range el.Spec.TriggerGroups {
+		if _, ok := g.Route.Match(request.Method, request.URL.Path); ok {
+			return true
+		}
+	}
+	return len(trs) == 0 && len(el.Spec.TriggerGroups) == 0
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/ptr"
+)
+
+func routedTrigger(name string, route *triggersv1beta1.TriggerRoute) *triggersv1beta1.Trigger {
+	return &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(context.path)")},
+				{Name: "revision", Value: ptr.String("$(context.pathParams.org)")},
+				{Name: "name", Value: ptr.String(name + "-run")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+			Route:    route,
+		},
+	}
+}
+
+func TestHandleEvent_Routes(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	trs := []*triggersv1beta1.Trigger{
+		routedTrigger("github", &triggersv1beta1.TriggerRoute{Path: "/github/{org}", Methods: []string{http.MethodPost}}),
+		routedTrigger("gitlab", &triggersv1beta1.TriggerRoute{Path: "/gitlab/{org}"}),
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "github"}, {TriggerRef: "gitlab"}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantRuns   []string
+	}{{
+		name:       "github",
+		method:     http.MethodPost,
+		path:       "/github/tektoncd",
+		wantStatus: http.StatusAccepted,
+		wantRuns:   []string{"github-run /github/tektoncd tektoncd"},
+	}, {
+		name:       "gitlab",
+		method:     http.MethodPut,
+		path:       "/gitlab/tektoncd/",
+		wantStatus: http.StatusAccepted,
+		wantRuns:   []string{"gitlab-run /gitlab/tektoncd/ tektoncd"},
+	}, {
+		name:       "other method",
+		method:     http.MethodPut,
+		path:       "/github/tektoncd",
+		wantStatus: http.StatusNotFound,
+	}, {
+		name:       "other path",
+		method:     http.MethodPost,
+		path:       "/slack",
+		wantStatus: http.StatusNotFound,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, dynamicClient := getSinkAssets(t, test.Resources{
+				EventListeners:   []*triggersv1beta1.EventListener{el},
+				Triggers:         trs,
+				TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+			}, el.Name, nil)
+			ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+			defer ts.Close()
+
+			req, err := http.NewRequest(tc.method, ts.URL+tc.path, bytes.NewReader([]byte(`{}`)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("error making request to eventListener: %s", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("Response code doesn't match: got %d, want %d", resp.StatusCode, tc.wantStatus)
+			}
+
+			sink.WGProcessTriggers.Wait()
+			var runs []string
+			for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+				runs = append(runs, tr.Name+" "+tr.Spec.Params[0].Value.StringVal+" "+tr.Spec.Params[1].Value.StringVal)
+			}
+			if diff := cmp.Diff(tc.wantRuns, runs); diff != "" {
+				t.Errorf("Created TaskRuns -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_TriggerGroupRoute(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	labels := map[string]string{"group": "github"}
+	all := routedTrigger("all", nil)
+	all.Labels = labels
+	push := routedTrigger("push", &triggersv1beta1.TriggerRoute{Path: "/github/{org}/push"})
+	push.Labels = labels
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			TriggerGroups: []triggersv1beta1.EventListenerTriggerGroup{{
+				Name:         "github",
+				Interceptors: []*triggersv1beta1.TriggerInterceptor{},
+				TriggerSelector: triggersv1beta1.EventListenerTriggerSelector{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+				},
+				Route: &triggersv1beta1.TriggerRoute{Path: "/github/{org}/{event...}"},
+			}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners:   []*triggersv1beta1.EventListener{el},
+		Triggers:         []*triggersv1beta1.Trigger{all, push},
+		TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+	}, el.Name, nil)
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+"/github/tektoncd/release", "application/json", bytes.NewReader([]byte(`{}`)))
+	if err != nil {
+		t.Fatalf("error making request to eventListener: %s", err)
+	}
+	checkSinkResponse(t, resp, el.Name)
+	sink.WGProcessTriggers.Wait()
+	var runs []string
+	for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+		runs = append(runs, tr.Name+" "+tr.Spec.Params[1].Value.StringVal)
+	}
+	if diff := cmp.Diff([]string{"all-run tektoncd"}, runs); diff != "" {
+		t.Errorf("Created TaskRuns -want +got: %s", diff)
+	}
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/template"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	// scheduleInterval is how often the scheduler checks for due Triggers.
+	scheduleInterval = time.Second
+	// scheduleCheckInterval is how often an EventListener without scheduled
+	// Triggers checks for new ones.
+	scheduleCheckInterval = 10 * time.Second
+	// scheduledTimeVar is replaced by the time at which a scheduled event
+	// was due, in RFC 3339 format.
+	scheduledTimeVar = "$(context.scheduledTime)"
+	// eventIDVar is replaced by the ID of a scheduled event.
+	eventIDVar = "$(context.eventID)"
+
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// scheduler fires the scheduled and polling Triggers of the EventListener,
+// and the events of its Kubernetes event sources.
+type scheduler struct {
+	sink Sink
+	// next holds the time at which each schedule is next due, keyed by the
+	// Trigger and its schedule so that a changed schedule starts over.
+	next map[string]time.Time
+	// nextPoll holds the time at which each polled repository is next due,
+	// keyed by the Trigger.
+	nextPoll map[string]time.Time
+
+	mu sync.Mutex
+	// polling holds the Triggers whose repository is being polled.
+	polling map[string]bool
+
+	watcher *watcher
+}
+
+func newScheduler(r Sink) *scheduler {
+	return &scheduler{
+		sink:     r,
+		next:     map[string]time.Time{},
+		nextPoll: map[string]time.Time{},
+		polling:  map[string]bool{},
+		watcher:  &watcher{sink: r},
+	}
+}
+
+// RunScheduler fires the scheduled and polling Triggers of the EventListener,
+// and the events of its Kubernetes event sources, until the context is done.
+// Once the EventListener has such a Trigger or source, its replicas elect a
+// leader with a Lease so that each event is fired once.
+func (r Sink) RunScheduler(ctx context.Context, identity string) {
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if !r.needsScheduler() {
+			continue
+		}
+		lock := &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      r.EventListenerName + "-scheduler",
+				Namespace: r.EventListenerNamespace,
+			},
+			Client:     r.KubeClientSet.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		}
+		// RunOrDie returns once leadership is lost, after which the
+		// replica contends for it again.
+		leading := &leaderTask{}
+		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			ReleaseOnCancel: true,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					leading.run(func() {
+						r.Logger.Infof("%s is firing the scheduled Triggers", identity)
+						newScheduler(r).run(ctx)
+					})
+				},
+				OnStoppedLeading: func() {
+					r.Logger.Infof("%s stopped firing the scheduled Triggers", identity)
+				},
+			},
+		})
+		// The scheduler runs in its own goroutine, and must not fire events
+		// once RunScheduler returns.
+		leading.wait()
+	}
+}
+
+// leaderTask is a task started by the leader election in its own goroutine,
+// which can be waited for once the election returns.
+type leaderTask struct {
+	mu      sync.Mutex
+	done    bool
+	running sync.WaitGroup
+}
+
+// run runs the task, unless wait was already called.
+func (l *leaderTask) run(task func()) {
+	l.mu.Lock()
+	if l.done {
+		l.mu.Unlock()
+		return
+	}
+	l.running.Add(1)
+	l.mu.Unlock()
+	defer l.running.Done()
+	task()
+}
+
+// wait prevents the task from starting, and waits for it to be done.
+func (l *leaderTask) wait() {
+	l.mu.Lock()
+	l.done = true
+	l.mu.Unlock()
+	l.running.Wait()
+}
+
+func (s *scheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(scheduleInterval)
+	defer ticker.Stop()
+	defer s.watcher.stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		}
+	}
+}
+
+// tick fires the scheduled Triggers and polls the repositories that are due
+// at the given time, and watches the Kubernetes event sources.
+func (s *scheduler) tick(ctx context.Context, now time.Time) {
+	el, err := s.sink.EventListenerLister.EventListeners(s.sink.EventListenerNamespace).Get(s.sink.EventListenerName)
+	if err != nil {
+		s.sink.Logger.Errorf("Error getting EventListener %s in Namespace %s: %s", s.sink.EventListenerName, s.sink.EventListenerNamespace, err)
+		return
+	}
+	s.watcher.sync(ctx, el, now)
+	scheduled, err := s.sink.scheduledTriggers(el)
+	if err != nil {
+		s.sink.Logger.Errorf("unable to select scheduled triggers: %s", err)
+		return
+	}
+	s.fireDue(ctx, el, scheduled, now)
+	s.pollDue(ctx, el, scheduled, now)
+}
+
+// fireDue fires the scheduled Triggers that are due at the given time. A
+// schedule seen for the first time is only due from its next occurrence.
+func (s *scheduler) fireDue(ctx context.Context, el *triggersv1.EventListener, trs []*triggersv1.Trigger, now time.Time) {
+	seen := map[string]bool{}
+	for _, t := range trs {
+		if t.Spec.Schedule == nil {
+			continue
+		}
+		schedule, err := t.Spec.Schedule.Parse()
+		if err != nil {
+			s.sink.Logger.Errorf("invalid schedule for Trigger %s/%s: %s", t.Namespace, t.Name, err)
+			continue
+		}
+		key := strings.Join([]string{t.Namespace, t.Name, t.Spec.Schedule.Cron, t.Spec.Schedule.TimeZone}, "/")
+		seen[key] = true
+		next, ok := s.next[key]
+		if !ok {
+			s.next[key] = schedule.Next(now)
+			continue
+		}
+		if now.Before(next) {
+			continue
+		}
+		s.next[key] = schedule.Next(now)
+		s.sink.fireScheduledTrigger(ctx, *t, el, next)
+	}
+	for key := range s.next {
+		if !seen[key] {
+			delete(s.next, key)
+		}
+	}
+}
+
+// fireScheduledTrigger processes a scheduled event for the Trigger.
+func (r Sink) fireScheduledTrigger(ctx context.Context, t triggersv1.Trigger, el *triggersv1.EventListener, scheduledTime time.Time) {
+	eventID := template.UUID()
+	log := r.eventLogger(el, eventID)
+	log.Infof("firing scheduled Trigger %s/%s due at %s", t.Namespace, t.Name, scheduledTime.Format(time.RFC3339))
+
+	body, header := scheduledEvent(t.Spec.Schedule, eventID, scheduledTime)
+	r.fireTrigger(ctx, t, el, eventID, body, header, log)
+}
+
+// eventLogger returns the logger of an event fired by the EventListener.
+func (r Sink) eventLogger(el *triggersv1.EventListener, eventID string) *zap.SugaredLogger {
+	return r.Logger.With(
+		zap.String("eventlistener", r.EventListenerName),
+		zap.String("namespace", r.EventListenerNamespace),
+		zap.String("eventlistenerUID", string(el.GetUID())),
+		zap.String(triggers.EventIDLabelKey, eventID),
+	)
+}
+
+// fireTrigger processes an event fired by the EventListener for the Trigger
+// in the background, the same way as an incoming event.
+func (r Sink) fireTrigger(ctx context.Context, t triggersv1.Trigger, el *triggersv1.EventListener, eventID string, body []byte, header http.Header, log *zap.SugaredLogger) {
+	r.processInBackground(func() {
+		r.processFiredEvent(ctx, t, el, eventID, body, header, log)
+	})
+}
+
+// processFiredEvent processes an event fired by the EventListener for the
+// Trigger and returns the outcome of the Trigger.
+func (r Sink) processFiredEvent(ctx context.Context, t triggersv1.Trigger, el *triggersv1.EventListener, eventID string, body []byte, header http.Header, log *zap.SugaredLogger) triggersv1alpha1.TriggerOutcomeStatus {
+	rec := newInvocationRecorder(el, eventID, header, body, nil)
+	request, err := http.NewRequestWithContext(withInvocationRecorder(ctx, rec), http.MethodPost, "/", bytes.NewReader(body))
+	if err != nil {
+		log.Errorf("failed to create event: %v", err)
+		return triggersv1alpha1.TriggerOutcomeFailed
+	}
+	request.Header = header
+
+	status := r.processTrigger(t, el, request, body, eventID, log, map[string]interface{}{})
+	r.writeInvocation(rec, log)
+	return status
+}
+
+// scheduledEvent returns the body and header of a scheduled event, with the
+// context variables replaced.
+func scheduledEvent(s *triggersv1.TriggerSchedule, eventID string, scheduledTime time.Time) ([]byte, http.Header) {
+	replacer := strings.NewReplacer(
+		eventIDVar, eventID,
+		scheduledTimeVar, scheduledTime.Format(time.RFC3339),
+	)
+	body := s.Body
+	if body == "" {
+		body = "{}"
+	}
+	header := http.Header{"Content-Type": {"application/json"}}
+	for k, v := range s.Header {
+		header.Set(k, replacer.Replace(v))
+	}
+	return []byte(replacer.Replace(body)), header
+}
+
+// needsScheduler returns true if the EventListener has a scheduled or
+// polling Trigger, or a Kubernetes event source.
+func (r Sink) needsScheduler() bool {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		return false
+	}
+	if len(el.Spec.KubernetesEventSources) > 0 {
+		return true
+	}
+	scheduled, err := r.scheduledTriggers(el)
+	return err == nil && len(scheduled) > 0
+}
+
+// scheduledTriggers returns the Triggers of the EventListener that have a
+// schedule or poll a repository, including those selected by its trigger
+// groups. A Trigger selected more than once is only returned once.
+func (r Sink) scheduledTriggers(el *triggersv1.EventListener) ([]*triggersv1.Trigger, error) {
+	trItems, err := r.selectTriggers(el.Spec.NamespaceSelector, el.Spec.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := r.merge(el.Spec.Triggers, trItems)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range el.Spec.TriggerGroups {
+		selected, err := r.selectTriggers(g.TriggerSelector.NamespaceSelector, g.TriggerSelector.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		merged = append(merged, selected...)
+	}
+	var scheduled []*triggersv1.Trigger
+	seen := map[string]bool{}
+	for _, t := range merged {
+		key := t.Namespace + "/" + t.Name
+		if (t.Spec.Schedule != nil || t.Spec.Poll != nil) && !seen[key] {
+			seen[key] = true
+			scheduled = append(scheduled, t)
+		}
+	}
+	return scheduled, nil
+}
+
+// withoutScheduledTriggers returns the Triggers that process incoming events.
+func withoutScheduledTriggers(trs []*triggersv1.Trigger) []*triggersv1.Trigger {
+	out := make([]*triggersv1.Trigger, 0, len(trs))
+	for _, t := range trs {
+		if t.Spec.Schedule == nil && t.Spec.Poll == nil {
+			out = append(out, t)
+		}
+	}
+	return out
+}
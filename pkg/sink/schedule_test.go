@@ -0,0 +1,255 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestScheduledEvent(t *testing.T) {
+	scheduledTime := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name       string
+		schedule   *triggersv1beta1.TriggerSchedule
+		wantBody   string
+		wantHeader http.Header
+	}{{
+		name:       "defaults",
+		schedule:   &triggersv1beta1.TriggerSchedule{Cron: "@hourly"},
+		wantBody:   "{}",
+		wantHeader: http.Header{"Content-Type": {"application/json"}},
+	}, {
+		name: "templated body and header",
+		schedule: &triggersv1beta1.TriggerSchedule{
+			Cron:   "@hourly",
+			Body:   `{"time": "$(context.scheduledTime)", "id": "$(context.eventID)"}`,
+			Header: map[string]string{"x-event-id": "$(context.eventID)", "Content-Type": "application/cloudevents+json"},
+		},
+		wantBody: `{"time": "2026-01-02T03:04:00Z", "id": "12345"}`,
+		wantHeader: http.Header{
+			"Content-Type": {"application/cloudevents+json"},
+			"X-Event-Id":   {"12345"},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			body, header := scheduledEvent(tc.schedule, eventID, scheduledTime)
+			if diff := cmp.Diff(tc.wantBody, string(body)); diff != "" {
+				t.Errorf("scheduledEvent() body -want +got: %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantHeader, header); diff != "" {
+				t.Errorf("scheduledEvent() header -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func scheduledTriggerAssets(t *testing.T) (Sink, *triggersv1beta1.EventListener, func() int) {
+	t.Helper()
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.repository.url)")},
+				{Name: "revision", Value: ptr.String("$(context.eventID)")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+			Schedule: &triggersv1beta1.TriggerSchedule{
+				Cron: "0 2 * * *",
+				Body: `{"repository": {"url": "testurl"}}`,
+			},
+		},
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "nightly"}},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners:   []*triggersv1beta1.EventListener{el},
+		Triggers:         []*triggersv1beta1.Trigger{tr},
+		TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+	}, el.Name, nil)
+	creates := func() int {
+		n := 0
+		for _, a := range dynamicClient.Actions() {
+			if _, ok := a.(ktesting.CreateActionImpl); ok {
+				n++
+			}
+		}
+		return n
+	}
+	return sink, el, creates
+}
+
+func TestFireDue(t *testing.T) {
+	sink, _, creates := scheduledTriggerAssets(t)
+	s := newScheduler(sink)
+	start := time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)
+
+	for _, step := range []struct {
+		now         time.Time
+		wantCreates int
+	}{
+		// The schedule is first seen, so it is only due from 02:00
+		{now: start, wantCreates: 0},
+		{now: start.Add(59 * time.Minute), wantCreates: 0},
+		{now: start.Add(time.Hour), wantCreates: 1},
+		// Already fired for 02:00
+		{now: start.Add(time.Hour + time.Second), wantCreates: 1},
+		{now: start.Add(25 * time.Hour), wantCreates: 2},
+	} {
+		s.tick(context.Background(), step.now)
+		sink.WGProcessTriggers.Wait()
+		if got := creates(); got != step.wantCreates {
+			t.Errorf("at %s got %d creates, want %d", step.now, got, step.wantCreates)
+		}
+	}
+}
+
+func TestScheduledTriggers(t *testing.T) {
+	scheduled := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: namespace, Labels: map[string]string{"team": "a"}},
+		Spec: triggersv1beta1.TriggerSpec{
+			Schedule: &triggersv1beta1.TriggerSchedule{Cron: "0 2 * * *"},
+		},
+	}
+	polling := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "poll", Namespace: namespace, Labels: map[string]string{"team": "b"}},
+		Spec: triggersv1beta1.TriggerSpec{
+			Poll: &triggersv1beta1.TriggerPoll{URL: "https://git.example.com/repo"},
+		},
+	}
+	incoming := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "push", Namespace: namespace, Labels: map[string]string{"team": "b"}},
+	}
+	group := func(team string) triggersv1beta1.EventListenerTriggerGroup {
+		return triggersv1beta1.EventListenerTriggerGroup{
+			Name: team,
+			TriggerSelector: triggersv1beta1.EventListenerTriggerSelector{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": team}},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		spec triggersv1beta1.EventListenerSpec
+		want []string
+	}{{
+		name: "triggerRef",
+		spec: triggersv1beta1.EventListenerSpec{
+			Triggers: []triggersv1beta1.EventListenerTrigger{{TriggerRef: "nightly"}, {TriggerRef: "push"}},
+		},
+		want: []string{"nightly"},
+	}, {
+		name: "trigger groups",
+		spec: triggersv1beta1.EventListenerSpec{
+			TriggerGroups: []triggersv1beta1.EventListenerTriggerGroup{group("a"), group("b")},
+		},
+		want: []string{"nightly", "poll"},
+	}, {
+		name: "selected more than once",
+		spec: triggersv1beta1.EventListenerSpec{
+			Triggers:      []triggersv1beta1.EventListenerTrigger{{TriggerRef: "nightly"}},
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			TriggerGroups: []triggersv1beta1.EventListenerTriggerGroup{group("a")},
+		},
+		want: []string{"nightly"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			el := &triggersv1beta1.EventListener{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+				Spec:       tc.spec,
+			}
+			sink, _ := getSinkAssets(t, test.Resources{
+				EventListeners: []*triggersv1beta1.EventListener{el},
+				Triggers:       []*triggersv1beta1.Trigger{scheduled, polling, incoming},
+			}, el.Name, nil)
+			trs, err := sink.scheduledTriggers(el)
+			if err != nil {
+				t.Fatalf("scheduledTriggers() returned error: %v", err)
+			}
+			var got []string
+			for _, tr := range trs {
+				got = append(got, tr.Name)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("scheduledTriggers() -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleEvent_SkipsScheduledTriggers(t *testing.T) {
+	sink, el, creates := scheduledTriggerAssets(t)
+	ts := httptest.NewServer(http.HandlerFunc(sink.HandleEvent))
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL, "application/json", bytes.NewReader([]byte(`{"repository": {"url": "testurl"}}`)))
+	if err != nil {
+		t.Fatalf("error making request to eventListener: %s", err)
+	}
+	checkSinkResponse(t, resp, el.Name)
+	sink.WGProcessTriggers.Wait()
+	if got := creates(); got != 0 {
+		t.Errorf("got %d creates for a scheduled Trigger, want 0", got)
+	}
+}
+
+func TestLeaderTask(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	var l leaderTask
+	go l.run(func() {
+		close(started)
+		<-release
+	})
+	<-started
+
+	waited := make(chan struct{})
+	go func() {
+		l.wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+		t.Fatal("wait() returned while the task was running")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	<-waited
+
+	l.run(func() { t.Error("the task ran after wait() returned") })
+}
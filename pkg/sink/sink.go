@@ -23,21 +23,25 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
-	net "net/url"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
 	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	triggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned"
 	listersv1alpha1 "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1alpha1"
 	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/decode"
 	"github.com/tektoncd/triggers/pkg/interceptors"
 	"github.com/tektoncd/triggers/pkg/interceptors/webhook"
+	"github.com/tektoncd/triggers/pkg/policy"
 	"github.com/tektoncd/triggers/pkg/reconciler/events"
 	"github.com/tektoncd/triggers/pkg/resources"
 	"github.com/tektoncd/triggers/pkg/sink/cloudevent"
@@ -46,6 +50,7 @@ import (
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	discoveryclient "k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -73,8 +78,16 @@ type Sink struct {
 	CloudEventURI          string
 	// WGProcessTriggers keeps track of triggers or triggerGroups currently being processed
 	// Currently only used in tests to wait for all triggers to finish processing
+	// and to drain the EventListener when it shuts down
 	WGProcessTriggers *sync.WaitGroup
-	EventRecorder     record.EventRecorder
+	// WGCloudEvents keeps track of the CloudEvents being sent, so that they
+	// are flushed when the EventListener shuts down. Not tracked if nil.
+	WGCloudEvents *sync.WaitGroup
+	// PendingEvents counts the events being processed in the background, to
+	// report those left incomplete when the EventListener shuts down. Not
+	// counted if nil.
+	PendingEvents *atomic.Int64
+	EventRecorder record.EventRecorder
 
 	// listers index properties about resources
 	EventListenerLister         listers.EventListenerLister
@@ -84,6 +97,9 @@ type Sink struct {
 	TriggerTemplateLister       listers.TriggerTemplateLister
 	ClusterInterceptorLister    listersv1alpha1.ClusterInterceptorLister
 	InterceptorLister           listersv1alpha1.InterceptorLister
+	// EventListenerPolicyLister lists the EventListenerPolicies enforced
+	// before the Triggers create resources. If nil, no policy is enforced.
+	EventListenerPolicyLister listersv1alpha1.EventListenerPolicyLister
 }
 
 // Response defines the HTTP body that the Sink responds to events with.
@@ -100,6 +116,24 @@ type Response struct {
 	EventID string `json:"eventID,omitempty"`
 	// ErrorMessage gives message about Error which occurs during event processing
 	ErrorMessage string `json:"errorMessage,omitempty"`
+	// ReplayOf is the ID of the original event when the event is a replay
+	ReplayOf string `json:"replayOf,omitempty"`
+	// Triggers holds the outcome of each Trigger for a dry run replay
+	Triggers []triggersv1alpha1.TriggerOutcome `json:"triggers,omitempty"`
+	// Events holds the result of each event of a batch of CloudEvents
+	Events []EventResponse `json:"events,omitempty"`
+}
+
+// EventResponse defines the result of an event of a batch of CloudEvents.
+type EventResponse struct {
+	// ID is the ID of the CloudEvent
+	ID string `json:"id"`
+	// EventID is the uniqueID assigned to the event, unless it is invalid
+	EventID string `json:"eventID,omitempty"`
+	// ErrorMessage is why the event is invalid
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Triggers holds the outcome of each Trigger for a dry run replay
+	Triggers []triggersv1alpha1.TriggerOutcome `json:"triggers,omitempty"`
 }
 
 func (r Sink) emitEvents(recorder record.EventRecorder, el *triggersv1.EventListener, eventType string, err error) {
@@ -193,27 +227,82 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
 		return
 	}
-	r.WGProcessTriggers.Add(len(mergedTriggers))
-	for _, t := range mergedTriggers {
-		go func(t triggersv1.Trigger) {
-			defer r.WGProcessTriggers.Done()
-			localRequest := request.Clone(request.Context())
-			emptyExtensions := make(map[string]interface{})
-			r.processTrigger(t, el, localRequest, event, eventID, log, emptyExtensions)
-		}(*t)
+	// Scheduled Triggers only process the events fired by the scheduler
+	mergedTriggers = withoutScheduledTriggers(mergedTriggers)
+	rp := replayFrom(request.Header)
+	if rp != nil {
+		if status, err := r.authorizeReplay(request.Context(), el, request.Header, rp); err != nil {
+			log.Errorf("rejecting replay: %s", err)
+			r.recordCountMetrics(failTag)
+			response.Header().Set("Content-Type", "application/json")
+			response.WriteHeader(status)
+			if err := json.NewEncoder(response).Encode(Response{
+				EventListener:    r.EventListenerName,
+				EventListenerUID: elUID,
+				Namespace:        r.EventListenerNamespace,
+				EventID:          eventID,
+				ErrorMessage:     err.Error(),
+			}); err != nil {
+				log.Errorf("failed to write back sink response: %v", err)
+			}
+			return
+		}
+		log = log.With(zap.String("replayOf", rp.of))
+		log.Infof("replaying event %s, dry run: %t", rp.of, rp.dryRun)
 	}
-
-	// Process grouped triggers
-	for _, group := range el.Spec.TriggerGroups {
-		r.WGProcessTriggers.Add(1)
-		go func(g triggersv1.EventListenerTriggerGroup) {
-			defer r.WGProcessTriggers.Done()
-			localRequest := request.Clone(request.Context())
-			r.processTriggerGroups(g, el, localRequest, event, eventID, log, r.WGProcessTriggers)
-		}(group)
+	// The replay token is neither recorded nor sent to interceptors
+	request.Header.Del(ReplayTokenHeader)
+	if !matchesRoutes(el, mergedTriggers, request) {
+		log.Infof("no Triggers match %s %s", request.Method, request.URL.Path)
+		r.recordCountMetrics(failTag)
+		response.Header().Set("Content-Type", "application/json")
+		response.WriteHeader(http.StatusNotFound)
+		if err := json.NewEncoder(response).Encode(Response{
+			EventListener:    r.EventListenerName,
+			EventListenerUID: elUID,
+			Namespace:        r.EventListenerNamespace,
+			EventID:          eventID,
+			ErrorMessage:     fmt.Sprintf("no Triggers match %s %s", request.Method, request.URL.Path),
+		}); err != nil {
+			log.Errorf("failed to write back sink response: %v", err)
+		}
+		return
+	}
+	incoming, batch, err := decodeEvents(request.Context(), request.Header, event)
+	if err != nil {
+		log.Error(err)
+		r.recordCountMetrics(failTag)
+		response.WriteHeader(http.StatusBadRequest)
+		r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
+		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
+		return
 	}
 
-	r.recordCountMetrics(successTag)
+	for i, in := range incoming {
+		if in.err != nil {
+			continue
+		}
+		incoming[i].payload, incoming[i].err = decode.Body(el.Spec.PayloadDecoders, in.header, in.body)
+	}
+	if !batch && incoming[0].err != nil {
+		err := incoming[0].err
+		log.Error(err)
+		r.recordCountMetrics(failTag)
+		response.Header().Set("Content-Type", "application/json")
+		response.WriteHeader(http.StatusBadRequest)
+		if err := json.NewEncoder(response).Encode(Response{
+			EventListener:    r.EventListenerName,
+			EventListenerUID: elUID,
+			Namespace:        r.EventListenerNamespace,
+			EventID:          eventID,
+			ErrorMessage:     err.Error(),
+		}); err != nil {
+			log.Errorf("failed to write back sink response: %v", err)
+		}
+		r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
+		r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
+		return
+	}
 
 	body := Response{
 		EventListener:    r.EventListenerName,
@@ -221,10 +310,36 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 		Namespace:        r.EventListenerNamespace,
 		EventID:          eventID,
 	}
+	status := http.StatusAccepted
+	if rp != nil {
+		body.ReplayOf = rp.of
+	}
+	if rp != nil && rp.dryRun {
+		status = http.StatusOK
+	}
+	if batch {
+		// Each event of a batch is processed independently, with its own ID
+		body.Events = make([]EventResponse, 0, len(incoming))
+		for _, in := range incoming {
+			res := EventResponse{ID: in.id}
+			if in.err != nil {
+				log.Errorf("skipping CloudEvent %s of the batch: %s", in.id, in.err)
+				res.ErrorMessage = in.err.Error()
+			} else {
+				res.EventID = template.UUID()
+				res.Triggers = r.acceptEvent(request, el, mergedTriggers, in, res.EventID, rp, r.eventLogger(el, res.EventID))
+			}
+			body.Events = append(body.Events, res)
+		}
+	} else {
+		body.Triggers = r.acceptEvent(request, el, mergedTriggers, incoming[0], eventID, rp, log)
+	}
+
+	r.recordCountMetrics(successTag)
 
 	msg := cehttp.NewMessageFromHttpRequest(request)
 	if encoding := msg.ReadEncoding(); encoding == binding.EncodingUnknown {
-		response.WriteHeader(http.StatusAccepted)
+		response.WriteHeader(status)
 		response.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(response).Encode(body); err != nil {
 			log.Errorf("failed to write back sink response: %v", err)
@@ -247,7 +362,7 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 			}
 		}()
 
-		if err := cehttp.WriteResponseWriter(request.Context(), eventResponse, http.StatusAccepted, response); err != nil {
+		if err := cehttp.WriteResponseWriter(request.Context(), eventResponse, status, response); err != nil {
 			log.Errorf("failed to write back cloud event sink response: %v", err)
 			r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
 			r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingFailedV1)
@@ -257,13 +372,140 @@ func (r Sink) HandleEvent(response http.ResponseWriter, request *http.Request) {
 	r.sendCloudEvents(nil, *el, eventID, events.TriggerProcessingDoneV1)
 }
 
+// acceptEvent starts processing an event of the request with the Triggers.
+// The outcomes of the Triggers are returned for a dry run replay, once all of
+// them are processed.
+func (r Sink) acceptEvent(request *http.Request, el *triggersv1.EventListener, trs []*triggersv1.Trigger, in incomingEvent, eventID string, rp *replay, log *zap.SugaredLogger) []triggersv1alpha1.TriggerOutcome {
+	rec := newInvocationRecorder(el, eventID, in.header, in.body, rp)
+	rec.setRequest(request.Method, request.URL.Path)
+	ctx := withInvocationRecorder(request.Context(), rec)
+	request = request.Clone(ctx)
+	request.Header = in.header
+	// eventWG tracks the Triggers processing this event, so that the
+	// TriggerInvocation is only written once all of them are done.
+	eventWG := r.processTriggers(ctx, el, trs, request, in.payload, bodyExtensions(in.body, in.payload), eventID, log)
+	if rp != nil && rp.dryRun {
+		eventWG.Wait()
+		return rec.outcomes()
+	}
+	r.processInBackground(func() {
+		eventWG.Wait()
+		r.writeInvocation(rec, log)
+	})
+	return nil
+}
+
+// startEvent starts processing an event fired by the EventListener, rather
+// than received by it, with its Triggers and trigger groups. The returned
+// WaitGroup is done once all of them are, after which the recorder holds
+// their outcomes.
+func (r Sink) startEvent(ctx context.Context, body []byte, header http.Header) (*invocationRecorder, *sync.WaitGroup, *zap.SugaredLogger, error) {
+	el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting EventListener %s in Namespace %s: %w", r.EventListenerName, r.EventListenerNamespace, err)
+	}
+	eventID := template.UUID()
+	log := r.eventLogger(el, eventID)
+	trItems, err := r.selectTriggers(el.Spec.NamespaceSelector, el.Spec.LabelSelector)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to select configured mergedTriggers: %w", err)
+	}
+	mergedTriggers, err := r.merge(el.Spec.Triggers, trItems)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error merging triggers: %w", err)
+	}
+
+	rec := newInvocationRecorder(el, eventID, header, body, nil)
+	if rec == nil {
+		// Collect the outcomes without writing them
+		rec = &invocationRecorder{invocation: &triggersv1alpha1.TriggerInvocation{}}
+	}
+	ctx = withInvocationRecorder(withFiredEvent(ctx), rec)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create event: %w", err)
+	}
+	// Events fired by the EventListener are never replays
+	header.Del(ReplayOfHeader)
+	header.Del(ReplayDryRunHeader)
+	request.Header = header
+	payload, err := decode.Body(el.Spec.PayloadDecoders, header, body)
+	if err != nil {
+		// The event can't be processed, so it is dropped rather than
+		// failed, which would deliver it again
+		log.Errorf("dropping event: %s", err)
+		return rec, &sync.WaitGroup{}, log, nil
+	}
+	// Scheduled Triggers only process the events fired by the scheduler
+	eventWG := r.processTriggers(ctx, el, withoutScheduledTriggers(mergedTriggers), request, payload, bodyExtensions(body, payload), eventID, log)
+	return rec, eventWG, log, nil
+}
+
+// bodyExtensions returns the extensions the interceptors start from, which
+// hold the body as it was received when it was decoded into another payload,
+// so that interceptors can verify its signature.
+func bodyExtensions(body, payload []byte) map[string]interface{} {
+	if bytes.Equal(body, payload) {
+		return map[string]interface{}{}
+	}
+	return map[string]interface{}{interceptors.RawBodyExtension: string(body)}
+}
+
+// processTriggers processes the event with the Triggers and the trigger
+// groups of the EventListener, and returns a WaitGroup that is done once all
+// of them are. Each of them starts from a copy of the extensions.
+func (r Sink) processTriggers(ctx context.Context, el *triggersv1.EventListener, trs []*triggersv1.Trigger, request *http.Request, event []byte, extensions map[string]interface{}, eventID string, log *zap.SugaredLogger) *sync.WaitGroup {
+	eventWG := &sync.WaitGroup{}
+	for _, t := range trs {
+		triggerCtx, ok := withRoute(ctx, t.Spec.Route, request)
+		if !ok {
+			continue
+		}
+		eventWG.Add(1)
+		go func(t triggersv1.Trigger) {
+			defer eventWG.Done()
+			localRequest := request.Clone(triggerCtx)
+			r.processTrigger(t, el, localRequest, event, eventID, log, maps.Clone(extensions))
+		}(*t)
+	}
+
+	// Process grouped triggers
+	for _, group := range el.Spec.TriggerGroups {
+		groupCtx, ok := withRoute(ctx, group.Route, request)
+		if !ok {
+			continue
+		}
+		eventWG.Add(1)
+		go func(g triggersv1.EventListenerTriggerGroup) {
+			defer eventWG.Done()
+			localRequest := request.Clone(groupCtx)
+			r.processTriggerGroups(g, el, localRequest, event, maps.Clone(extensions), eventID, log, eventWG)
+		}(group)
+	}
+	return eventWG
+}
+
 func (r Sink) sendCloudEvents(headers http.Header, el triggersv1.EventListener, eventID, eventType string) {
 	data, err := json.Marshal(headers)
 	if err != nil {
 		r.Logger.Errorf("Error marshaling request Headers to json: %s", err)
 		return
 	}
+	r.sendCloudEventData(data, el, eventID, eventType)
+}
 
+// sendResourceErrorCloudEvent sends a failed CloudEvent with the ResourceError
+// as its data.
+func (r Sink) sendResourceErrorCloudEvent(el triggersv1.EventListener, eventID string, resErr *ResourceError) {
+	data, err := json.Marshal(resErr)
+	if err != nil {
+		r.Logger.Errorf("Error marshaling resource error to json: %s", err)
+		return
+	}
+	r.sendCloudEventData(data, el, eventID, events.TriggerProcessingFailedV1)
+}
+
+func (r Sink) sendCloudEventData(data []byte, el triggersv1.EventListener, eventID, eventType string) {
 	// If no cloudEventURI, then don't try to sendCloudEvents
 	if r.CloudEventURI == "" {
 		return
@@ -279,7 +521,7 @@ func (r Sink) sendCloudEvents(headers http.Header, el triggersv1.EventListener,
 		EL:        el,
 	}
 
-	go resource.SendCloudEvents()
+	r.sendInBackground(resource.SendCloudEvents)
 }
 
 func (r Sink) merge(et []triggersv1.EventListenerTrigger, trItems []*triggersv1.Trigger) ([]*triggersv1.Trigger, error) {
@@ -303,6 +545,7 @@ func (r Sink) merge(et []triggersv1.EventListenerTrigger, trItems []*triggersv1.
 					Bindings:           t.Bindings,
 					Template:           *t.Template,
 					Interceptors:       t.Interceptors,
+					Route:              t.Route,
 				},
 			})
 		default:
@@ -312,13 +555,17 @@ func (r Sink) merge(et []triggersv1.EventListenerTrigger, trItems []*triggersv1.
 	return triggers, nil
 }
 
-func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, wg *sync.WaitGroup) {
+func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *triggersv1.EventListener, request *http.Request, event []byte, extensions map[string]interface{}, eventID string, eventLog *zap.SugaredLogger, wg *sync.WaitGroup) {
 	log := eventLog.With(zap.String(triggers.TriggerGroupLabelKey, g.Name))
 
-	extensions := map[string]interface{}{}
 	payload, header, resp, err := r.ExecuteInterceptors(g.Interceptors, request, event, log, eventID, fmt.Sprintf("namespaces/%s/triggerGroups/%s", r.EventListenerNamespace, g.Name), r.EventListenerNamespace, extensions)
 	if err != nil {
 		log.Error(err)
+		invocationRecorderFrom(request.Context()).addOutcome(&triggersv1alpha1.TriggerOutcome{
+			TriggerGroup: g.Name,
+			Status:       triggersv1alpha1.TriggerOutcomeFailed,
+			Message:      err.Error(),
+		})
 		return
 	}
 	if resp != nil {
@@ -329,6 +576,11 @@ func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *t
 		}
 		if !resp.Continue {
 			eventLog.Debugf("interceptor stopped trigger processing: %v", resp.Status.Err())
+			invocationRecorderFrom(request.Context()).addOutcome(&triggersv1alpha1.TriggerOutcome{
+				TriggerGroup: g.Name,
+				Status:       triggersv1alpha1.TriggerOutcomeStopped,
+				Message:      resp.Status.Message,
+			})
 			return
 		}
 	}
@@ -337,6 +589,7 @@ func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *t
 	if err != nil {
 		return
 	}
+	trItems = withoutScheduledTriggers(trItems)
 
 	// Create a new HTTP request that contains the body and header from any interceptors in the TriggerGroup
 	// This request will be passed on to the triggers in this group
@@ -344,13 +597,18 @@ func (r Sink) processTriggerGroups(g triggersv1.EventListenerTriggerGroup, el *t
 	triggerReq.Header = header
 	triggerReq.Body = io.NopCloser(bytes.NewBuffer(payload))
 
-	wg.Add(len(trItems))
 	for _, t := range trItems {
+		// The Triggers of the group can restrict the requests further
+		triggerCtx, ok := withRoute(triggerReq.Context(), t.Spec.Route, triggerReq)
+		if !ok {
+			continue
+		}
+		wg.Add(1)
 		go func(t triggersv1.Trigger) {
 			defer wg.Done()
 			// TODO(dibyom): We might be able to get away with only cloning if necessary
 			// i.e. if there are interceptors and iff those interceptors will modify the body/header (i.e. webhook)
-			localRequest := triggerReq.Clone(triggerReq.Context())
+			localRequest := triggerReq.Clone(triggerCtx)
 			r.processTrigger(t, el, localRequest, event, eventID, log, extensions)
 		}(*t)
 	}
@@ -405,18 +663,46 @@ func (r Sink) selectTriggers(namespaceSelector triggersv1.NamespaceSelector, lab
 	return trItems, nil
 }
 
-func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, extensions map[string]interface{}) {
+// processTrigger processes the event for the Trigger and returns its
+// outcome, which is empty if the Trigger is skipped.
+func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener, request *http.Request, event []byte, eventID string, eventLog *zap.SugaredLogger, extensions map[string]interface{}) (status triggersv1alpha1.TriggerOutcomeStatus) {
+	if !retriedTrigger(request.Context(), t) {
+		return
+	}
+
 	log := eventLog.With(zap.String(triggers.TriggerLabelKey, t.Name))
+	outcome := &triggersv1alpha1.TriggerOutcome{
+		Name:      t.Name,
+		Namespace: t.Namespace,
+		Status:    triggersv1alpha1.TriggerOutcomeFailed,
+	}
+	defer func() {
+		invocationRecorderFrom(request.Context()).addOutcome(outcome)
+		status = outcome.Status
+	}()
+
+	policies, err := policy.For(r.EventListenerPolicyLister, el.Namespace, el.Name)
+	if err == nil {
+		err = policies.CheckTrigger(t.Namespace, t.Spec.ServiceAccountName)
+	}
+	if err != nil {
+		log.Error(err)
+		outcome.Message = err.Error()
+		return
+	}
 
 	finalPayload, header, iresp, err := r.ExecuteTriggerInterceptors(t, request, event, log, eventID, extensions)
 	if err != nil {
 		log.Error(err)
+		outcome.Message = err.Error()
 		return
 	}
 
 	if iresp != nil {
 		if !iresp.Continue {
 			log.Debugf("interceptor stopped trigger processing: %v", iresp.Status.Err())
+			outcome.Status = triggersv1alpha1.TriggerOutcomeStopped
+			outcome.Message = iresp.Status.Message
 			return
 		}
 	}
@@ -427,27 +713,73 @@ func (r Sink) processTrigger(t triggersv1.Trigger, el *triggersv1.EventListener,
 		r.TriggerTemplateLister.TriggerTemplates(t.Namespace).Get)
 	if err != nil {
 		log.Error(err)
+		outcome.Message = err.Error()
 		return
 	}
 	if iresp != nil && iresp.Extensions != nil {
 		extensions = iresp.Extensions
 	}
-	params, err := template.ResolveParams(rt, finalPayload, header, extensions, template.NewTriggerContext(eventID))
+	triggerContext := template.NewTriggerContext(eventID)
+	triggerContext.Path = request.URL.Path
+	triggerContext.PathParams = pathParamsFrom(request.Context())
+	params, err := template.ResolveParams(rt, finalPayload, header, extensions, triggerContext)
 	if err != nil {
 		log.Error(err)
+		outcome.Message = err.Error()
 		return
 	}
+	outcome.Params = paramsToOutcome(params)
 
 	log.Infof("ResolvedParams : %+v", params)
-	resources := template.ResolveResources(rt.TriggerTemplate, params)
+	resources, err := template.ResolveResources(rt.TriggerTemplate, params)
+	if err != nil {
+		log.Error(err)
+		outcome.Message = err.Error()
+		return
+	}
+	if err := policies.CheckResources(t.Namespace, resources); err != nil {
+		log.Error(err)
+		outcome.Message = err.Error()
+		return
+	}
 
-	if err := r.CreateResources(t.Namespace, t.Spec.ServiceAccountName, resources, t.Name, eventID, log); err != nil {
+	if rp := replayFrom(request.Header); rp != nil {
+		if rp.dryRun {
+			if err := r.dryRunResources(t.Namespace, t.Spec.ServiceAccountName, resources, t.Name, eventID, log); err != nil {
+				outcome.Message = err.Error()
+				return
+			}
+			outcome.Status = triggersv1alpha1.TriggerOutcomeSucceeded
+			return
+		}
+		if resources, err = labelReplayedResources(resources, rp.of); err != nil {
+			log.Error(err)
+			outcome.Message = err.Error()
+			return
+		}
+	}
+
+	created, err := r.createResources(t.Namespace, t.Spec.ServiceAccountName, resources, t.Name, eventID, validateResources(el), log)
+	outcome.Resources = resourcesToOutcome(created)
+	if err != nil {
 		log.Error(err)
+		outcome.Message = err.Error()
+		var resErr *ResourceError
+		if errors.As(err, &resErr) {
+			if resErr.Reason.Retryable() {
+				invocationRecorderFrom(request.Context()).markRetryable(t.Namespace, t.Name)
+			}
+			go r.recordResourceError(resErr)
+			r.emitEvents(r.EventRecorder, el, events.TriggerProcessingFailedV1, err)
+			r.sendResourceErrorCloudEvent(*el, eventID, resErr)
+		}
 		return
 	}
+	outcome.Status = triggersv1alpha1.TriggerOutcomeSucceeded
 	go r.recordResourceCreation(resources)
 	r.emitEvents(r.EventRecorder, el, events.TriggerProcessingSuccessfulV1, nil)
 	r.sendCloudEvents(request.Header, *el, eventID, events.TriggerProcessingSuccessfulV1)
+	return
 }
 
 func (r Sink) ExecuteTriggerInterceptors(t triggersv1.Trigger, in *http.Request, event []byte, log *zap.SugaredLogger, eventID string, extensions map[string]interface{}) ([]byte, http.Header, *triggersv1.InterceptorResponse, error) {
@@ -472,29 +804,14 @@ func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *ht
 			EventID:  eventID,
 			// t.Name might not be fully accurate until we get rid of triggers inlined within EventListener
 			TriggerID: triggerID,
+			// Replays are only processed once authenticated
+			VerifiedReplay: replayFrom(in.Header) != nil,
 		},
 	}
 	for k, v := range extensions {
 		request.Extensions[k] = v
 	}
 
-	// check if string is urlencoded
-	// Parse the query string into a map
-	parsedQuery, _ := net.ParseQuery(request.Body)
-
-	// parse form-data payload
-	if v := in.Header.Get("Content-Type"); v == "application/x-www-form-urlencoded" && len(parsedQuery) > 1 {
-		// Convert the map into a JSON string
-		jsonString, err := json.Marshal(parsedQuery)
-		if err != nil {
-			log.Errorf("Error converting map to JSON:", err)
-			return nil, nil, nil, err
-		}
-		request.Body = string(jsonString)
-	}
-	// request is the request sent to the interceptors in the chain. Each interceptor can set the InterceptorParams field
-	// or add to the Extensions
-
 	for _, i := range trInt {
 		if i.Webhook != nil { // Old style interceptor
 			body, err := extendBodyWithExtensions([]byte(request.Body), request.Extensions)
@@ -584,29 +901,59 @@ func (r Sink) ExecuteInterceptors(trInt []*triggersv1.TriggerInterceptor, in *ht
 }
 
 func (r Sink) CreateResources(triggerNS, sa string, res []json.RawMessage, triggerName, eventID string, log *zap.SugaredLogger) error {
-	discoveryClient := r.DiscoveryClient
-	dynamicClient := r.DynamicClient
-	var err error
-	if len(sa) > 0 {
-		// So at start up the discovery and dynamic clients are created using the in cluster config
-		// of this pod (i.e. using the credentials of the serviceaccount associated with the EventListener)
+	_, err := r.createResources(triggerNS, sa, res, triggerName, eventID, false, log)
+	return err
+}
 
-		// However, we also have a ServiceAccountName reference with each EventListenerTrigger to allow
-		// for more fine grained authorization control around the resources we create below.
-		discoveryClient, dynamicClient, err = r.Auth.OverrideAuthentication(sa, triggerNS, log, r.DiscoveryClient, r.DynamicClient)
-		if err != nil {
-			log.Errorf("problem cloning rest config: %#v", err)
-			return err
+// createResources creates the resources of a Trigger and returns the
+// resources returned by the API server, including the ones created before an
+// error. If validate is true, all of the resources are validated with a dry
+// run before any of them is created. Errors returned by the API server are
+// returned as a *ResourceError.
+func (r Sink) createResources(triggerNS, sa string, res []json.RawMessage, triggerName, eventID string, validate bool, log *zap.SugaredLogger) ([]*unstructured.Unstructured, error) {
+	discoveryClient, dynamicClient, err := r.clientsFor(sa, triggerNS, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if validate {
+		for _, rr := range res {
+			if err := resources.Validate(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient); err != nil {
+				log.Errorf("problem validating obj: %#v", err)
+				return nil, newResourceError(validationStage, triggerName, rr, err)
+			}
 		}
 	}
 
+	created := make([]*unstructured.Unstructured, 0, len(res))
 	for _, rr := range res {
-		if err := resources.Create(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient); err != nil {
+		obj, err := resources.Create(r.Logger, rr, triggerName, eventID, r.EventListenerName, triggerNS, discoveryClient, dynamicClient)
+		if err != nil {
 			log.Errorf("problem creating obj: %#v", err)
-			return err
+			return created, newResourceError(creationStage, triggerName, rr, err)
 		}
+		created = append(created, obj)
 	}
-	return nil
+	return created, nil
+}
+
+// clientsFor returns the discovery and dynamic clients used to create the
+// resources of a Trigger with the given service account.
+func (r Sink) clientsFor(sa, triggerNS string, log *zap.SugaredLogger) (discoveryclient.ServerResourcesInterface, dynamic.Interface, error) {
+	if len(sa) == 0 {
+		return r.DiscoveryClient, r.DynamicClient, nil
+	}
+	// So at start up the discovery and dynamic clients are created using the in cluster config
+	// of this pod (i.e. using the credentials of the serviceaccount associated with the EventListener)
+
+	// However, we also have a ServiceAccountName reference with each EventListenerTrigger to allow
+	// for more fine grained authorization control around the resources we create below.
+	discoveryClient, dynamicClient, err := r.Auth.OverrideAuthentication(sa, triggerNS, log, r.DiscoveryClient, r.DynamicClient)
+	if err != nil {
+		log.Errorf("problem cloning rest config: %#v", err)
+		return nil, nil, err
+	}
+	return discoveryClient, dynamicClient, nil
 }
 
 // extendBodyWithExtensions merges the extensions into the given body.
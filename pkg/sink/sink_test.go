@@ -41,6 +41,7 @@ import (
 	triggersv1alpha1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1alpha1"
 	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	clusterinterceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/clusterinterceptor"
+	eventlistenerpolicyinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/eventlistenerpolicy"
 	interceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/interceptor"
 	clustertriggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding"
 	eventlistenerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/eventlistener"
@@ -179,6 +180,7 @@ func getSinkAssets(t *testing.T, res test.Resources, elName string, webhookInter
 		TriggerTemplateLister:       triggertemplateinformer.Get(ctx).Lister(),
 		ClusterInterceptorLister:    clusterinterceptorinformer.Get(ctx).Lister(),
 		InterceptorLister:           interceptorinformer.Get(ctx).Lister(),
+		EventListenerPolicyLister:   eventlistenerpolicyinformer.Get(ctx).Lister(),
 		PayloadValidation:           true,
 	}
 	return r, dynamicClient
@@ -357,6 +359,10 @@ func TestHandleEvent(t *testing.T) {
 		}
 	)
 
+	yamlEventBody := []byte("head_commit:\n  id: testrevision\nrepository:\n  url: testurl\nfoo: \"bar\\t\\r\\nbaz昨\"\n")
+	yamlGitCloneTaskRun := *gitCloneTaskRun.DeepCopy()
+	yamlGitCloneTaskRun.Labels["type"] = "application/yaml"
+
 	// tenGitCloneTriggers is a slice to ten triggers named git-clone-$i
 	tenGitCloneTriggers := []*triggersv1beta1.Trigger{}
 	for i := range 10 {
@@ -736,6 +742,63 @@ func TestHandleEvent(t *testing.T) {
 			"X-Hub-Signature-256": {test.HMACHeader(t, "secret", eventBody, "sha256")},
 		},
 		want: []pipelinev1.TaskRun{gitCloneTaskRun},
+	}, {
+		name: "with GitHub interceptor and a decoded body",
+		resources: test.Resources{
+			Secrets: []*corev1.Secret{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "secret",
+					Namespace: namespace,
+				},
+				Data: map[string][]byte{
+					"secretKey": []byte("secret"),
+				},
+			}},
+			ClusterInterceptors: []*triggersv1alpha1.ClusterInterceptor{github},
+			Triggers: []*triggersv1beta1.Trigger{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "git-clone-trigger",
+					Namespace: namespace,
+				},
+				Spec: triggersv1beta1.TriggerSpec{
+					Interceptors: []*triggersv1beta1.EventInterceptor{{
+						Ref: triggersv1beta1.InterceptorRef{Name: "github", Kind: triggersv1beta1.ClusterInterceptorKind},
+						Params: []triggersv1beta1.InterceptorParams{{
+							Name: "secretRef",
+							Value: test.ToV1JSON(t, &triggersv1beta1.SecretRef{
+								SecretKey:  "secretKey",
+								SecretName: "secret",
+							}),
+						}},
+					}},
+					Bindings: gitCloneTBSpec,
+					Template: triggersv1beta1.TriggerSpecTemplate{Spec: makeGitCloneTTSpec(t, "git-clone-test-run")},
+				},
+			}},
+			EventListeners: []*triggersv1beta1.EventListener{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      eventListenerName,
+					Namespace: namespace,
+					UID:       types.UID(elUID),
+				},
+				Spec: triggersv1beta1.EventListenerSpec{
+					Triggers: []triggersv1beta1.EventListenerTrigger{{
+						TriggerRef: "git-clone-trigger",
+					}},
+					PayloadDecoders: []triggersv1beta1.PayloadDecoder{{
+						ContentType: "application/yaml",
+						Format:      triggersv1beta1.PayloadFormatYAML,
+					}},
+				},
+			}},
+		},
+		eventBody: yamlEventBody,
+		headers: map[string][]string{
+			"Content-Type":        {"application/yaml"},
+			"X-GitHub-Event":      {"push"},
+			"X-Hub-Signature-256": {test.HMACHeader(t, "secret", yamlEventBody, "sha256")},
+		},
+		want: []pipelinev1.TaskRun{yamlGitCloneTaskRun},
 	}, {
 		name: "with BitBucket interceptor",
 		resources: test.Resources{
@@ -1334,7 +1397,9 @@ func TestHandleEvent(t *testing.T) {
 			if tc.headers != nil {
 				req.Header = http.Header(tc.headers)
 			}
-			req.Header.Add("Content-Type", "application/json")
+			if req.Header.Get("Content-Type") == "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
 			resp, err := http.DefaultClient.Do(req)
 			if err != nil {
 				t.Fatalf("error sending request: %s", err)
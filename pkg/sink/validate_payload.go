@@ -21,7 +21,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/decode"
 )
 
 func (r Sink) IsValidPayload(eventHandler http.Handler) http.Handler {
@@ -35,8 +40,7 @@ func (r Sink) IsValidPayload(eventHandler http.Handler) http.Handler {
 			return
 		}
 		if r.PayloadValidation {
-			var event map[string]interface{}
-			if err := json.Unmarshal(payload, &event); err != nil {
+			if err := r.validatePayload(request.Header, payload); err != nil {
 				errMsg := fmt.Sprintf("Invalid event body format : %s", err)
 				r.recordCountMetrics(failTag)
 				r.Logger.Error(errMsg)
@@ -56,3 +60,22 @@ func (r Sink) IsValidPayload(eventHandler http.Handler) http.Handler {
 		eventHandler.ServeHTTP(response, request)
 	})
 }
+
+// validatePayload checks that a body is a JSON object, unless the payload
+// decoders of the EventListener decode it from another format, in which case
+// it checks that it can be decoded.
+func (r Sink) validatePayload(header http.Header, payload []byte) error {
+	contentType := header.Get("Content-Type")
+	if mt, _, _ := mime.ParseMediaType(contentType); mt == cloudevents.ApplicationCloudEventsBatchJSON {
+		// Each CloudEvent of the batch is validated on its own
+		return nil
+	}
+	if el, err := r.EventListenerLister.EventListeners(r.EventListenerNamespace).Get(r.EventListenerName); err == nil {
+		if decode.Format(el.Spec.PayloadDecoders, contentType) != triggersv1.PayloadFormatJSON {
+			_, err := decode.Body(el.Spec.PayloadDecoders, header, payload)
+			return err
+		}
+	}
+	var event map[string]interface{}
+	return json.Unmarshal(payload, &event)
+}
@@ -23,11 +23,14 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	"github.com/tektoncd/triggers/test"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
 )
 
 func TestSink_IsValidPayload(t *testing.T) {
@@ -173,3 +176,82 @@ func TestSink_IsValidPayload_PayloadValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestSink_IsValidPayload_PayloadDecoders(t *testing.T) {
+	tt := &triggersv1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec: triggersv1.TriggerSpec{
+			Bindings: []*triggersv1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.push.repository)")},
+				{Name: "revision", Value: ptr.String("$(body.push.commit.-id)")},
+			},
+			Template: triggersv1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+		},
+	}
+	el := &triggersv1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1.EventListenerSpec{
+			Triggers: []triggersv1.EventListenerTrigger{{TriggerRef: "git-clone"}},
+			PayloadDecoders: []triggersv1.PayloadDecoder{{
+				ContentType: "application/xml",
+				Format:      triggersv1.PayloadFormatXML,
+			}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		contentType    string
+		eventBody      string
+		wantStatusCode int
+		wantParams     []string
+	}{{
+		name:           "xml",
+		contentType:    "application/xml",
+		eventBody:      `<push><repository>https://github.com/tektoncd/triggers</repository><commit id="abc"/></push>`,
+		wantStatusCode: http.StatusAccepted,
+		wantParams:     []string{"https://github.com/tektoncd/triggers", "abc"},
+	}, {
+		name:           "invalid xml",
+		contentType:    "application/xml",
+		eventBody:      `<push>`,
+		wantStatusCode: http.StatusBadRequest,
+	}, {
+		name:           "xml sent as json",
+		contentType:    "application/json",
+		eventBody:      `<push/>`,
+		wantStatusCode: http.StatusBadRequest,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink, dynamicClient := getSinkAssets(t, test.Resources{
+				EventListeners:   []*triggersv1.EventListener{el},
+				Triggers:         []*triggersv1.Trigger{tr},
+				TriggerTemplates: []*triggersv1.TriggerTemplate{tt},
+			}, el.Name, nil)
+			ts := httptest.NewServer(sink.IsValidPayload(http.HandlerFunc(sink.HandleEvent)))
+			defer ts.Close()
+
+			resp, err := http.Post(ts.URL, tc.contentType, bytes.NewReader([]byte(tc.eventBody)))
+			if err != nil {
+				t.Fatalf("error making request to eventListener: %s", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.wantStatusCode {
+				t.Fatalf("Status code mismatch: got %d, want %d", resp.StatusCode, tc.wantStatusCode)
+			}
+
+			sink.WGProcessTriggers.Wait()
+			var params []string
+			for _, tr := range toTaskRun(t, dynamicClient.Actions()) {
+				params = append(params, tr.Spec.Params[0].Value.StringVal, tr.Spec.Params[1].Value.StringVal)
+			}
+			if diff := cmp.Diff(tc.wantParams, params); diff != "" {
+				t.Errorf("TaskRun params -want +got: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	celgo "github.com/google/cel-go/cel"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/pkg/policy"
+	"github.com/tektoncd/triggers/pkg/resources"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// KubernetesEventTypeHeader is the header of the events fired by a
+	// Kubernetes event source that holds the type of the change.
+	KubernetesEventTypeHeader = "Tekton-Kubernetes-Event-Type"
+	// KubernetesEventSourceHeader is the header of the events fired by a
+	// Kubernetes event source that holds the name of the source.
+	KubernetesEventSourceHeader = "Tekton-Kubernetes-Event-Source"
+)
+
+// watcher watches the resources of the Kubernetes event sources of the
+// EventListener.
+type watcher struct {
+	sink Sink
+	// sources are the watched sources, which are watched again when they
+	// change.
+	sources []triggersv1.KubernetesEventSource
+	// retryAt is the time at which sources that failed to be watched are
+	// retried.
+	retryAt time.Time
+	cancel  context.CancelFunc
+	// factories are the informer factories of the watched sources.
+	factories []dynamicinformer.DynamicSharedInformerFactory
+}
+
+// sync watches the Kubernetes event sources of the EventListener, and stops
+// watching the ones that were removed.
+func (w *watcher) sync(ctx context.Context, el *triggersv1.EventListener, now time.Time) {
+	sources := el.Spec.KubernetesEventSources
+	if equality.Semantic.DeepEqual(w.sources, sources) && (w.retryAt.IsZero() || now.Before(w.retryAt)) {
+		return
+	}
+	w.stop()
+	w.sources = make([]triggersv1.KubernetesEventSource, len(sources))
+	for i := range sources {
+		sources[i].DeepCopyInto(&w.sources[i])
+	}
+	w.retryAt = time.Time{}
+	if len(sources) == 0 {
+		return
+	}
+
+	ctx, w.cancel = context.WithCancel(ctx)
+	for _, source := range w.sources {
+		factories, err := w.sink.watchSource(ctx, source)
+		w.factories = append(w.factories, factories...)
+		if err != nil {
+			w.sink.Logger.Errorf("failed to watch the resources of Kubernetes event source %s: %v", source.Name, err)
+			// Start over later, in case the resource isn't installed yet
+			w.retryAt = now.Add(scheduleCheckInterval)
+		}
+	}
+}
+
+// stop stops watching the sources, and waits for the changes being handled,
+// so that no event is fired once it returns.
+func (w *watcher) stop() {
+	if w.cancel != nil {
+		w.cancel()
+		w.cancel = nil
+	}
+	for _, factory := range w.factories {
+		factory.Shutdown()
+	}
+	w.factories = nil
+}
+
+// watchSource starts the informers of the resources watched by the source,
+// until the context is done, and returns their factories.
+func (r Sink) watchSource(ctx context.Context, source triggersv1.KubernetesEventSource) ([]dynamicinformer.DynamicSharedInformerFactory, error) {
+	apiResource, err := resources.FindAPIResource(source.APIVersion, source.Kind, r.DiscoveryClient)
+	if err != nil {
+		return nil, err
+	}
+	if triggersv1.IsWatchForbidden(schema.GroupKind{Group: apiResource.Group, Kind: apiResource.Kind}) {
+		return nil, fmt.Errorf("%s can't be watched by a Kubernetes event source", apiResource.Kind)
+	}
+	gvr := schema.GroupVersionResource{Group: apiResource.Group, Version: apiResource.Version, Resource: apiResource.Name}
+	selector := ""
+	if source.LabelSelector != nil {
+		s, err := metav1.LabelSelectorAsSelector(source.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		selector = s.String()
+	}
+	handler, err := r.kubernetesEventHandler(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	var factories []dynamicinformer.DynamicSharedInformerFactory
+	for _, ns := range r.watchedNamespaces(source, !apiResource.Namespaced) {
+		factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(r.DynamicClient, 0, ns, func(o *metav1.ListOptions) {
+			o.LabelSelector = selector
+		})
+		if _, err := factory.ForResource(gvr).Informer().AddEventHandler(handler); err != nil {
+			return factories, err
+		}
+		factory.Start(ctx.Done())
+		factories = append(factories, factory)
+	}
+	return factories, nil
+}
+
+// watchedNamespaces returns the namespaces of the resources watched by the
+// source, where "" is all of them.
+func (r Sink) watchedNamespaces(source triggersv1.KubernetesEventSource, clusterScoped bool) []string {
+	names := source.NamespaceSelector.MatchNames
+	if clusterScoped {
+		return []string{metav1.NamespaceAll}
+	}
+	if len(names) == 0 {
+		return []string{r.EventListenerNamespace}
+	}
+	for _, ns := range names {
+		if ns == "*" {
+			return []string{metav1.NamespaceAll}
+		}
+	}
+	return names
+}
+
+// kubernetesEventHandler returns the handler of the changes to the resources
+// watched by the source. The resources that exist when the source starts
+// being watched don't fire events.
+func (r Sink) kubernetesEventHandler(ctx context.Context, source triggersv1.KubernetesEventSource) (cache.ResourceEventHandler, error) {
+	var filter celgo.Program
+	if source.Filter != "" {
+		env, err := celgo.NewEnv(
+			celgo.Variable("eventType", celgo.StringType),
+			celgo.Variable("object", celgo.DynType),
+			celgo.Variable("oldObject", celgo.DynType),
+		)
+		if err != nil {
+			return nil, err
+		}
+		ast, issues := env.Compile(source.Filter)
+		if issues != nil && issues.Err() != nil {
+			return nil, fmt.Errorf("failed to compile the filter %q: %w", source.Filter, issues.Err())
+		}
+		if filter, err = env.Program(ast); err != nil {
+			return nil, fmt.Errorf("failed to create program for the filter %q: %w", source.Filter, err)
+		}
+	}
+	fire := func(eventType triggersv1.KubernetesEventType, obj, oldObj interface{}) {
+		if !watchesEventType(source, eventType) {
+			return
+		}
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		policies, err := policy.For(r.EventListenerPolicyLister, r.EventListenerNamespace, r.EventListenerName)
+		if err == nil {
+			err = policies.CheckWatch(u.GroupVersionKind().GroupKind(), u.GetNamespace())
+		}
+		if err != nil {
+			r.Logger.Errorf("Kubernetes event source %s can't fire events for %s %s/%s: %v", source.Name, u.GetKind(), u.GetNamespace(), u.GetName(), err)
+			return
+		}
+		var old map[string]interface{}
+		if o, ok := oldObj.(*unstructured.Unstructured); ok {
+			old = o.Object
+		}
+		if filter != nil {
+			out, _, err := filter.Eval(map[string]interface{}{
+				"eventType": string(eventType),
+				"object":    u.Object,
+				"oldObject": old,
+			})
+			if err != nil {
+				r.Logger.Errorf("failed to evaluate the filter of Kubernetes event source %s: %v", source.Name, err)
+				return
+			}
+			if pass, ok := out.Value().(bool); !ok || !pass {
+				return
+			}
+		}
+		body, err := json.Marshal(u.Object)
+		if err != nil {
+			r.Logger.Errorf("failed to marshal %s %s/%s: %v", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+			return
+		}
+		header := http.Header{
+			"Content-Type":              {"application/json"},
+			KubernetesEventTypeHeader:   {string(eventType)},
+			KubernetesEventSourceHeader: {source.Name},
+		}
+		r.fireEvent(ctx, body, header)
+	}
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				fire(triggersv1.KubernetesEventAdd, obj, nil)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			o, oldOK := oldObj.(*unstructured.Unstructured)
+			n, newOK := newObj.(*unstructured.Unstructured)
+			if oldOK && newOK && o.GetResourceVersion() == n.GetResourceVersion() {
+				// A resync, not a change
+				return
+			}
+			fire(triggersv1.KubernetesEventUpdate, newObj, oldObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			fire(triggersv1.KubernetesEventDelete, obj, nil)
+		},
+	}, nil
+}
+
+func watchesEventType(source triggersv1.KubernetesEventSource, eventType triggersv1.KubernetesEventType) bool {
+	if len(source.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range source.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// fireEvent processes an event fired by the EventListener with its Triggers,
+// the same way as an incoming event.
+func (r Sink) fireEvent(ctx context.Context, body []byte, header http.Header) {
+	rec, eventWG, log, err := r.startEvent(ctx, body, header)
+	if err != nil {
+		r.Logger.Errorf("failed to process %s event from Kubernetes event source %s: %v", header.Get(KubernetesEventTypeHeader), header.Get(KubernetesEventSourceHeader), err)
+		return
+	}
+	log.Infof("fired %s event from Kubernetes event source %s", header.Get(KubernetesEventTypeHeader), header.Get(KubernetesEventSourceHeader))
+	r.processInBackground(func() {
+		eventWG.Wait()
+		r.writeInvocation(rec, log)
+	})
+}
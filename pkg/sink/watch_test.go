@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sink
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1beta1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	"github.com/tektoncd/triggers/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/ptr"
+)
+
+func TestWatchedNamespaces(t *testing.T) {
+	r := Sink{EventListenerNamespace: namespace}
+	for _, tc := range []struct {
+		name          string
+		matchNames    []string
+		clusterScoped bool
+		want          []string
+	}{{
+		name: "defaults to the namespace of the EventListener",
+		want: []string{namespace},
+	}, {
+		name:       "namespaces",
+		matchNames: []string{"a", "b"},
+		want:       []string{"a", "b"},
+	}, {
+		name:       "all namespaces",
+		matchNames: []string{"a", "*"},
+		want:       []string{""},
+	}, {
+		name:          "cluster-scoped",
+		matchNames:    []string{"a"},
+		clusterScoped: true,
+		want:          []string{""},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			source := triggersv1beta1.KubernetesEventSource{
+				NamespaceSelector: triggersv1beta1.NamespaceSelector{MatchNames: tc.matchNames},
+			}
+			if diff := cmp.Diff(tc.want, r.watchedNamespaces(source, tc.clusterScoped)); diff != "" {
+				t.Errorf("watchedNamespaces() -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func configMap(resourceVersion, state string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "release",
+			"namespace":       namespace,
+			"resourceVersion": resourceVersion,
+		},
+		"data": map[string]interface{}{"state": state},
+	}}
+}
+
+func TestKubernetesEventHandler(t *testing.T) {
+	tt := &triggersv1beta1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-clone", Namespace: namespace},
+		Spec:       *makeGitCloneTTSpec(t, "git-clone-run"),
+	}
+	tr := &triggersv1beta1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: "on-change", Namespace: namespace},
+		Spec: triggersv1beta1.TriggerSpec{
+			Bindings: []*triggersv1beta1.TriggerSpecBinding{
+				{Name: "url", Value: ptr.String("$(body.data.state)")},
+				{Name: "revision", Value: ptr.String("$(header.Tekton-Kubernetes-Event-Type)")},
+			},
+			Template: triggersv1beta1.TriggerSpecTemplate{Ref: ptr.String("git-clone")},
+		},
+	}
+	source := triggersv1beta1.KubernetesEventSource{
+		Name:       "configmaps",
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		EventTypes: []triggersv1beta1.KubernetesEventType{triggersv1beta1.KubernetesEventAdd, triggersv1beta1.KubernetesEventUpdate},
+		Filter:     `eventType != "update" || object.data.state != oldObject.data.state`,
+	}
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace, UID: types.UID(elUID)},
+		Spec: triggersv1beta1.EventListenerSpec{
+			Triggers:               []triggersv1beta1.EventListenerTrigger{{TriggerRef: "on-change"}},
+			KubernetesEventSources: []triggersv1beta1.KubernetesEventSource{source},
+		},
+	}
+	sink, dynamicClient := getSinkAssets(t, test.Resources{
+		EventListeners:   []*triggersv1beta1.EventListener{el},
+		Triggers:         []*triggersv1beta1.Trigger{tr},
+		TriggerTemplates: []*triggersv1beta1.TriggerTemplate{tt},
+	}, el.Name, nil)
+
+	handler, err := sink.kubernetesEventHandler(context.Background(), source)
+	if err != nil {
+		t.Fatalf("kubernetesEventHandler() returned error: %v", err)
+	}
+	// The resources that exist when the source starts being watched
+	handler.OnAdd(configMap("1", "draft"), true)
+	handler.OnAdd(configMap("2", "draft"), false)
+	// A resync
+	handler.OnUpdate(configMap("2", "draft"), configMap("2", "draft"))
+	// Filtered out
+	handler.OnUpdate(configMap("2", "draft"), configMap("3", "draft"))
+	handler.OnUpdate(configMap("3", "draft"), configMap("4", "published"))
+	// Not one of the event types
+	handler.OnDelete(configMap("4", "published"))
+	sink.WGProcessTriggers.Wait()
+
+	var got [][]string
+	for _, a := range dynamicClient.Actions() {
+		if c, ok := a.(ktesting.CreateActionImpl); ok {
+			params, _, _ := unstructured.NestedSlice(c.GetObject().(*unstructured.Unstructured).Object, "spec", "params")
+			got = append(got, []string{
+				params[0].(map[string]interface{})["value"].(string),
+				params[1].(map[string]interface{})["value"].(string),
+			})
+		}
+	}
+	// The events are processed concurrently
+	sort.Slice(got, func(i, j int) bool { return got[i][1] < got[j][1] })
+	want := [][]string{{"draft", "add"}, {"published", "update"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("created resources -want +got: %s", diff)
+	}
+}
+
+func TestWatcherSync_Retry(t *testing.T) {
+	el := &triggersv1beta1.EventListener{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-el", Namespace: namespace},
+		Spec: triggersv1beta1.EventListenerSpec{
+			KubernetesEventSources: []triggersv1beta1.KubernetesEventSource{{
+				Name:       "missing",
+				APIVersion: "example.dev/v1",
+				Kind:       "Missing",
+			}},
+		},
+	}
+	sink, _ := getSinkAssets(t, test.Resources{EventListeners: []*triggersv1beta1.EventListener{el}}, el.Name, nil)
+	w := &watcher{sink: sink}
+	defer w.stop()
+	now := time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)
+
+	w.sync(context.Background(), el, now)
+	if want := now.Add(scheduleCheckInterval); !w.retryAt.Equal(want) {
+		t.Errorf("retryAt = %s, want %s", w.retryAt, want)
+	}
+	// Not retried before the retry time
+	w.sync(context.Background(), el, now.Add(time.Second))
+	if want := now.Add(scheduleCheckInterval); !w.retryAt.Equal(want) {
+		t.Errorf("retryAt = %s, want %s", w.retryAt, want)
+	}
+	w.sync(context.Background(), el, now.Add(scheduleCheckInterval))
+	if want := now.Add(2 * scheduleCheckInterval); !w.retryAt.Equal(want) {
+		t.Errorf("retryAt = %s, want %s", w.retryAt, want)
+	}
+	// The source was removed
+	el.Spec.KubernetesEventSources = nil
+	w.sync(context.Background(), el, now.Add(2*scheduleCheckInterval))
+	if !w.retryAt.IsZero() || w.cancel != nil {
+		t.Errorf("watcher still retries or watches after the source was removed")
+	}
+}
+
+func TestWatchSource_Secrets(t *testing.T) {
+	sink, _ := getSinkAssets(t, test.Resources{}, "my-el", nil)
+	kube := sink.KubeClientSet.(*fakekubeclientset.Clientset)
+	kube.Resources = append(kube.Resources, &metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "secrets", Kind: "Secret", Namespaced: true}},
+	})
+
+	_, err := sink.watchSource(context.Background(), triggersv1beta1.KubernetesEventSource{
+		Name:       "secrets",
+		APIVersion: "v1",
+		Kind:       "Secret",
+	})
+	if err == nil || !strings.Contains(err.Error(), "can't be watched") {
+		t.Errorf("watchSource() = %v, want an error for watching Secrets", err)
+	}
+}
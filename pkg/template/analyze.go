@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	"knative.dev/pkg/apis"
+)
+
+// deprecatedParamRefRegexp captures the pre-v0.10 param references
+// $(params.NAME), which are no longer substituted.
+var deprecatedParamRefRegexp = regexp.MustCompile(`\$\(params\.([_a-zA-Z][_a-zA-Z0-9.-]*)\)`)
+
+// FindingCode identifies the kind of problem reported by AnalyzeParams.
+type FindingCode string
+
+const (
+	// UndeclaredParam is reported for a param provided by a binding that the
+	// TriggerTemplate doesn't declare. Its value is silently ignored.
+	UndeclaredParam FindingCode = "UndeclaredParam"
+	// MissingParam is reported for a param the TriggerTemplate declares
+	// without a default that no binding provides. Every event fails to
+	// create resources.
+	MissingParam FindingCode = "MissingParam"
+	// UndeclaredReference is reported for a $(tt.params.NAME) reference in
+	// the resource templates to a param the TriggerTemplate doesn't declare.
+	// The reference is left as is in the created resources.
+	UndeclaredReference FindingCode = "UndeclaredReference"
+	// DeprecatedReference is reported for a $(params.NAME) reference to a
+	// TriggerTemplate param, which isn't substituted anymore.
+	DeprecatedReference FindingCode = "DeprecatedReference"
+)
+
+// Finding is a problem with the params of a resolved Trigger.
+type Finding struct {
+	Code FindingCode
+	// Param is the name of the param the finding is about.
+	Param   string
+	Message string
+}
+
+// IsError reports whether the finding breaks the Trigger, as opposed to
+// being a likely mistake that still lets resources be created.
+func (f Finding) IsError() bool {
+	return f.Code == MissingParam || f.Code == UndeclaredReference
+}
+
+// AnalyzeParams statically checks that the params provided by the bindings of
+// a resolved Trigger are compatible with the params its TriggerTemplate
+// declares and references, without needing an event.
+//
+// A $(params.NAME) reference is only reported when NAME is declared by the
+// TriggerTemplate but never referenced as $(tt.params.NAME), since resource
+// templates such as embedded Pipelines legitimately use $(params.NAME) for
+// their own params.
+func AnalyzeParams(rt ResolvedTrigger) []Finding {
+	if rt.TriggerTemplate == nil {
+		return nil
+	}
+	var findings []Finding
+
+	declared := make(map[string]bool, len(rt.TriggerTemplate.Spec.Params))
+	for _, p := range rt.TriggerTemplate.Spec.Params {
+		declared[p.Name] = true
+	}
+	provided := make(map[string]bool, len(rt.BindingParams))
+	for _, p := range rt.BindingParams {
+		provided[p.Name] = true
+		if !declared[p.Name] {
+			findings = append(findings, Finding{
+				Code:    UndeclaredParam,
+				Param:   p.Name,
+				Message: fmt.Sprintf("param %s is provided by a binding but not declared by the TriggerTemplate, so its value is ignored", p.Name),
+			})
+		}
+	}
+	for _, p := range rt.TriggerTemplate.Spec.Params {
+		if p.Default == nil && !provided[p.Name] {
+			findings = append(findings, Finding{
+				Code:    MissingParam,
+				Param:   p.Name,
+				Message: fmt.Sprintf("param %s is declared by the TriggerTemplate without a default but no binding provides it", p.Name),
+			})
+		}
+	}
+
+	referenced := map[string]bool{}
+	var deprecated []string
+	seenDeprecated := map[string]bool{}
+	for _, r := range rt.TriggerTemplate.Spec.ResourceTemplates {
+		for _, m := range paramRefRegexp.FindAllSubmatch(r.Raw, -1) {
+			name := string(m[1])
+			if referenced[name] {
+				continue
+			}
+			referenced[name] = true
+			if !declared[name] {
+				findings = append(findings, Finding{
+					Code:    UndeclaredReference,
+					Param:   name,
+					Message: fmt.Sprintf("$(tt.params.%s) is referenced by a resource template but not declared by the TriggerTemplate", name),
+				})
+			}
+		}
+		for _, m := range deprecatedParamRefRegexp.FindAllSubmatch(r.Raw, -1) {
+			name := string(m[1])
+			if declared[name] && !seenDeprecated[name] {
+				seenDeprecated[name] = true
+				deprecated = append(deprecated, name)
+			}
+		}
+	}
+	for _, name := range deprecated {
+		if referenced[name] {
+			continue
+		}
+		findings = append(findings, Finding{
+			Code:    DeprecatedReference,
+			Param:   name,
+			Message: fmt.Sprintf("$(params.%s) is no longer substituted, use $(tt.params.%s) to reference the TriggerTemplate param", name, name),
+		})
+	}
+	return findings
+}
+
+// NewTriggerParamsAnalyzer returns an analyzer for
+// v1beta1.WithTriggerParamsAnalyzer that resolves Triggers with the listers
+// and reports the findings of AnalyzeParams on the field they're about.
+// Triggers that can't be resolved, e.g. because their TriggerTemplate isn't
+// created yet, aren't reported on.
+func NewTriggerParamsAnalyzer(triggerBindingLister listers.TriggerBindingLister, clusterTriggerBindingLister listers.ClusterTriggerBindingLister,
+	triggerTemplateLister listers.TriggerTemplateLister) func(context.Context, *triggersv1.Trigger) *apis.FieldError {
+	return func(_ context.Context, t *triggersv1.Trigger) *apis.FieldError {
+		rt, err := ResolveTrigger(*t,
+			triggerBindingLister.TriggerBindings(t.Namespace).Get,
+			clusterTriggerBindingLister.Get,
+			triggerTemplateLister.TriggerTemplates(t.Namespace).Get)
+		if err != nil {
+			return nil
+		}
+		var errs *apis.FieldError
+		for _, f := range AnalyzeParams(rt) {
+			field := "bindings"
+			if f.Code == UndeclaredReference || f.Code == DeprecatedReference {
+				field = "template"
+			}
+			errs = errs.Also(apis.ErrGeneric(f.Message, field))
+		}
+		return errs
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	listers "github.com/tektoncd/triggers/pkg/client/listers/triggers/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestAnalyzeParams(t *testing.T) {
+	tt := func(params []triggersv1.ParamSpec, resources ...string) *triggersv1.TriggerTemplate {
+		spec := triggersv1.TriggerTemplateSpec{Params: params}
+		for _, r := range resources {
+			spec.ResourceTemplates = append(spec.ResourceTemplates, triggersv1.TriggerResourceTemplate{
+				RawExtension: runtime.RawExtension{Raw: []byte(r)},
+			})
+		}
+		return &triggersv1.TriggerTemplate{Spec: spec}
+	}
+
+	tests := []struct {
+		name string
+		rt   ResolvedTrigger
+		want []Finding
+	}{{
+		name: "compatible",
+		rt: ResolvedTrigger{
+			TriggerTemplate: tt([]triggersv1.ParamSpec{{Name: "a"}, {Name: "b", Default: ptr.String("x")}},
+				`{"metadata":{"name":"$(tt.params.a)-$(tt.params.b)"}}`),
+			BindingParams: []triggersv1.Param{{Name: "a", Value: "1"}},
+		},
+	}, {
+		name: "no template",
+		rt:   ResolvedTrigger{BindingParams: []triggersv1.Param{{Name: "a", Value: "1"}}},
+	}, {
+		name: "undeclared and missing params",
+		rt: ResolvedTrigger{
+			TriggerTemplate: tt([]triggersv1.ParamSpec{{Name: "a"}, {Name: "b"}}),
+			BindingParams:   []triggersv1.Param{{Name: "a", Value: "1"}, {Name: "c", Value: "3"}},
+		},
+		want: []Finding{{
+			Code:    UndeclaredParam,
+			Param:   "c",
+			Message: "param c is provided by a binding but not declared by the TriggerTemplate, so its value is ignored",
+		}, {
+			Code:    MissingParam,
+			Param:   "b",
+			Message: "param b is declared by the TriggerTemplate without a default but no binding provides it",
+		}},
+	}, {
+		name: "undeclared references are reported once",
+		rt: ResolvedTrigger{
+			TriggerTemplate: tt(nil,
+				`{"metadata":{"name":"$(tt.params.a)"}}`,
+				`{"metadata":{"name":"$(tt.params.a)-$(tt.params.b)"}}`),
+		},
+		want: []Finding{{
+			Code:    UndeclaredReference,
+			Param:   "a",
+			Message: "$(tt.params.a) is referenced by a resource template but not declared by the TriggerTemplate",
+		}, {
+			Code:    UndeclaredReference,
+			Param:   "b",
+			Message: "$(tt.params.b) is referenced by a resource template but not declared by the TriggerTemplate",
+		}},
+	}, {
+		name: "deprecated references",
+		rt: ResolvedTrigger{
+			TriggerTemplate: tt([]triggersv1.ParamSpec{{Name: "a", Default: ptr.String("")}, {Name: "b", Default: ptr.String("")}},
+				// $(params.b) and $(params.c) are the params of the
+				// embedded Pipeline, which is passed $(tt.params.b).
+				`{"spec":{"params":[{"name":"b","value":"$(tt.params.b)"}],"pipelineSpec":{"tasks":[{"name":"$(params.a)-$(params.b)-$(params.c)"}]}}}`),
+		},
+		want: []Finding{{
+			Code:    DeprecatedReference,
+			Param:   "a",
+			Message: "$(params.a) is no longer substituted, use $(tt.params.a) to reference the TriggerTemplate param",
+		}},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := AnalyzeParams(tc.rt)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AnalyzeParams() mismatch. -want/+got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindingIsError(t *testing.T) {
+	for code, want := range map[FindingCode]bool{
+		UndeclaredParam:     false,
+		MissingParam:        true,
+		UndeclaredReference: true,
+		DeprecatedReference: false,
+	} {
+		if got := (Finding{Code: code}).IsError(); got != want {
+			t.Errorf("Finding{Code: %s}.IsError() = %t, want %t", code, got, want)
+		}
+	}
+}
+
+func TestNewTriggerParamsAnalyzer(t *testing.T) {
+	indexer := func(objs ...runtime.Object) cache.Indexer {
+		i := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		for _, o := range objs {
+			if err := i.Add(o); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return i
+	}
+	analyze := NewTriggerParamsAnalyzer(
+		listers.NewTriggerBindingLister(indexer(&triggersv1.TriggerBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "tb", Namespace: "ns"},
+			Spec:       triggersv1.TriggerBindingSpec{Params: []triggersv1.Param{{Name: "url", Value: "$(body.url)"}}},
+		})),
+		listers.NewClusterTriggerBindingLister(indexer()),
+		listers.NewTriggerTemplateLister(indexer(&triggersv1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "tt", Namespace: "ns"},
+			Spec: triggersv1.TriggerTemplateSpec{
+				Params: []triggersv1.ParamSpec{{Name: "revision"}},
+				ResourceTemplates: []triggersv1.TriggerResourceTemplate{{
+					RawExtension: runtime.RawExtension{Raw: []byte(`{"metadata":{"name":"$(params.revision)"}}`)},
+				}},
+			},
+		})))
+	trigger := func(template string) *triggersv1.Trigger {
+		return &triggersv1.Trigger{
+			ObjectMeta: metav1.ObjectMeta{Name: "trigger", Namespace: "ns"},
+			Spec: triggersv1.TriggerSpec{
+				Bindings: []*triggersv1.TriggerSpecBinding{{Ref: "tb"}},
+				Template: triggersv1.TriggerSpecTemplate{Ref: ptr.String(template)},
+			},
+		}
+	}
+
+	want := (&apis.FieldError{
+		Message: "param url is provided by a binding but not declared by the TriggerTemplate, so its value is ignored",
+		Paths:   []string{"bindings"},
+	}).Also(&apis.FieldError{
+		Message: "param revision is declared by the TriggerTemplate without a default but no binding provides it",
+		Paths:   []string{"bindings"},
+	}).Also(&apis.FieldError{
+		Message: "$(params.revision) is no longer substituted, use $(tt.params.revision) to reference the TriggerTemplate param",
+		Paths:   []string{"template"},
+	})
+	if got := analyze(context.Background(), trigger("tt")); got.Error() != want.Error() {
+		t.Errorf("analyze() = %q, want %q", got.Error(), want.Error())
+	}
+	if got := analyze(context.Background(), trigger("missing")); got != nil {
+		t.Errorf("expected no findings for a Trigger that can't be resolved, got %q", got.Error())
+	}
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	celext "github.com/google/cel-go/ext"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+var (
+	// forEachItemMatch determines the current item variable within a
+	// resource template with a for-each annotation.
+	forEachItemMatch = []byte(`$(tt.foreach.item)`)
+	// forEachIndexMatch determines the current index variable within a
+	// resource template with a for-each annotation.
+	forEachIndexMatch = []byte(`$(tt.foreach.index)`)
+	// forEachItemPlaceholder stands for the current item until the params
+	// and uid are substituted. It is random so that param values can't
+	// produce it.
+	forEachItemPlaceholder = newForEachItemPlaceholder()
+)
+
+func newForEachItemPlaceholder() []byte {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return []byte("tt-foreach-item-" + hex.EncodeToString(b))
+}
+
+// resourceTemplate is a resource template to resolve, along with the item of
+// the for-each annotation it was expanded for.
+type resourceTemplate struct {
+	raw json.RawMessage
+	// item is the item encoded as the contents of a JSON string, or nil if
+	// the resource template has no for-each annotation.
+	item []byte
+}
+
+// applyItem substitutes the item of the resource template into the resolved
+// resource. The item is only substituted once the params and uid are, so that
+// neither the item nor the params can inject references to the other.
+func (rt resourceTemplate) applyItem(resource json.RawMessage) json.RawMessage {
+	if rt.item == nil {
+		return resource
+	}
+	return bytes.ReplaceAll(resource, forEachItemPlaceholder, rt.item)
+}
+
+// expandResourceTemplates returns the resource templates of the
+// TriggerTemplate that should be created for the params.
+//
+// Resource templates with a when annotation are only returned if the CEL
+// expression evaluates to true, and resource templates with a for-each
+// annotation are returned once for each item of the array param, with the
+// item and index variables substituted. Both annotations are removed from the
+// returned resource templates.
+func expandResourceTemplates(template *triggersv1.TriggerTemplate, params []triggersv1.Param) ([]resourceTemplate, error) {
+	var env *cel.Env
+	var celParams map[string]interface{}
+	resources := make([]resourceTemplate, 0, len(template.Spec.ResourceTemplates))
+	for i := range template.Spec.ResourceTemplates {
+		raw := template.Spec.ResourceTemplates[i].RawExtension.Raw
+		rt, when, forEach, err := extractResourceTemplateAnnotations(raw)
+		if err != nil {
+			return nil, fmt.Errorf("resource template %d: %w", i, err)
+		}
+		if when == "" && forEach == "" {
+			resources = append(resources, resourceTemplate{raw: raw})
+			continue
+		}
+
+		if env == nil {
+			if env, err = newResourceTemplateEnv(); err != nil {
+				return nil, err
+			}
+			if celParams, err = paramsToCEL(template.Spec.Params, params, !usesStructuredEngine(template)); err != nil {
+				return nil, err
+			}
+		}
+
+		if forEach == "" {
+			ok, err := evaluateWhen(env, when, map[string]interface{}{"params": celParams})
+			if err != nil {
+				return nil, fmt.Errorf("resource template %d: %w", i, err)
+			}
+			if ok {
+				resources = append(resources, resourceTemplate{raw: rt})
+			}
+			continue
+		}
+
+		items, ok := celParams[forEach].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("resource template %d: %s param %q is not an array param with a value", i, triggers.ForEachAnnotation, forEach)
+		}
+		for index, item := range items {
+			if when != "" {
+				ok, err := evaluateWhen(env, when, map[string]interface{}{"params": celParams, "item": item, "index": index})
+				if err != nil {
+					return nil, fmt.Errorf("resource template %d item %d: %w", i, index, err)
+				}
+				if !ok {
+					continue
+				}
+			}
+			expanded, err := applyForEachItemToResourceTemplate(rt, item, index)
+			if err != nil {
+				return nil, fmt.Errorf("resource template %d item %d: %w", i, index, err)
+			}
+			resources = append(resources, expanded)
+		}
+	}
+	return resources, nil
+}
+
+// extractResourceTemplateAnnotations returns the resource template without the
+// when and for-each annotations, along with their values.
+func extractResourceTemplateAnnotations(rt json.RawMessage) (json.RawMessage, string, string, error) {
+	// Avoid parsing the resource template when neither annotation is present.
+	if !bytes.Contains(rt, []byte(triggers.WhenAnnotation)) && !bytes.Contains(rt, []byte(triggers.ForEachAnnotation)) {
+		return rt, "", "", nil
+	}
+	var data map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(rt))
+	d.UseNumber()
+	if err := d.Decode(&data); err != nil {
+		return nil, "", "", fmt.Errorf("failed to parse resource template: %w", err)
+	}
+	metadata, _ := data["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	when, _ := annotations[triggers.WhenAnnotation].(string)
+	forEach, _ := annotations[triggers.ForEachAnnotation].(string)
+	if when == "" && forEach == "" {
+		return rt, "", "", nil
+	}
+	delete(annotations, triggers.WhenAnnotation)
+	delete(annotations, triggers.ForEachAnnotation)
+	if len(annotations) == 0 {
+		delete(metadata, "annotations")
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(data); err != nil {
+		return nil, "", "", fmt.Errorf("failed to encode resource template: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), when, forEach, nil
+}
+
+// newResourceTemplateEnv returns the CEL environment used to evaluate when
+// annotations.
+func newResourceTemplateEnv() (*cel.Env, error) {
+	env, err := cel.NewEnv(
+		celext.Strings(),
+		celext.Lists(),
+		celext.Sets(),
+		cel.Variable("params", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("item", cel.DynType),
+		cel.Variable("index", cel.IntType),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	return env, nil
+}
+
+// paramsToCEL returns the params as values for a CEL evaluation, with array and
+// object params decoded into lists and maps. The legacy engine inserts string
+// params into resource templates as the contents of JSON strings, so they are
+// decoded into the strings the resources get when escaped is true.
+func paramsToCEL(specs []triggersv1.ParamSpec, params []triggersv1.Param, escaped bool) (map[string]interface{}, error) {
+	types := make(map[string]triggersv1.ParamType, len(specs))
+	for _, ps := range specs {
+		types[ps.Name] = ps.GetType()
+	}
+	out := make(map[string]interface{}, len(params))
+	for _, p := range params {
+		t, ok := types[p.Name]
+		if !ok || t == triggersv1.ParamTypeString {
+			out[p.Name] = p.Value
+			if escaped {
+				out[p.Name] = unescapeJSONString(p.Value)
+			}
+			continue
+		}
+		var v interface{}
+		if err := json.Unmarshal([]byte(p.Value), &v); err != nil {
+			return nil, fmt.Errorf("invalid JSON value for param %s: %w", p.Name, err)
+		}
+		out[p.Name] = v
+	}
+	return out, nil
+}
+
+// unescapeJSONString returns the string value of s when s is the contents of
+// a JSON string. Any other value is returned unmodified.
+func unescapeJSONString(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var out string
+	if err := json.Unmarshal([]byte(`"`+s+`"`), &out); err != nil {
+		return s
+	}
+	return out
+}
+
+// evaluateWhen evaluates a when expression, which must return a bool.
+func evaluateWhen(env *cel.Env, expr string, vars map[string]interface{}) (bool, error) {
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return false, fmt.Errorf("failed to compile %s expression %q: %w", triggers.WhenAnnotation, expr, issues.Err())
+	}
+	prg, err := env.Program(ast)
+	if err != nil {
+		return false, fmt.Errorf("failed to create program for %s expression %q: %w", triggers.WhenAnnotation, expr, err)
+	}
+	if _, ok := vars["item"]; !ok {
+		vars["item"] = nil
+		vars["index"] = -1
+	}
+	out, _, err := prg.Eval(vars)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate %s expression %q: %w", triggers.WhenAnnotation, expr, err)
+	}
+	b, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("%s expression %q did not return a bool, got %v", triggers.WhenAnnotation, expr, out.Type())
+	}
+	return b, nil
+}
+
+// applyForEachItemToResourceTemplate returns the resource template with the
+// index substituted, and the item in place of its variable.
+func applyForEachItemToResourceTemplate(rt json.RawMessage, item interface{}, index int) (resourceTemplate, error) {
+	s, ok := item.(string)
+	if !ok {
+		b, err := json.Marshal(item)
+		if err != nil {
+			return resourceTemplate{}, err
+		}
+		s = string(b)
+	}
+	value, err := encodeJSONString(escapeTektonVariables(s))
+	if err != nil {
+		return resourceTemplate{}, err
+	}
+	rt = bytes.ReplaceAll(rt, forEachItemMatch, forEachItemPlaceholder)
+	return resourceTemplate{
+		raw:  bytes.ReplaceAll(rt, forEachIndexMatch, []byte(strconv.Itoa(index))),
+		item: value,
+	}, nil
+}
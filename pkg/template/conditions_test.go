@@ -0,0 +1,178 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func conditionalTemplate(params []triggersv1.ParamSpec, rts ...string) *triggersv1.TriggerTemplate {
+	tt := &triggersv1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tt", Namespace: ns},
+		Spec:       triggersv1.TriggerTemplateSpec{Params: params},
+	}
+	for _, rt := range rts {
+		tt.Spec.ResourceTemplates = append(tt.Spec.ResourceTemplates, triggersv1.TriggerResourceTemplate{
+			RawExtension: runtime.RawExtension{Raw: []byte(rt)},
+		})
+	}
+	return tt
+}
+
+func TestExpandResourceTemplates(t *testing.T) {
+	specs := []triggersv1.ParamSpec{
+		{Name: "ref"},
+		{Name: "dirs", Type: triggersv1.ParamTypeArray},
+		{Name: "labels", Type: triggersv1.ParamTypeObject},
+	}
+	params := []triggersv1.Param{
+		{Name: "ref", Value: "refs/tags/v1.0.0"},
+		{Name: "dirs", Value: `["api", "web", "docs"]`},
+		{Name: "labels", Value: `{"team": "a"}`},
+	}
+	tests := []struct {
+		name     string
+		template *triggersv1.TriggerTemplate
+		want     []json.RawMessage
+	}{{
+		name:     "no annotations",
+		template: conditionalTemplate(specs, `{"kind": "ConfigMap", "metadata": {"annotations": {"a": "b"}}}`),
+		want:     []json.RawMessage{json.RawMessage(`{"kind": "ConfigMap", "metadata": {"annotations": {"a": "b"}}}`)},
+	}, {
+		name: "when true",
+		template: conditionalTemplate(specs,
+			`{"kind": "ConfigMap", "metadata": {"annotations": {"triggers.tekton.dev/when": "params.ref.startsWith('refs/tags/')"}}}`),
+		want: []json.RawMessage{json.RawMessage(`{"kind":"ConfigMap","metadata":{}}`)},
+	}, {
+		name: "when false",
+		template: conditionalTemplate(specs,
+			`{"kind": "ConfigMap", "metadata": {"annotations": {"a": "b", "triggers.tekton.dev/when": "params.labels.team == 'b'"}}}`,
+			`{"kind": "Secret"}`),
+		want: []json.RawMessage{json.RawMessage(`{"kind": "Secret"}`)},
+	}, {
+		name: "for each item",
+		template: conditionalTemplate(specs,
+			`{"kind": "PipelineRun", "metadata": {"name": "build-$(tt.foreach.index)", "annotations": {"a": "b", "triggers.tekton.dev/for-each": "dirs"}}, "spec": {"dir": "$(tt.foreach.item)"}}`),
+		want: []json.RawMessage{
+			json.RawMessage(`{"kind":"PipelineRun","metadata":{"annotations":{"a":"b"},"name":"build-0"},"spec":{"dir":"api"}}`),
+			json.RawMessage(`{"kind":"PipelineRun","metadata":{"annotations":{"a":"b"},"name":"build-1"},"spec":{"dir":"web"}}`),
+			json.RawMessage(`{"kind":"PipelineRun","metadata":{"annotations":{"a":"b"},"name":"build-2"},"spec":{"dir":"docs"}}`),
+		},
+	}, {
+		name: "for each item with when",
+		template: conditionalTemplate(specs,
+			`{"kind": "PipelineRun", "metadata": {"annotations": {"triggers.tekton.dev/for-each": "dirs", "triggers.tekton.dev/when": "item != 'docs' && index > 0"}}, "spec": {"dir": "$(tt.foreach.item)"}}`),
+		want: []json.RawMessage{
+			json.RawMessage(`{"kind":"PipelineRun","metadata":{},"spec":{"dir":"web"}}`),
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rts, err := expandResourceTemplates(tt.template, params)
+			if err != nil {
+				t.Fatalf("expandResourceTemplates() returned unexpected error: %s", err)
+			}
+			got := make([]json.RawMessage, len(rts))
+			for i, rt := range rts {
+				got[i] = rt.applyItem(rt.raw)
+			}
+			if diff := cmp.Diff(toString(tt.want), toString(got)); diff != "" {
+				t.Errorf("expandResourceTemplates(): -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandResourceTemplates_Error(t *testing.T) {
+	specs := []triggersv1.ParamSpec{{Name: "ref"}, {Name: "dirs", Type: triggersv1.ParamTypeArray}}
+	params := []triggersv1.Param{{Name: "ref", Value: "main"}}
+	tests := []struct {
+		name    string
+		rt      string
+		wantErr string
+	}{{
+		name:    "invalid expression",
+		rt:      `{"metadata": {"annotations": {"triggers.tekton.dev/when": "params.ref ==="}}}`,
+		wantErr: "failed to compile",
+	}, {
+		name:    "expression does not return a bool",
+		rt:      `{"metadata": {"annotations": {"triggers.tekton.dev/when": "params.ref"}}}`,
+		wantErr: "did not return a bool",
+	}, {
+		name:    "for each string param",
+		rt:      `{"metadata": {"annotations": {"triggers.tekton.dev/for-each": "ref"}}}`,
+		wantErr: `param "ref" is not an array param with a value`,
+	}, {
+		name:    "for each param without a value",
+		rt:      `{"metadata": {"annotations": {"triggers.tekton.dev/for-each": "dirs"}}}`,
+		wantErr: `param "dirs" is not an array param with a value`,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := expandResourceTemplates(conditionalTemplate(specs, tt.rt), params)
+			if err == nil {
+				t.Fatal("expandResourceTemplates() did not return an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expandResourceTemplates() error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveResources_ForEach(t *testing.T) {
+	// Items are only substituted once the params and uid are, so that
+	// variables in items stay escaped, and params can't reference the item
+	params := []triggersv1.Param{
+		{Name: "name", Value: "foo $(tt.foreach.item)"},
+		{Name: "files", Value: `["a \"b\".go", "$(c).go", "$(tt.params.name)", "$(uid)"]`},
+	}
+	want := []json.RawMessage{
+		json.RawMessage(`{"data":{"file":"a \"b\".go","name":"foo $$(tt.foreach.item)"},"metadata":{}}`),
+		json.RawMessage(`{"data":{"file":"$$(c).go","name":"foo $$(tt.foreach.item)"},"metadata":{}}`),
+		json.RawMessage(`{"data":{"file":"$$(tt.params.name)","name":"foo $$(tt.foreach.item)"},"metadata":{}}`),
+		json.RawMessage(`{"data":{"file":"$$(uid)","name":"foo $$(tt.foreach.item)"},"metadata":{}}`),
+	}
+	for _, engine := range []string{"", triggers.StructuredTemplateEngine} {
+		t.Run("engine "+engine, func(t *testing.T) {
+			template := conditionalTemplate([]triggersv1.ParamSpec{{Name: "name"}, {Name: "files", Type: triggersv1.ParamTypeArray}},
+				`{"metadata": {"annotations": {"triggers.tekton.dev/for-each": "files"}}, "data": {"name": "$(tt.params.name)", "file": "$(tt.foreach.item)"}}`)
+			if engine != "" {
+				template.Annotations = map[string]string{triggers.TemplateEngineAnnotation: engine}
+			}
+			uuid.SetRand(nil)
+			got, err := ResolveResources(template, params)
+			if err != nil {
+				t.Fatalf("ResolveResources() returned unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(toString(want), toString(got)); diff != "" {
+				t.Errorf("ResolveResources(): -want +got: %s", diff)
+			}
+		})
+	}
+}
@@ -34,10 +34,17 @@ const (
 	//
 	// This can be removed when this functionality is no-longer needed.
 	OldEscapeAnnotation = "triggers.tekton.dev/old-escape-quotes"
+
+	cloudEventHeaderPrefix = "ce-"
 )
 
 type TriggerContext struct {
 	EventID string `json:"eventID"`
+	// Path is the HTTP path of the request of the event
+	Path string `json:"path,omitempty"`
+	// PathParams holds the values of the wildcards of the path of the route
+	// matched by the event
+	PathParams map[string]string `json:"pathParams,omitempty"`
 }
 
 func NewTriggerContext(eventID string) TriggerContext {
@@ -46,13 +53,19 @@ func NewTriggerContext(eventID string) TriggerContext {
 
 // ResolveParams takes given triggerbindings and produces the resulting
 // resource params.
+//
+// Strings extracted from the event are JSON-escaped, as the legacy template
+// engine inserts them into the raw resource templates, unless the
+// TriggerTemplate uses the structured engine, which encodes them itself.
 func ResolveParams(rt ResolvedTrigger, body []byte, header http.Header, extensions map[string]interface{}, triggerContext TriggerContext) ([]triggersv1.Param, error) {
 	var ttParams []triggersv1.ParamSpec
+	escape := true
 	if rt.TriggerTemplate != nil {
 		ttParams = rt.TriggerTemplate.Spec.Params
+		escape = !usesStructuredEngine(rt.TriggerTemplate)
 	}
 
-	out, err := applyEventValuesToParams(rt.BindingParams, body, header, extensions, ttParams, triggerContext)
+	out, err := applyEventValuesToParams(rt.BindingParams, body, header, extensions, ttParams, triggerContext, escape)
 	if err != nil {
 		return nil, fmt.Errorf("failed to ApplyEventValuesToParams: %w", err)
 	}
@@ -61,17 +74,41 @@ func ResolveParams(rt ResolvedTrigger, body []byte, header http.Header, extensio
 }
 
 // ResolveResources resolves a templated resource by replacing params with their values.
-func ResolveResources(template *triggersv1.TriggerTemplate, params []triggersv1.Param) []json.RawMessage {
-	resources := make([]json.RawMessage, len(template.Spec.ResourceTemplates))
+// Array and object params are substituted as JSON values.
+//
+// Resource templates are only resolved if their when annotation, if any,
+// evaluates to true, and are resolved once per item of the array param named
+// in their for-each annotation.
+//
+// TriggerTemplates annotated with the structured template engine are parsed
+// and params are only substituted in string values; references to params
+// without a value are returned as an error.
+func ResolveResources(template *triggersv1.TriggerTemplate, params []triggersv1.Param) ([]json.RawMessage, error) {
+	rts, err := expandResourceTemplates(template, params)
+	if err != nil {
+		return nil, err
+	}
+
 	uid := UUID()
+	if usesStructuredEngine(template) {
+		return resolveStructuredResources(template.Spec.Params, rts, params, uid)
+	}
+
+	resources := make([]json.RawMessage, len(rts))
 
 	oldEscape := metav1.HasAnnotation(template.ObjectMeta, OldEscapeAnnotation)
+	stringParams, typedParams := splitParamsByType(template.Spec.Params, params)
 
-	for i := range template.Spec.ResourceTemplates {
-		resources[i] = applyParamsToResourceTemplate(params, template.Spec.ResourceTemplates[i].RawExtension.Raw, oldEscape)
+	for i := range rts {
+		rt, err := applyTypedParamsToResourceTemplate(typedParams, rts[i].raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve resource template %d: %w", i, err)
+		}
+		resources[i] = applyParamsToResourceTemplate(stringParams, rt, oldEscape)
 		resources[i] = applyUIDToResourceTemplate(resources[i], uid)
+		resources[i] = rts[i].applyItem(resources[i])
 	}
-	return resources
+	return resources, nil
 }
 
 // event represents a HTTP event that Triggers processes
@@ -80,6 +117,9 @@ type event struct {
 	Body       interface{}            `json:"body"`
 	Extensions map[string]interface{} `json:"extensions"`
 	Context    TriggerContext         `json:"context"`
+	// CloudEvent holds the attributes of a CloudEvent in binary mode, which
+	// the EventListener also converts structured and batched CloudEvents to.
+	CloudEvent map[string]string `json:"ce"`
 }
 
 // newEvent returns a new Event from HTTP headers and body
@@ -100,14 +140,31 @@ func newEvent(body []byte, headers http.Header, extensions map[string]interface{
 		Body:       data,
 		Extensions: extensions,
 		Context:    triggerContext,
+		CloudEvent: cloudEventAttributes(headers),
 	}, nil
 }
 
+// cloudEventAttributes returns the attributes of a CloudEvent in binary mode
+// from the ce- headers, and its content type.
+func cloudEventAttributes(headers http.Header) map[string]string {
+	attributes := map[string]string{}
+	for k, v := range headers {
+		if len(k) > len(cloudEventHeaderPrefix) && strings.EqualFold(k[:len(cloudEventHeaderPrefix)], cloudEventHeaderPrefix) {
+			attributes[strings.ToLower(k[len(cloudEventHeaderPrefix):])] = strings.Join(v, ",")
+		}
+	}
+	if _, ok := attributes["specversion"]; ok && headers.Get("Content-Type") != "" {
+		attributes["datacontenttype"] = headers.Get("Content-Type")
+	}
+	return attributes
+}
+
 // applyEventValuesToParams returns a slice of Params with the JSONPath variables replaced
-// with values from the event body, headers, and extensions.
+// with values from the event body, headers, and extensions. Strings are
+// replaced with the contents of their JSON string if escape is true.
 func applyEventValuesToParams(params []triggersv1.Param, body []byte, header http.Header, extensions map[string]interface{},
 	defaults []triggersv1.ParamSpec,
-	triggerContext TriggerContext) ([]triggersv1.Param, error) {
+	triggerContext TriggerContext, escape bool) ([]triggersv1.Param, error) {
 	event, err := newEvent(body, header, extensions, triggerContext)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal event: %w", err)
@@ -120,12 +177,16 @@ func applyEventValuesToParams(params []triggersv1.Param, body []byte, header htt
 		}
 	}
 
+	parse := parseJSONPath
+	if !escape {
+		parse = parseJSONPathValue
+	}
 	for _, p := range params {
 		pValue := p.Value
 		// Find all expressions wrapped in $() from the value
 		expressions, originals := findTektonExpressions(pValue)
 		for i, expr := range expressions {
-			val, err := parseJSONPath(event, expr)
+			val, err := parse(event, expr)
 			if defaults != nil && err != nil {
 				// if the header or body was not supplied or was malformed, go with a default if it exists
 				v, ok := allParamsMap[p.Name]
@@ -141,5 +202,15 @@ func applyEventValuesToParams(params []triggersv1.Param, body []byte, header htt
 		}
 		allParamsMap[p.Name] = pValue
 	}
+
+	for _, paramSpec := range defaults {
+		v, ok := allParamsMap[paramSpec.Name]
+		if !ok {
+			continue
+		}
+		if err := triggersv1.ValidateParamValue(paramSpec.GetType(), v); err != nil {
+			return nil, fmt.Errorf("invalid value for %s param %s: %w", paramSpec.GetType(), paramSpec.Name, err)
+		}
+	}
 	return convertParamMapToArray(allParamsMap), nil
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
 	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
 	"github.com/tektoncd/triggers/test"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -130,7 +131,7 @@ func TestApplyEventValuesMergeInDefaultParams(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := applyEventValuesToParams(tt.args.params, nil, nil, nil, tt.args.paramSpecs, context)
+			got, err := applyEventValuesToParams(tt.args.params, nil, nil, nil, tt.args.paramSpecs, context, true)
 			if err != nil {
 				t.Errorf("applyEventValuesToParams(): unexpected error: %s", err.Error())
 			}
@@ -302,11 +303,32 @@ func TestApplyEventValuesToParams(t *testing.T) {
 		},
 		params: []triggersv1.Param{{Name: "a", Value: "$(extensions.foo)"}},
 		want:   []triggersv1.Param{{Name: "a", Value: `[{"a":"1"},{"b":"2"}]`}},
+	}, {
+		name: "CloudEvent attributes",
+		header: map[string][]string{
+			"Ce-Specversion": {"1.0"},
+			"Ce-Type":        {"dev.tekton.push"},
+			"Ce-Source":      {"/repos/triggers"},
+			"Ce-Myextension": {"value"},
+			"Content-Type":   {"application/json"},
+		},
+		params: []triggersv1.Param{
+			{Name: "type", Value: "$(ce.type)"},
+			{Name: "source", Value: "$(ce.source)"},
+			{Name: "extension", Value: "$(ce.myextension)"},
+			{Name: "contenttype", Value: "$(ce.datacontenttype)"},
+		},
+		want: []triggersv1.Param{
+			{Name: "type", Value: "dev.tekton.push"},
+			{Name: "source", Value: "/repos/triggers"},
+			{Name: "extension", Value: "value"},
+			{Name: "contenttype", Value: "application/json"},
+		},
 	}}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := applyEventValuesToParams(tt.params, tt.body, tt.header, tt.extensions, nil, context)
+			got, err := applyEventValuesToParams(tt.params, tt.body, tt.header, tt.extensions, nil, context, true)
 			if err != nil {
 				t.Errorf("unexpected error: %v", err)
 			}
@@ -350,7 +372,7 @@ func TestApplyEventValuesToParams_Error(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := applyEventValuesToParams(tt.params, tt.body, tt.header, tt.extensions, nil, context)
+			got, err := applyEventValuesToParams(tt.params, tt.body, tt.header, tt.extensions, nil, context, true)
 			if err == nil {
 				t.Errorf("did not get expected error - got: %v", got)
 			}
@@ -358,6 +380,65 @@ func TestApplyEventValuesToParams_Error(t *testing.T) {
 	}
 }
 
+func TestApplyEventValuesToParams_TypedParams(t *testing.T) {
+	body := json.RawMessage(`{"files": ["a.go", "b.go"], "labels": {"app": "foo"}, "commits": [{"id": "1"}]}`)
+	tests := []struct {
+		name    string
+		params  []triggersv1.Param
+		specs   []triggersv1.ParamSpec
+		want    []triggersv1.Param
+		wantErr bool
+	}{{
+		name:   "array param from body",
+		params: []triggersv1.Param{{Name: "files", Value: "$(body.files)"}},
+		specs:  []triggersv1.ParamSpec{{Name: "files", Type: triggersv1.ParamTypeArray}},
+		want:   []triggersv1.Param{{Name: "files", Value: `["a.go","b.go"]`}},
+	}, {
+		name:   "object param from body",
+		params: []triggersv1.Param{{Name: "labels", Value: "$(body.labels)"}},
+		specs:  []triggersv1.ParamSpec{{Name: "labels", Type: triggersv1.ParamTypeObject}},
+		want:   []triggersv1.Param{{Name: "labels", Value: `{"app":"foo"}`}},
+	}, {
+		name:   "array param default",
+		params: []triggersv1.Param{},
+		specs:  []triggersv1.ParamSpec{{Name: "files", Type: triggersv1.ParamTypeArray, Default: ptr.String(`["c.go"]`)}},
+		want:   []triggersv1.Param{{Name: "files", Value: `["c.go"]`}},
+	}, {
+		name:    "string value for array param",
+		params:  []triggersv1.Param{{Name: "files", Value: "$(body.labels.app)"}},
+		specs:   []triggersv1.ParamSpec{{Name: "files", Type: triggersv1.ParamTypeArray}},
+		wantErr: true,
+	}, {
+		name:    "array value for object param",
+		params:  []triggersv1.Param{{Name: "labels", Value: "$(body.files)"}},
+		specs:   []triggersv1.ParamSpec{{Name: "labels", Type: triggersv1.ParamTypeObject}},
+		wantErr: true,
+	}, {
+		name:    "array of objects for array param",
+		params:  []triggersv1.Param{{Name: "files", Value: "$(body.commits)"}},
+		specs:   []triggersv1.ParamSpec{{Name: "files", Type: triggersv1.ParamTypeArray}},
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyEventValuesToParams(tt.params, body, nil, nil, tt.specs, NewTriggerContext("1234"), true)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("did not get expected error - got: %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyEventValuesToParams() returned unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("applyEventValuesToParams(): -want +got: %s", diff)
+			}
+		})
+	}
+}
+
 func TestResolveParams(t *testing.T) {
 	eventID := "1234567"
 
@@ -468,6 +549,24 @@ func TestResolveParams(t *testing.T) {
 			{Name: "param2", Value: "bar\\r\\nbaz"},
 			{Name: "event1", Value: "1234567"},
 		},
+	}, {
+		name: "values are not escaped for the structured engine",
+		body: json.RawMessage(`{"foo": "say \"hi\"\r\n", "path": "C:\\new"}`),
+		template: &triggersv1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "tt-name",
+				Namespace:   ns,
+				Annotations: map[string]string{triggers.TemplateEngineAnnotation: triggers.StructuredTemplateEngine},
+			},
+		},
+		bindingParams: []triggersv1.Param{
+			{Name: "param1", Value: "$(body.foo)"},
+			{Name: "param2", Value: "$(body.path) and D:\\new"},
+		},
+		want: []triggersv1.Param{
+			{Name: "param1", Value: "say \"hi\"\r\n"},
+			{Name: "param2", Value: `C:\new and D:\new`},
+		},
 	}}
 
 	for _, tt := range tests {
@@ -642,6 +741,36 @@ func TestResolveResources(t *testing.T) {
 			json.RawMessage(`{"rt1": "31313131-3131-4131-b131-313131313131"}`),
 			json.RawMessage(`{"rt2": "31313131-3131-4131-b131-313131313131"}`),
 		},
+	}, {
+		name: "array and object params are inserted as JSON values",
+		template: &triggersv1.TriggerTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "tt",
+				Namespace: ns,
+			},
+			Spec: triggersv1.TriggerTemplateSpec{
+				Params: []triggersv1.ParamSpec{{
+					Name: "files",
+					Type: triggersv1.ParamTypeArray,
+				}, {
+					Name: "labels",
+					Type: triggersv1.ParamTypeObject,
+				}, {
+					Name: "name",
+				}},
+				ResourceTemplates: []triggersv1.TriggerResourceTemplate{{
+					RawExtension: runtime.RawExtension{Raw: []byte(`{"name": "$(tt.params.name)", "files": "$(tt.params.files)", "labels": "$(tt.params.labels)", "msg": "changed $(tt.params.files)"}`)},
+				}},
+			},
+		},
+		params: []triggersv1.Param{
+			{Name: "files", Value: `["a.go", "b.go"]`},
+			{Name: "labels", Value: `{"app": "foo"}`},
+			{Name: "name", Value: "bar"},
+		},
+		want: []json.RawMessage{
+			json.RawMessage(`{"name": "bar", "files": ["a.go","b.go"], "labels": {"app":"foo"}, "msg": "changed [\"a.go\",\"b.go\"]"}`),
+		},
 	}}
 
 	for _, tt := range tests {
@@ -650,7 +779,10 @@ func TestResolveResources(t *testing.T) {
 			reader := bytes.NewReader([]byte("1111111111111111"))
 			uuid.SetRand(reader)
 			uuid.SetClockSequence(1)
-			got := ResolveResources(addOldEscape(tt.template), tt.params)
+			got, err := ResolveResources(addOldEscape(tt.template), tt.params)
+			if err != nil {
+				t.Fatalf("ResolveResources() returned unexpected error: %s", err)
+			}
 			// Use toString so that it is easy to compare the json.RawMessage diffs
 			if diff := cmp.Diff(toString(tt.want), toString(got)); diff != "" {
 				t.Errorf("didn't get expected resource template -want + got: %s", diff)
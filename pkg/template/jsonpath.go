@@ -43,6 +43,16 @@ var (
 // parseJSONPath extracts a subset of the given JSON input
 // using the provided JSONPath expression.
 func parseJSONPath(input interface{}, expr string) (string, error) {
+	return evalJSONPath(input, expr, true)
+}
+
+// parseJSONPathValue is parseJSONPath, except that a single string result is
+// returned as is rather than as the contents of a JSON string.
+func parseJSONPathValue(input interface{}, expr string) (string, error) {
+	return evalJSONPath(input, expr, false)
+}
+
+func evalJSONPath(input interface{}, expr string, escape bool) (string, error) {
 	j := jsonpath.New("").AllowMissingKeys(false)
 	buf := new(bytes.Buffer)
 
@@ -62,7 +72,7 @@ func parseJSONPath(input interface{}, expr string) (string, error) {
 	}
 
 	for _, r := range fullResults {
-		if err := printResults(buf, r); err != nil {
+		if err := printResults(buf, r, escape); err != nil {
 			return "", err
 		}
 	}
@@ -71,8 +81,8 @@ func parseJSONPath(input interface{}, expr string) (string, error) {
 }
 
 // PrintResults writes the results into writer
-func printResults(wr io.Writer, values []reflect.Value) error {
-	results, err := getResults(values)
+func printResults(wr io.Writer, values []reflect.Value, escape bool) error {
+	results, err := getResults(values, escape)
 	if err != nil {
 		return fmt.Errorf("error getting values for jsonpath results: %w", err)
 	}
@@ -83,13 +93,15 @@ func printResults(wr io.Writer, values []reflect.Value) error {
 	return nil
 }
 
-func getResults(values []reflect.Value) ([]byte, error) {
+func getResults(values []reflect.Value, escape bool) ([]byte, error) {
 	if len(values) == 1 {
 		v := values[0]
 		t := reflect.TypeOf(v.Interface())
 		switch {
 		case t == nil:
 			return []byte("null"), nil
+		case t.Kind() == reflect.String && !escape:
+			return []byte(reflect.ValueOf(v.Interface()).String()), nil
 		case t.Kind() == reflect.String:
 			b, err := json.Marshal(v.Interface())
 			if err != nil {
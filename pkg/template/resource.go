@@ -85,14 +85,14 @@ func resolveBindingsToParams(bindings []*triggersv1.TriggerSpecBinding, getTB ge
 		case b.Ref != "" && b.Kind == triggersv1.ClusterTriggerBindingKind:
 			ctb, err := getCTB(b.Ref)
 			if err != nil {
-				return nil, fmt.Errorf("error getting ClusterTriggerBinding %s: %w", b.Name, err)
+				return nil, fmt.Errorf("error getting ClusterTriggerBinding %s: %w", b.Ref, err)
 			}
 			bindingParams = append(bindingParams, ctb.Spec.Params...)
 
 		case b.Ref != "": // if no kind is set, assume NamespacedTriggerBinding
 			tb, err := getTB(b.Ref)
 			if err != nil {
-				return nil, fmt.Errorf("error getting TriggerBinding %s: %w", b.Name, err)
+				return nil, fmt.Errorf("error getting TriggerBinding %s: %w", b.Ref, err)
 			}
 			bindingParams = append(bindingParams, tb.Spec.Params...)
 		default:
@@ -147,6 +147,100 @@ func applyParamToResourceTemplate(param triggersv1.Param, rt json.RawMessage, ol
 	return bytes.ReplaceAll(rt, []byte(paramVariable), []byte(paramValue))
 }
 
+// applyTypedParamsToResourceTemplate returns the TriggerResourceTemplate with the
+// JSON values of the array and object params substituted for all matching param
+// variables in the template.
+func applyTypedParamsToResourceTemplate(params []triggersv1.Param, rt json.RawMessage) (json.RawMessage, error) {
+	for _, param := range params {
+		var err error
+		rt, err = applyTypedParamToResourceTemplate(param, rt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rt, nil
+}
+
+// applyTypedParamToResourceTemplate substitutes the JSON value of an array or
+// object param into the TriggerResourceTemplate.
+//
+// A param variable that makes up a whole JSON string value e.g.
+// "value": "$(tt.params.files)" is replaced by the JSON value itself, so that
+// the array or object is inserted as a JSON array or object. A param variable
+// embedded in a larger string is replaced by the JSON encoded value, escaped so
+// that the string remains valid JSON.
+func applyTypedParamToResourceTemplate(param triggersv1.Param, rt json.RawMessage) (json.RawMessage, error) {
+	paramVariable := []byte(fmt.Sprintf("$(tt.params.%s)", param.Name))
+	value := new(bytes.Buffer)
+	if err := json.Compact(value, []byte(param.Value)); err != nil {
+		return nil, fmt.Errorf("invalid JSON value for param %s: %w", param.Name, err)
+	}
+	jsonValue := []byte(escapeTektonVariables(value.String()))
+	stringValue, err := encodeJSONString(string(jsonValue))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value for param %s: %w", param.Name, err)
+	}
+
+	quoted := append(append([]byte{'"'}, paramVariable...), '"')
+	out := make([]byte, 0, len(rt))
+	for {
+		i := bytes.Index(rt, quoted)
+		if i < 0 {
+			break
+		}
+		end := i + len(quoted)
+		if isObjectKey(rt[end:]) {
+			// Keys must remain strings, so fall back to string substitution.
+			out = append(out, rt[:end]...)
+		} else {
+			out = append(out, rt[:i]...)
+			out = append(out, jsonValue...)
+		}
+		rt = rt[end:]
+	}
+	out = append(out, rt...)
+	return bytes.ReplaceAll(out, paramVariable, stringValue), nil
+}
+
+// isObjectKey returns true if the remainder of a JSON document following a
+// string indicates that the string was an object key.
+func isObjectKey(rest []byte) bool {
+	rest = bytes.TrimLeft(rest, " \t\r\n")
+	return len(rest) > 0 && rest[0] == ':'
+}
+
+// encodeJSONString returns s encoded as the contents of a JSON string, without
+// the surrounding quotes.
+func encodeJSONString(s string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	b := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	return b[1 : len(b)-1], nil
+}
+
+// splitParamsByType splits params into string params and the typed (array and
+// object) params declared by the ParamSpecs.
+func splitParamsByType(specs []triggersv1.ParamSpec, params []triggersv1.Param) ([]triggersv1.Param, []triggersv1.Param) {
+	types := make(map[string]triggersv1.ParamType, len(specs))
+	for _, ps := range specs {
+		types[ps.Name] = ps.GetType()
+	}
+	stringParams := []triggersv1.Param{}
+	typedParams := []triggersv1.Param{}
+	for _, p := range params {
+		if t, ok := types[p.Name]; ok && t != triggersv1.ParamTypeString {
+			typedParams = append(typedParams, p)
+			continue
+		}
+		stringParams = append(stringParams, p)
+	}
+	return stringParams, typedParams
+}
+
 // UUID generates a Universally Unique IDentifier following RFC 4122.
 var UUID = func() string { return uuid.New().String() }
 
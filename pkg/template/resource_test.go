@@ -264,6 +264,58 @@ func Test_applyParamToResourceTemplate(t *testing.T) {
 	}
 }
 
+func Test_applyTypedParamToResourceTemplate(t *testing.T) {
+	tests := []struct {
+		name  string
+		param triggersv1.Param
+		rt    json.RawMessage
+		want  json.RawMessage
+	}{{
+		name:  "array as whole value",
+		param: triggersv1.Param{Name: "files", Value: `[ "a.go", "b.go" ]`},
+		rt:    json.RawMessage(`{"value": "$(tt.params.files)"}`),
+		want:  json.RawMessage(`{"value": ["a.go","b.go"]}`),
+	}, {
+		name:  "object as whole value",
+		param: triggersv1.Param{Name: "labels", Value: `{"app": "foo"}`},
+		rt:    json.RawMessage(`{"value": "$(tt.params.labels)"}`),
+		want:  json.RawMessage(`{"value": {"app":"foo"}}`),
+	}, {
+		name:  "array embedded in string",
+		param: triggersv1.Param{Name: "files", Value: `["a.go"]`},
+		rt:    json.RawMessage(`{"value": "files: $(tt.params.files)"}`),
+		want:  json.RawMessage(`{"value": "files: [\"a.go\"]"}`),
+	}, {
+		name:  "param used as object key",
+		param: triggersv1.Param{Name: "files", Value: `["a.go"]`},
+		rt:    json.RawMessage(`{"$(tt.params.files)" : "$(tt.params.files)"}`),
+		want:  json.RawMessage(`{"[\"a.go\"]" : ["a.go"]}`),
+	}, {
+		name:  "tekton variables in values are escaped",
+		param: triggersv1.Param{Name: "args", Value: `["$(params.foo)", "<b>"]`},
+		rt:    json.RawMessage(`{"value": "$(tt.params.args)"}`),
+		want:  json.RawMessage(`{"value": ["$$(params.foo)","<b>"]}`),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyTypedParamToResourceTemplate(tt.param, tt.rt)
+			if err != nil {
+				t.Fatalf("applyTypedParamToResourceTemplate() returned unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(string(tt.want), string(got)); diff != "" {
+				t.Errorf("applyTypedParamToResourceTemplate(): -want +got: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_applyTypedParamToResourceTemplate_Error(t *testing.T) {
+	param := triggersv1.Param{Name: "files", Value: `["a.go"`}
+	if _, err := applyTypedParamToResourceTemplate(param, json.RawMessage(`{"value": "$(tt.params.files)"}`)); err == nil {
+		t.Error("applyTypedParamToResourceTemplate() did not return expected error for invalid JSON value")
+	}
+}
+
 func Test_ApplyParamsToResourceTemplate(t *testing.T) {
 	rt := json.RawMessage(`{"oneparam": "$(tt.params.oneid)", "twoparam": "$(tt.params.twoid)", "threeparam": "$(tt.params.threeid)"`)
 	rt3 := json.RawMessage(`{"actualParam": "$(tt.params.oneid)", "invalidParam": "$(tt.params1.invalidid)", "deprecatedParam": "$(params.twoid)"`)
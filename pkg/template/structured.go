@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+)
+
+// paramRefRegexp captures TriggerTemplate parameter references $(tt.params.NAME)
+var paramRefRegexp = regexp.MustCompile(`\$\(tt\.params\.([_a-zA-Z][_a-zA-Z0-9.-]*)\)`)
+
+// usesStructuredEngine returns true if the TriggerTemplate opted in to the
+// structured templating engine.
+func usesStructuredEngine(template *triggersv1.TriggerTemplate) bool {
+	return template.GetAnnotations()[triggers.TemplateEngineAnnotation] == triggers.StructuredTemplateEngine
+}
+
+// structuredParam is a param value prepared for substitution by the
+// structured engine.
+type structuredParam struct {
+	// str is the value substituted when the param is embedded in a string.
+	str string
+	// value is the value substituted when the param makes up a whole string.
+	value interface{}
+}
+
+// structuredEngine substitutes params into parsed resource templates.
+//
+// Unlike the legacy engine, which replaces the raw bytes of the template,
+// params are only substituted in string values and object keys, and the
+// result is encoded as JSON so that values never need to be escaped.
+type structuredEngine struct {
+	params map[string]structuredParam
+	uid    string
+}
+
+func newStructuredEngine(specs []triggersv1.ParamSpec, params []triggersv1.Param, uid string) (*structuredEngine, error) {
+	types := make(map[string]triggersv1.ParamType, len(specs))
+	for _, ps := range specs {
+		types[ps.Name] = ps.GetType()
+	}
+	e := &structuredEngine{params: make(map[string]structuredParam, len(params)), uid: uid}
+	for _, p := range params {
+		t, ok := types[p.Name]
+		if !ok || t == triggersv1.ParamTypeString {
+			e.params[p.Name] = structuredParam{str: escapeTektonVariables(p.Value), value: escapeTektonVariables(p.Value)}
+			continue
+		}
+		var v interface{}
+		d := json.NewDecoder(strings.NewReader(p.Value))
+		d.UseNumber()
+		if err := d.Decode(&v); err != nil {
+			return nil, fmt.Errorf("invalid JSON value for param %s: %w", p.Name, err)
+		}
+		compact := new(bytes.Buffer)
+		if err := json.Compact(compact, []byte(p.Value)); err != nil {
+			return nil, fmt.Errorf("invalid JSON value for param %s: %w", p.Name, err)
+		}
+		e.params[p.Name] = structuredParam{str: escapeTektonVariables(compact.String()), value: escapeValue(v)}
+	}
+	return e, nil
+}
+
+// escapeValue escapes Tekton variables in all of the strings within a JSON value.
+func escapeValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return escapeTektonVariables(t)
+	case []interface{}:
+		for i := range t {
+			t[i] = escapeValue(t[i])
+		}
+	case map[string]interface{}:
+		for k, val := range t {
+			t[k] = escapeValue(val)
+		}
+	}
+	return v
+}
+
+// resolve returns the resource template with all params and the uid
+// substituted. References to params without a value are returned as an error.
+func (e *structuredEngine) resolve(rt json.RawMessage) (json.RawMessage, error) {
+	var tree interface{}
+	d := json.NewDecoder(bytes.NewReader(rt))
+	d.UseNumber()
+	if err := d.Decode(&tree); err != nil {
+		return nil, fmt.Errorf("failed to parse resource template: %w", err)
+	}
+	unresolved := map[string]bool{}
+	tree = e.walk(tree, unresolved)
+	if len(unresolved) > 0 {
+		names := make([]string, 0, len(unresolved))
+		for n := range unresolved {
+			names = append(names, fmt.Sprintf("$(tt.params.%s)", n))
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unresolved param references: %s", strings.Join(names, ", "))
+	}
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(tree); err != nil {
+		return nil, fmt.Errorf("failed to encode resource template: %w", err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func (e *structuredEngine) walk(v interface{}, unresolved map[string]bool) interface{} {
+	switch t := v.(type) {
+	case string:
+		return e.substitute(t, unresolved)
+	case []interface{}:
+		for i := range t {
+			t[i] = e.walk(t[i], unresolved)
+		}
+		return t
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[e.substituteString(k, unresolved)] = e.walk(val, unresolved)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// substitute replaces the params in a string value. A string that consists of
+// a single param reference is replaced by the value of the param, which is a
+// JSON array or object for typed params.
+func (e *structuredEngine) substitute(s string, unresolved map[string]bool) interface{} {
+	if m := paramRefRegexp.FindStringSubmatch(s); m != nil && m[0] == s {
+		p, ok := e.params[m[1]]
+		if !ok {
+			unresolved[m[1]] = true
+			return s
+		}
+		return p.value
+	}
+	return e.substituteString(s, unresolved)
+}
+
+// substituteString replaces the uid and the params embedded in a string.
+func (e *structuredEngine) substituteString(s string, unresolved map[string]bool) string {
+	// Replace the uid first so that escaped param values are left untouched.
+	s = strings.ReplaceAll(s, string(uidMatch), e.uid)
+	return paramRefRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+		name := paramRefRegexp.FindStringSubmatch(ref)[1]
+		p, ok := e.params[name]
+		if !ok {
+			unresolved[name] = true
+			return ref
+		}
+		return p.str
+	})
+}
+
+// resolveStructuredResources resolves resource templates using the structured
+// engine.
+func resolveStructuredResources(specs []triggersv1.ParamSpec, rts []resourceTemplate, params []triggersv1.Param, uid string) ([]json.RawMessage, error) {
+	e, err := newStructuredEngine(specs, params, uid)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]json.RawMessage, len(rts))
+	var errs []error
+	for i := range rts {
+		resources[i], err = e.resolve(rts[i].raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resource template %d: %w", i, err))
+			continue
+		}
+		resources[i] = rts[i].applyItem(resources[i])
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return resources, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Tekton Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/tektoncd/triggers/pkg/apis/triggers"
+	triggersv1 "github.com/tektoncd/triggers/pkg/apis/triggers/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func structuredTemplate(params []triggersv1.ParamSpec, rts ...string) *triggersv1.TriggerTemplate {
+	tt := &triggersv1.TriggerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tt",
+			Namespace:   ns,
+			Annotations: map[string]string{triggers.TemplateEngineAnnotation: triggers.StructuredTemplateEngine},
+		},
+		Spec: triggersv1.TriggerTemplateSpec{Params: params},
+	}
+	for _, rt := range rts {
+		tt.Spec.ResourceTemplates = append(tt.Spec.ResourceTemplates, triggersv1.TriggerResourceTemplate{
+			RawExtension: runtime.RawExtension{Raw: []byte(rt)},
+		})
+	}
+	return tt
+}
+
+func TestResolveResources_Structured(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *triggersv1.TriggerTemplate
+		params   []triggersv1.Param
+		want     []json.RawMessage
+	}{{
+		name: "string params in values and keys",
+		template: structuredTemplate([]triggersv1.ParamSpec{{Name: "p1"}, {Name: "p2"}},
+			`{"rt1": "$(tt.params.p1)-$(tt.params.p2)", "$(tt.params.p1)": 1}`),
+		params: []triggersv1.Param{{Name: "p1", Value: "val1"}, {Name: "p2", Value: "42"}},
+		want:   []json.RawMessage{json.RawMessage(`{"rt1":"val1-42","val1":1}`)},
+	}, {
+		name:     "quotes and backslashes in values are encoded",
+		template: structuredTemplate([]triggersv1.ParamSpec{{Name: "p1"}, {Name: "p2"}}, `{"rt1": "$(tt.params.p1)", "rt2": "$(tt.params.p2)"}`),
+		params: []triggersv1.Param{
+			{Name: "p1", Value: `say "hi"`},
+			// Backslashes are not escapes
+			{Name: "p2", Value: `C:\new\path`},
+		},
+		want: []json.RawMessage{json.RawMessage(`{"rt1":"say \"hi\"","rt2":"C:\\new\\path"}`)},
+	}, {
+		name:     "JSON objects in string params are inserted as strings",
+		template: structuredTemplate([]triggersv1.ParamSpec{{Name: "p1"}}, `{"rt1": "$(tt.params.p1)"}`),
+		params:   []triggersv1.Param{{Name: "p1", Value: `{"a": "b"}`}},
+		want:     []json.RawMessage{json.RawMessage(`{"rt1":"{\"a\": \"b\"}"}`)},
+	}, {
+		name: "typed params replace whole values",
+		template: structuredTemplate([]triggersv1.ParamSpec{
+			{Name: "files", Type: triggersv1.ParamTypeArray},
+			{Name: "labels", Type: triggersv1.ParamTypeObject},
+		}, `{"files": "$(tt.params.files)", "labels": "$(tt.params.labels)", "msg": "changed $(tt.params.files)"}`),
+		params: []triggersv1.Param{{Name: "files", Value: `["a.go", "$(b)"]`}, {Name: "labels", Value: `{"app": "foo"}`}},
+		want:   []json.RawMessage{json.RawMessage(`{"files":["a.go","$$(b)"],"labels":{"app":"foo"},"msg":"changed [\"a.go\",\"$$(b)\"]"}`)},
+	}, {
+		name:     "tekton variables in params are escaped",
+		template: structuredTemplate([]triggersv1.ParamSpec{{Name: "p1"}}, `{"rt1": "$(tt.params.p1)", "rt2": "$(params.foo)"}`),
+		params:   []triggersv1.Param{{Name: "p1", Value: "$(tasks.a.results.b) $(uid)"}},
+		want:     []json.RawMessage{json.RawMessage(`{"rt1":"$$(tasks.a.results.b) $$(uid)","rt2":"$(params.foo)"}`)},
+	}, {
+		name:     "uid and numbers",
+		template: structuredTemplate(nil, `{"rt1": "$(uid)", "n": 12345678901234567890, "f": 1.5}`, `{"rt2": "run-$(uid)"}`),
+		want: []json.RawMessage{
+			json.RawMessage(`{"f":1.5,"n":12345678901234567890,"rt1":"31313131-3131-4131-b131-313131313131"}`),
+			json.RawMessage(`{"rt2":"run-31313131-3131-4131-b131-313131313131"}`),
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Seeded for UUID() to return "31313131-3131-4131-b131-313131313131"
+			uuid.SetRand(bytes.NewReader([]byte("1111111111111111")))
+			uuid.SetClockSequence(1)
+			got, err := ResolveResources(tt.template, tt.params)
+			if err != nil {
+				t.Fatalf("ResolveResources() returned unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(toString(tt.want), toString(got)); diff != "" {
+				t.Errorf("didn't get expected resource template -want + got: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveResources_StructuredError(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *triggersv1.TriggerTemplate
+		params   []triggersv1.Param
+		wantErr  string
+	}{{
+		name:     "unresolved params",
+		template: structuredTemplate([]triggersv1.ParamSpec{{Name: "p1"}, {Name: "p2"}}, `{"rt1": "$(tt.params.p2)", "rt2": "a-$(tt.params.p1)"}`),
+		wantErr:  "resource template 0: unresolved param references: $(tt.params.p1), $(tt.params.p2)",
+	}, {
+		name:     "invalid resource template",
+		template: structuredTemplate(nil, `{"rt1": `),
+		wantErr:  "resource template 0: failed to parse resource template",
+	}, {
+		name:     "invalid typed param value",
+		template: structuredTemplate([]triggersv1.ParamSpec{{Name: "p1", Type: triggersv1.ParamTypeArray}}, `{"rt1": "$(tt.params.p1)"}`),
+		params:   []triggersv1.Param{{Name: "p1", Value: `["a"`}},
+		wantErr:  "invalid JSON value for param p1",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuid.SetRand(nil)
+			_, err := ResolveResources(tt.template, tt.params)
+			if err == nil {
+				t.Fatal("ResolveResources() did not return an error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ResolveResources() error = %q, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 	faketriggersclientset "github.com/tektoncd/triggers/pkg/client/clientset/versioned/fake"
 	faketriggersclient "github.com/tektoncd/triggers/pkg/client/injection/client/fake"
 	fakeClusterInterceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/clusterinterceptor/fake"
+	fakeeventlistenerpolicyinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/eventlistenerpolicy/fake"
 	fakeInterceptorinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1alpha1/interceptor/fake"
 	fakeclustertriggerbindinginformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/clustertriggerbinding/fake"
 	fakeeventlistenerinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/eventlistener/fake"
@@ -38,30 +39,40 @@ import (
 	faketriggertemplateinformer "github.com/tektoncd/triggers/pkg/client/injection/informers/triggers/v1beta1/triggertemplate/fake"
 	"github.com/tektoncd/triggers/pkg/reconciler/eventlistener/resources"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	fakedynamic "k8s.io/client-go/dynamic/fake"
 	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	ktesting "k8s.io/client-go/testing"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	duckinformerfake "knative.dev/pkg/client/injection/ducks/duck/v1/podspecable/fake"
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	fakefiltereddeployinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment/filtered/fake"
+	fakefilteredhpainformer "knative.dev/pkg/client/injection/kube/informers/autoscaling/v2/horizontalpodautoscaler/filtered/fake"
 	fakepodinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/fake"
 	fakesecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/fake"
+	fakefilteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered/fake"
 	fakefilteredserviceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/service/filtered/fake"
 	fakeserviceaccountinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/fake"
 	filteredinformerfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	fakefilteredingressinformer "knative.dev/pkg/client/injection/kube/informers/networking/v1/ingress/filtered/fake"
+	fakefilteredpdbinformer "knative.dev/pkg/client/injection/kube/informers/policy/v1/poddisruptionbudget/filtered/fake"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
 	fakedynamicclientset "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	rtesting "knative.dev/pkg/reconciler/testing"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	// Import for creating fake filtered factory in the test
 	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
@@ -70,20 +81,26 @@ import (
 // Resources represents the desired state of the system (i.e. existing resources)
 // to seed controllers with.
 type Resources struct {
-	Namespaces             []*corev1.Namespace
-	ClusterTriggerBindings []*v1beta1.ClusterTriggerBinding
-	EventListeners         []*v1beta1.EventListener
-	ClusterInterceptors    []*v1alpha1.ClusterInterceptor
-	Interceptors           []*v1alpha1.Interceptor
-	TriggerBindings        []*v1beta1.TriggerBinding
-	TriggerTemplates       []*v1beta1.TriggerTemplate
-	Triggers               []*v1beta1.Trigger
-	Deployments            []*appsv1.Deployment
-	Services               []*corev1.Service
-	Secrets                []*corev1.Secret
-	ServiceAccounts        []*corev1.ServiceAccount
-	Pods                   []*corev1.Pod
-	WithPod                []*duckv1.WithPod
+	Namespaces               []*corev1.Namespace
+	ClusterTriggerBindings   []*v1beta1.ClusterTriggerBinding
+	EventListeners           []*v1beta1.EventListener
+	EventListenerPolicies    []*v1alpha1.EventListenerPolicy
+	ClusterInterceptors      []*v1alpha1.ClusterInterceptor
+	Interceptors             []*v1alpha1.Interceptor
+	TriggerBindings          []*v1beta1.TriggerBinding
+	TriggerTemplates         []*v1beta1.TriggerTemplate
+	Triggers                 []*v1beta1.Trigger
+	Deployments              []*appsv1.Deployment
+	Services                 []*corev1.Service
+	HorizontalPodAutoscalers []*autoscalingv2.HorizontalPodAutoscaler
+	PodDisruptionBudgets     []*policyv1.PodDisruptionBudget
+	Ingresses                []*networkingv1.Ingress
+	HTTPRoutes               []*gatewayv1.HTTPRoute
+	Gateways                 []*gatewayv1.Gateway
+	Secrets                  []*corev1.Secret
+	ServiceAccounts          []*corev1.ServiceAccount
+	Pods                     []*corev1.Pod
+	WithPod                  []*duckv1.WithPod
 }
 
 // Clients holds references to clients which are useful for reconciler tests.
@@ -108,6 +125,9 @@ func init() {
 		if err != nil {
 			panic(err.Error())
 		}
+		if err := gatewayv1.Install(scheme); err != nil {
+			panic(err.Error())
+		}
 		ctx, _ = fakedynamicclientset.With(ctx, scheme)
 		return ctx
 	})
@@ -138,13 +158,18 @@ func SeedResources(t *testing.T, ctx context.Context, r Resources) Clients {
 	ctbInformer := fakeclustertriggerbindinginformer.Get(ctx)
 	elInformer := fakeeventlistenerinformer.Get(ctx)
 	icInformer := fakeClusterInterceptorinformer.Get(ctx)
+	elpInformer := fakeeventlistenerpolicyinformer.Get(ctx)
 	nsicInformer := fakeInterceptorinformer.Get(ctx)
 	ttInformer := faketriggertemplateinformer.Get(ctx)
 	tbInformer := faketriggerbindinginformer.Get(ctx)
 	trInformer := faketriggerinformer.Get(ctx)
 	deployInformer := fakefiltereddeployinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
 	serviceInformer := fakefilteredserviceinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+	hpaInformer := fakefilteredhpainformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+	pdbInformer := fakefilteredpdbinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
+	ingressInformer := fakefilteredingressinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
 	secretInformer := fakesecretinformer.Get(ctx)
+	filteredSecretInformer := fakefilteredsecretinformer.Get(ctx, labels.FormatLabels(resources.DefaultStaticResourceLabels))
 	saInformer := fakeserviceaccountinformer.Get(ctx)
 	podInformer := fakepodinformer.Get(ctx)
 	duckInformerFactory := duckinformerfake.Get(ctx)
@@ -172,6 +197,14 @@ func SeedResources(t *testing.T, ctx context.Context, r Resources) Clients {
 			t.Fatal(err)
 		}
 	}
+	for _, elp := range r.EventListenerPolicies {
+		if err := elpInformer.Informer().GetIndexer().Add(elp); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Triggers.TriggersV1alpha1().EventListenerPolicies().Create(context.Background(), elp, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
 	for _, ic := range r.ClusterInterceptors {
 		if err := icInformer.Informer().GetIndexer().Add(ic); err != nil {
 			t.Fatal(err)
@@ -229,10 +262,43 @@ func SeedResources(t *testing.T, ctx context.Context, r Resources) Clients {
 			t.Fatal(err)
 		}
 	}
+	for _, hpa := range r.HorizontalPodAutoscalers {
+		if err := hpaInformer.Informer().GetIndexer().Add(hpa); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Kube.AutoscalingV2().HorizontalPodAutoscalers(hpa.Namespace).Create(context.Background(), hpa, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, pdb := range r.PodDisruptionBudgets {
+		if err := pdbInformer.Informer().GetIndexer().Add(pdb); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Kube.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create(context.Background(), pdb, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, ingress := range r.Ingresses {
+		if err := ingressInformer.Informer().GetIndexer().Add(ingress); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Kube.NetworkingV1().Ingresses(ingress.Namespace).Create(context.Background(), ingress, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, route := range r.HTTPRoutes {
+		createUnstructured(t, c.DynamicClient, gatewayv1.SchemeGroupVersion.WithResource("httproutes"), route)
+	}
+	for _, gateway := range r.Gateways {
+		createUnstructured(t, c.DynamicClient, gatewayv1.SchemeGroupVersion.WithResource("gateways"), gateway)
+	}
 	for _, s := range r.Secrets {
 		if err := secretInformer.Informer().GetIndexer().Add(s); err != nil {
 			t.Fatal(err)
 		}
+		if err := filteredSecretInformer.Informer().GetIndexer().Add(s); err != nil {
+			t.Fatal(err)
+		}
 		if _, err := c.Kube.CoreV1().Secrets(s.Namespace).Create(context.Background(), s, metav1.CreateOptions{}); err != nil {
 			t.Fatal(err)
 		}
@@ -278,6 +344,8 @@ func SeedResources(t *testing.T, ctx context.Context, r Resources) Clients {
 		}
 	}
 
+	c.DynamicClient.PrependReactor("patch", "*", applyReactor(c.DynamicClient))
+
 	c.Kube.ClearActions()
 	c.Triggers.ClearActions()
 	c.Pipeline.ClearActions()
@@ -285,6 +353,65 @@ func SeedResources(t *testing.T, ctx context.Context, r Resources) Clients {
 	return c
 }
 
+// applyReactor handles server-side apply patches of the dynamic client,
+// which its object tracker can't apply to unstructured objects. It merges
+// the applied configuration into the existing object, as server-side apply
+// does for the fields that aren't owned by other field managers.
+func applyReactor(client *fakedynamic.FakeDynamicClient) ktesting.ReactionFunc {
+	return func(action ktesting.Action) (bool, runtime.Object, error) {
+		patch, ok := action.(ktesting.PatchAction)
+		if !ok || patch.GetPatchType() != types.ApplyPatchType {
+			return false, nil, nil
+		}
+		existing, err := client.Tracker().Get(patch.GetResource(), patch.GetNamespace(), patch.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		existingData, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+		if err != nil {
+			return true, nil, err
+		}
+		applied := map[string]interface{}{}
+		if err := json.Unmarshal(patch.GetPatch(), &applied); err != nil {
+			return true, nil, err
+		}
+		obj := &unstructured.Unstructured{Object: mergeApplied(existingData, applied)}
+		if err := client.Tracker().Update(patch.GetResource(), obj, patch.GetNamespace()); err != nil {
+			return true, nil, err
+		}
+		return true, obj, nil
+	}
+}
+
+func mergeApplied(existing, applied map[string]interface{}) map[string]interface{} {
+	for k, v := range applied {
+		appliedMap, isMap := v.(map[string]interface{})
+		existingMap, existingIsMap := existing[k].(map[string]interface{})
+		switch {
+		case v == nil:
+			delete(existing, k)
+		case isMap && existingIsMap:
+			existing[k] = mergeApplied(existingMap, appliedMap)
+		default:
+			existing[k] = v
+		}
+	}
+	return existing
+}
+
+// createUnstructured creates a typed object with the dynamic client, for the
+// kinds that aren't known to the Kubernetes clientset.
+func createUnstructured(t *testing.T, client *fakedynamic.FakeDynamicClient, gvr schema.GroupVersionResource, obj metav1.Object) {
+	t.Helper()
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Resource(gvr).Namespace(obj.GetNamespace()).Create(context.Background(), &unstructured.Unstructured{Object: data}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
 // GetResourcesFromClients returns the Resources in the Clients provided
 // Precondition: all Namespaces used in Resources must be listed in Resources.Namespaces
 //
@@ -346,6 +473,42 @@ func GetResourcesFromClients(c Clients) (*Resources, error) {
 		for _, svc := range svcList.Items {
 			testResources.Services = append(testResources.Services, svc.DeepCopy())
 		}
+		// Add HorizontalPodAutoscalers
+		hpaList, err := c.Kube.AutoscalingV2().HorizontalPodAutoscalers(ns.Name).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, hpa := range hpaList.Items {
+			testResources.HorizontalPodAutoscalers = append(testResources.HorizontalPodAutoscalers, hpa.DeepCopy())
+		}
+		// Add PodDisruptionBudgets
+		pdbList, err := c.Kube.PolicyV1().PodDisruptionBudgets(ns.Name).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pdb := range pdbList.Items {
+			testResources.PodDisruptionBudgets = append(testResources.PodDisruptionBudgets, pdb.DeepCopy())
+		}
+		// Add Ingresses
+		ingressList, err := c.Kube.NetworkingV1().Ingresses(ns.Name).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, ingress := range ingressList.Items {
+			testResources.Ingresses = append(testResources.Ingresses, ingress.DeepCopy())
+		}
+		// Add HTTPRoutes
+		routeList, err := c.DynamicClient.Resource(gatewayv1.SchemeGroupVersion.WithResource("httproutes")).Namespace(ns.Name).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range routeList.Items {
+			route := &gatewayv1.HTTPRoute{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, route); err != nil {
+				return nil, err
+			}
+			testResources.HTTPRoutes = append(testResources.HTTPRoutes, route)
+		}
 		// Add Secrets
 		secretsList, err := c.Kube.CoreV1().Secrets(ns.Name).List(context.Background(), metav1.ListOptions{})
 		if err != nil {